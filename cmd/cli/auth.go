@@ -0,0 +1,26 @@
+package main
+
+import (
+	"net/http"
+)
+
+// client sends the requests to the server, authenticated when API_KEY is set and within the tenant set by TENANT.
+var client = http.DefaultClient
+
+// credentials authenticates requests with a token, either an API key or a JWT, and selects their tenant.
+type credentials struct {
+	token  string
+	tenant string
+	next   http.RoundTripper
+}
+
+func (c credentials) RoundTrip(r *http.Request) (*http.Response, error) {
+	r = r.Clone(r.Context())
+	if c.token != "" {
+		r.Header.Set("Authorization", "Bearer "+c.token)
+	}
+	if c.tenant != "" {
+		r.Header.Set("X-Tenant-Id", c.tenant)
+	}
+	return c.next.RoundTrip(r)
+}
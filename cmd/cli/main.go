@@ -7,9 +7,11 @@ import (
 	"fmt"
 	"net/http"
 	"os"
+	"strings"
 	"time"
 
 	"github.com/acai-travel/tech-challenge/internal/pb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 func main() {
@@ -17,8 +19,20 @@ func main() {
 		fmt.Printf("Usage: acai-cli [command] [options]\n")
 		fmt.Println("Commands:")
 		fmt.Println("  ask        Create a new conversation with assistant or continue an existing one")
-		fmt.Println("  list       List existing conversations")
+		fmt.Println("  list       List existing conversations, use --all to include archived ones")
 		fmt.Println("  show       Show conversation by ID")
+		fmt.Println("  retry      Regenerate the last reply of a conversation")
+		fmt.Println("  edit       Rewrite a message of a conversation and get a new reply")
+		fmt.Println("  approve    Approve a tool call the conversation awaits approval for")
+		fmt.Println("  reject     Reject a tool call the conversation awaits approval for, optionally giving a reason")
+		fmt.Println("  fork       Create a new conversation from a conversation up to one of its messages")
+		fmt.Println("  configure  Change the system prompt, model, temperature or max tokens of a conversation")
+		fmt.Println("  rename     Change the title of a conversation")
+		fmt.Println("  archive    Archive a conversation")
+		fmt.Println("  unarchive  Restore an archived conversation")
+		fmt.Println("  rm         Delete a conversation")
+		fmt.Println("  personas   List the personas new conversations can be started with")
+		fmt.Println("  usage      Report the tokens used and their cost by day and model, use --days to change the period")
 	}
 
 	if len(os.Args) < 2 {
@@ -33,7 +47,11 @@ func main() {
 		url = v
 	}
 
-	cli := pb.NewChatServiceJSONClient(url, http.DefaultClient)
+	if key, tenant := os.Getenv("API_KEY"), os.Getenv("TENANT"); key != "" || tenant != "" {
+		client = &http.Client{Transport: credentials{token: key, tenant: tenant, next: http.DefaultTransport}}
+	}
+
+	cli := pb.NewChatServiceJSONClient(url, client)
 	ctx := context.Background()
 
 	switch os.Args[1] {
@@ -41,9 +59,12 @@ func main() {
 		fmt.Println("Press CMD+C to exit.")
 		fmt.Println()
 
-		cid := ""
-		if len(os.Args) >= 3 {
-			cid = os.Args[2]
+		fs := flag.NewFlagSet("ask", flag.ExitOnError)
+		persona := fs.String("persona", "", "ID of the persona to start the conversation with")
+		_ = fs.Parse(os.Args[2:])
+
+		cid := fs.Arg(0)
+		if cid != "" {
 			resp, err := cli.DescribeConversation(ctx, &pb.DescribeConversationRequest{ConversationId: cid})
 
 			if err != nil {
@@ -56,7 +77,7 @@ func main() {
 			fmt.Println("Timestamp:", resp.GetConversation().GetTimestamp().AsTime().Format(time.RFC1123))
 			fmt.Println("")
 			for _, msg := range resp.GetConversation().GetMessages() {
-				fmt.Printf("%s, %s:\n%s\n\n", msg.GetRole(), msg.GetTimestamp().AsTime().Format(time.TimeOnly), msg.GetContent())
+				printMessage(msg)
 			}
 		} else {
 			fmt.Println("Starting a new conversation, type your message below.")
@@ -74,11 +95,14 @@ func main() {
 			}
 
 			fmt.Println()
+			fmt.Printf("ASSISTANT:\n")
 
 			if cid == "" {
-				out, err := cli.StartConversation(ctx, &pb.StartConversationRequest{
-					Message: string(line),
-				})
+				var out pb.StartConversationResponse
+				err := stream(ctx, url, "StartConversation", &pb.StartConversationRequest{
+					Message:   string(line),
+					PersonaId: *persona,
+				}, &out)
 
 				if err != nil {
 					fmt.Printf("Error starting conversation: %v\n", err)
@@ -87,41 +111,60 @@ func main() {
 
 				fmt.Println("New conversation started:")
 				fmt.Println("ID:", out.GetConversationId())
-				fmt.Println("Title:", out.GetTitle())
+				if out.GetTitlePending() {
+					fmt.Println("Title: (still being generated)")
+				} else {
+					fmt.Println("Title:", out.GetTitle())
+				}
 				fmt.Println()
 
 				cid = out.GetConversationId()
-				fmt.Printf("ASSISTANT:\n%s\n\n", out.GetReply())
 				continue
 			}
 
-			out, err := cli.ContinueConversation(ctx, &pb.ContinueConversationRequest{
+			var out pb.ContinueConversationResponse
+			err = stream(ctx, url, "ContinueConversation", &pb.ContinueConversationRequest{
 				ConversationId: cid,
 				Message:        string(line),
-			})
+			}, &out)
 
 			if err != nil {
 				fmt.Printf("Error continuing conversation: %v\n", err)
 				os.Exit(1)
 			}
-
-			fmt.Printf("ASSISTANT:\n%s\n\n", out.GetReply())
 		}
 
 	case "list":
-		resp, err := cli.ListConversations(ctx, &pb.ListConversationsRequest{})
-		if err != nil {
-			fmt.Printf("Error listing conversations: %v\n", err)
-			os.Exit(1)
+		req := &pb.ListConversationsRequest{
+			IncludeArchived: len(os.Args) >= 3 && os.Args[2] == "--all",
 		}
 
-		if len(resp.Conversations) == 0 {
+		var conversations []*pb.Conversation
+		for {
+			resp, err := cli.ListConversations(ctx, req)
+			if err != nil {
+				fmt.Printf("Error listing conversations: %v\n", err)
+				os.Exit(1)
+			}
+
+			conversations = append(conversations, resp.GetConversations()...)
+			if resp.GetNextPageToken() == "" {
+				break
+			}
+			req.PageToken = resp.GetNextPageToken()
+		}
+
+		if len(conversations) == 0 {
 			fmt.Println("No conversations found.")
 			return
 		}
 
 		fmt.Println("ID                         TITLE")
-		for _, conv := range resp.Conversations {
+		for _, conv := range conversations {
+			if conv.GetArchived() {
+				fmt.Printf("%s   %s (archived)\n", conv.GetId(), conv.GetTitle())
+				continue
+			}
 			fmt.Printf("%s   %s\n", conv.GetId(), conv.GetTitle())
 		}
 	case "show":
@@ -142,9 +185,271 @@ func main() {
 		fmt.Println("ID:", resp.GetConversation().GetId())
 		fmt.Println("Title:", resp.GetConversation().GetTitle())
 		fmt.Println("Timestamp:", resp.GetConversation().GetTimestamp().AsTime().Format(time.RFC1123))
+		printSettings(resp.GetConversation().GetSettings())
+		fmt.Println("Usage:", formatUsage(resp.GetConversation().GetUsage()))
+		if parent := resp.GetConversation().GetParentId(); parent != "" {
+			fmt.Printf("Forked from: %s after message %s\n", parent, resp.GetConversation().GetForkPointMessageId())
+		}
 		fmt.Println("")
 		for _, msg := range resp.GetConversation().GetMessages() {
-			fmt.Printf("%s, %s:\n%s\n\n", msg.GetRole(), msg.GetTimestamp().AsTime().Format(time.TimeOnly), msg.GetContent())
+			printMessage(msg)
 		}
+
+		if len(resp.GetForks()) > 0 {
+			fmt.Println("Forks:")
+			for _, fork := range resp.GetForks() {
+				fmt.Printf("%s   %s (after message %s)\n", fork.GetId(), fork.GetTitle(), fork.GetForkPointMessageId())
+			}
+		}
+	case "retry":
+		if len(os.Args) < 3 {
+			fmt.Println("Error: Conversation ID is required")
+			os.Exit(1)
+		}
+
+		fmt.Printf("ASSISTANT:\n")
+
+		var out pb.RegenerateReplyResponse
+		if err := stream(ctx, url, "RegenerateReply", &pb.RegenerateReplyRequest{ConversationId: os.Args[2]}, &out); err != nil {
+			fmt.Printf("Error regenerating reply: %v\n", err)
+			os.Exit(1)
+		}
+	case "edit":
+		if len(os.Args) < 5 {
+			fmt.Println("Error: Conversation ID, message ID and the new message are required")
+			os.Exit(1)
+		}
+
+		fmt.Printf("ASSISTANT:\n")
+
+		var out pb.EditMessageResponse
+		err := stream(ctx, url, "EditMessage", &pb.EditMessageRequest{
+			ConversationId: os.Args[2],
+			MessageId:      os.Args[3],
+			Message:        strings.Join(os.Args[4:], " "),
+		}, &out)
+
+		if err != nil {
+			fmt.Printf("Error editing message: %v\n", err)
+			os.Exit(1)
+		}
+	case "approve", "reject":
+		if len(os.Args) < 4 {
+			fmt.Println("Error: Conversation ID and tool call ID are required")
+			os.Exit(1)
+		}
+
+		fmt.Printf("ASSISTANT:\n")
+
+		var err error
+		if os.Args[1] == "approve" {
+			var out pb.ApproveToolCallResponse
+			err = stream(ctx, url, "ApproveToolCall", &pb.ApproveToolCallRequest{ConversationId: os.Args[2], ToolCallId: os.Args[3]}, &out)
+		} else {
+			var out pb.RejectToolCallResponse
+			err = stream(ctx, url, "RejectToolCall", &pb.RejectToolCallRequest{
+				ConversationId: os.Args[2],
+				ToolCallId:     os.Args[3],
+				Reason:         strings.Join(os.Args[4:], " "),
+			}, &out)
+		}
+
+		if err != nil {
+			fmt.Printf("Error deciding tool call: %v\n", err)
+			os.Exit(1)
+		}
+	case "fork":
+		if len(os.Args) < 4 {
+			fmt.Println("Error: Conversation ID and message ID are required")
+			os.Exit(1)
+		}
+
+		resp, err := cli.ForkConversation(ctx, &pb.ForkConversationRequest{
+			ConversationId: os.Args[2],
+			MessageId:      os.Args[3],
+		})
+
+		if err != nil {
+			fmt.Printf("Error forking conversation: %v\n", err)
+			os.Exit(1)
+		}
+
+		fmt.Println("New conversation forked:")
+		fmt.Println("ID:", resp.GetConversation().GetId())
+		fmt.Println("Title:", resp.GetConversation().GetTitle())
+	case "configure":
+		if len(os.Args) < 3 {
+			fmt.Println("Error: Conversation ID is required")
+			os.Exit(1)
+		}
+
+		fs := flag.NewFlagSet("configure", flag.ExitOnError)
+		systemPrompt := fs.String("system", "", "system prompt replacing the default one")
+		model := fs.String("model", "", "model replying to the conversation")
+		temperature := fs.Float64("temperature", -1, "sampling temperature between 0 and 2")
+		maxTokens := fs.Int("max-tokens", 0, "maximum number of tokens of each reply")
+		_ = fs.Parse(os.Args[3:])
+
+		settings := &pb.ConversationSettings{
+			SystemPrompt: *systemPrompt,
+			Model:        *model,
+			MaxTokens:    int32(*maxTokens),
+		}
+		if *temperature >= 0 {
+			settings.Temperature = temperature
+		}
+
+		resp, err := cli.UpdateConversationSettings(ctx, &pb.UpdateConversationSettingsRequest{
+			ConversationId: os.Args[2],
+			Settings:       settings,
+		})
+
+		if err != nil {
+			fmt.Printf("Error configuring conversation: %v\n", err)
+			os.Exit(1)
+		}
+
+		printSettings(resp.GetConversation().GetSettings())
+	case "usage":
+		fs := flag.NewFlagSet("usage", flag.ExitOnError)
+		days := fs.Int("days", 30, "number of days to report, including today")
+		_ = fs.Parse(os.Args[2:])
+
+		today := time.Now().UTC().Truncate(24 * time.Hour)
+		resp, err := cli.GetUsageReport(ctx, &pb.GetUsageReportRequest{
+			StartTime: timestamppb.New(today.AddDate(0, 0, 1-*days)),
+		})
+
+		if err != nil {
+			fmt.Printf("Error getting usage report: %v\n", err)
+			os.Exit(1)
+		}
+
+		if len(resp.GetRows()) == 0 {
+			fmt.Println("No usage found.")
+			return
+		}
+
+		fmt.Printf("%-12s %-20s %s\n", "DAY", "MODEL", "USAGE")
+		for _, row := range resp.GetRows() {
+			fmt.Printf("%-12s %-20s %s\n", row.GetDay(), row.GetUsage().GetModel(), formatUsage(row.GetUsage()))
+		}
+		fmt.Printf("%-12s %-20s %s\n", "TOTAL", "", formatUsage(resp.GetTotal()))
+	case "personas":
+		resp, err := pb.NewPersonaServiceJSONClient(url, client).ListPersonas(ctx, &pb.ListPersonasRequest{})
+		if err != nil {
+			fmt.Printf("Error listing personas: %v\n", err)
+			os.Exit(1)
+		}
+
+		if len(resp.GetPersonas()) == 0 {
+			fmt.Println("No personas found.")
+			return
+		}
+
+		fmt.Println("ID                         NAME")
+		for _, persona := range resp.GetPersonas() {
+			fmt.Printf("%s   %s\n", persona.GetId(), persona.GetName())
+		}
+	case "rename":
+		if len(os.Args) < 4 {
+			fmt.Println("Error: Conversation ID and title are required")
+			os.Exit(1)
+		}
+
+		resp, err := cli.UpdateConversationTitle(ctx, &pb.UpdateConversationTitleRequest{
+			ConversationId: os.Args[2],
+			Title:          strings.Join(os.Args[3:], " "),
+		})
+
+		if err != nil {
+			fmt.Printf("Error renaming conversation: %v\n", err)
+			os.Exit(1)
+		}
+
+		fmt.Println("Title:", resp.GetConversation().GetTitle())
+	case "archive", "unarchive":
+		if len(os.Args) < 3 {
+			fmt.Println("Error: Conversation ID is required")
+			os.Exit(1)
+		}
+
+		var err error
+		if os.Args[1] == "archive" {
+			_, err = cli.ArchiveConversation(ctx, &pb.ArchiveConversationRequest{ConversationId: os.Args[2]})
+		} else {
+			_, err = cli.UnarchiveConversation(ctx, &pb.UnarchiveConversationRequest{ConversationId: os.Args[2]})
+		}
+
+		if err != nil {
+			fmt.Printf("Error updating conversation: %v\n", err)
+			os.Exit(1)
+		}
+
+		fmt.Printf("Conversation %s %sd.\n", os.Args[2], os.Args[1])
+	case "rm":
+		if len(os.Args) < 3 {
+			fmt.Println("Error: Conversation ID is required")
+			os.Exit(1)
+		}
+
+		if _, err := cli.DeleteConversation(ctx, &pb.DeleteConversationRequest{ConversationId: os.Args[2]}); err != nil {
+			fmt.Printf("Error deleting conversation: %v\n", err)
+			os.Exit(1)
+		}
+
+		fmt.Printf("Conversation %s deleted.\n", os.Args[2])
+	default:
+		fmt.Printf("Error: Unknown command %q\n", os.Args[1])
+		fmt.Println("")
+		flag.Usage()
+		os.Exit(-1)
+	}
+}
+
+func printMessage(msg *pb.Conversation_Message) {
+	header := fmt.Sprintf("%s, %s, %s", msg.GetRole(), msg.GetTimestamp().AsTime().Format(time.TimeOnly), msg.GetId())
+	if len(msg.GetVersions()) > 0 {
+		header += " (edited)"
+	}
+
+	switch tc := msg.GetToolCall(); msg.GetRole() {
+	case pb.Conversation_TOOL_CALL:
+		switch tc.GetApproval() {
+		case pb.Conversation_APPROVAL_PENDING:
+			header += " (awaiting approval)"
+		case pb.Conversation_APPROVAL_APPROVED:
+			header += " (approved)"
+		case pb.Conversation_APPROVAL_REJECTED:
+			header += " (rejected)"
+		}
+		fmt.Printf("%s:\n%s(%s)\n\n", header, tc.GetName(), tc.GetArguments())
+	case pb.Conversation_TOOL_RESULT:
+		if tc.GetError() != "" {
+			fmt.Printf("%s, %s failed after %s:\n%s\n\n", header, tc.GetName(), tc.GetDuration().AsDuration(), tc.GetError())
+			return
+		}
+		fmt.Printf("%s, %s took %s:\n%s\n\n", header, tc.GetName(), tc.GetDuration().AsDuration(), tc.GetResult())
+	default:
+		fmt.Printf("%s:\n%s\n\n", header, msg.GetContent())
+	}
+}
+
+func formatUsage(usage *pb.Usage) string {
+	return fmt.Sprintf("%d prompt + %d completion tokens, $%.4f", usage.GetPromptTokens(), usage.GetCompletionTokens(), usage.GetCost())
+}
+
+func printSettings(settings *pb.ConversationSettings) {
+	if v := settings.GetSystemPrompt(); v != "" {
+		fmt.Println("System prompt:", v)
+	}
+	if v := settings.GetModel(); v != "" {
+		fmt.Println("Model:", v)
+	}
+	if settings.Temperature != nil {
+		fmt.Println("Temperature:", settings.GetTemperature())
+	}
+	if v := settings.GetMaxTokens(); v > 0 {
+		fmt.Println("Max tokens:", v)
 	}
 }
@@ -0,0 +1,105 @@
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/twitchtv/twirp"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+// streamEvent mirrors the payload of the events sent by the server's streaming endpoints.
+type streamEvent struct {
+	Delta      string `json:"delta"`
+	ToolCallID string `json:"tool_call_id"`
+	ToolName   string `json:"tool_name"`
+	Arguments  string `json:"arguments"`
+	Error      string `json:"error"`
+	Code       string `json:"code"`
+	Msg        string `json:"msg"`
+}
+
+// stream calls one of the streaming endpoints, rendering reply tokens and tool calls as they arrive. The final
+// response is decoded into resp.
+func stream(ctx context.Context, url, method string, req, resp proto.Message) error {
+	body, err := protojson.Marshal(req)
+	if err != nil {
+		return err
+	}
+
+	httpReq, err := http.NewRequestWithContext(ctx, http.MethodPost, url+"/stream/"+method, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	httpReq.Header.Set("Content-Type", "application/json")
+	httpReq.Header.Set("Accept", "text/event-stream")
+
+	httpResp, err := client.Do(httpReq)
+	if err != nil {
+		return err
+	}
+	defer httpResp.Body.Close()
+
+	if httpResp.StatusCode != http.StatusOK {
+		var e streamEvent
+		if err := json.NewDecoder(httpResp.Body).Decode(&e); err != nil {
+			return fmt.Errorf("unexpected status: %s", httpResp.Status)
+		}
+		return twirp.NewError(twirp.ErrorCode(e.Code), e.Msg)
+	}
+
+	var event string
+	scanner := bufio.NewScanner(httpResp.Body)
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+
+	for scanner.Scan() {
+		line := scanner.Text()
+
+		switch {
+		case strings.HasPrefix(line, "event: "):
+			event = strings.TrimPrefix(line, "event: ")
+			continue
+		case !strings.HasPrefix(line, "data: "):
+			continue
+		}
+
+		data := []byte(strings.TrimPrefix(line, "data: "))
+		if event == "done" {
+			fmt.Print("\n\n")
+			return protojson.Unmarshal(data, resp)
+		}
+
+		var e streamEvent
+		if err := json.Unmarshal(data, &e); err != nil {
+			return err
+		}
+
+		switch event {
+		case "delta":
+			fmt.Print(e.Delta)
+		case "tool_call_started":
+			fmt.Printf("[calling %s...]\n", e.ToolName)
+		case "tool_call_finished":
+			if e.Error != "" {
+				fmt.Printf("[%s failed: %s]\n", e.ToolName, e.Error)
+			}
+		case "tool_call_approval_required":
+			fmt.Printf("[%s(%s) awaits approval, call ID %s]\n", e.ToolName, e.Arguments, e.ToolCallID)
+		case "error":
+			fmt.Print("\n\n")
+			return twirp.NewError(twirp.ErrorCode(e.Code), e.Msg)
+		}
+	}
+
+	if err := scanner.Err(); err != nil {
+		return err
+	}
+
+	return fmt.Errorf("stream ended before the reply was complete")
+}
@@ -1,16 +1,22 @@
 package main
 
 import (
+	"context"
 	"fmt"
 	"log/slog"
 	"net/http"
+	"os"
+	"time"
 
 	"github.com/acai-travel/tech-challenge/internal/chat"
 	"github.com/acai-travel/tech-challenge/internal/chat/assistant"
+	"github.com/acai-travel/tech-challenge/internal/chat/budget"
 	"github.com/acai-travel/tech-challenge/internal/chat/model"
+	"github.com/acai-travel/tech-challenge/internal/chat/tools/mcp"
 	"github.com/acai-travel/tech-challenge/internal/httpx"
 	"github.com/acai-travel/tech-challenge/internal/mongox"
 	"github.com/acai-travel/tech-challenge/internal/pb"
+	"github.com/acai-travel/tech-challenge/internal/webhook"
 	"github.com/gorilla/mux"
 	"github.com/joho/godotenv"
 	"github.com/twitchtv/twirp"
@@ -22,12 +28,49 @@ import (
 )
 
 func main() {
+	// Load .env file before anything reads its configuration from the environment
+	err := godotenv.Load()
+	if err != nil {
+		panic("Error loading .env file")
+	}
+
 	mongo := mongox.MustConnect()
 
 	repo := model.New(mongo)
+	if err := repo.EnsureIndexes(context.Background()); err != nil {
+		panic(err)
+	}
+
+	// Tools of the MCP servers are registered next to the built-in ones, before anything looks them up
+	mcpClients, err := mcp.RegisterFromEnv(context.Background())
+	if err != nil {
+		panic(err)
+	}
+	defer func() {
+		for _, c := range mcpClients {
+			_ = c.Close()
+		}
+	}()
+
 	assist := assistant.New()
 
-	server := chat.NewServer(repo, assist)
+	// Retries of idempotent requests wait this long for the first attempt before giving up
+	idempotencyWait := 30 * time.Second
+	if v := os.Getenv("IDEMPOTENCY_WAIT"); v != "" {
+		if idempotencyWait, err = time.ParseDuration(v); err != nil {
+			panic(fmt.Errorf("invalid IDEMPOTENCY_WAIT: %w", err))
+		}
+	}
+
+	server := chat.NewServer(repo, assist,
+		chat.WithPersonas(repo),
+		chat.WithTenants(repo),
+		chat.WithBudget(budget.New(repo)),
+		chat.WithIdempotency(repo, idempotencyWait),
+		chat.WithWebhooks(webhook.FromEnv()),
+	)
+	personas := chat.NewPersonaServer(repo)
+	tenants := chat.NewTenantServer(repo)
 
 	// Configure handler
 	handler := mux.NewRouter()
@@ -40,13 +83,35 @@ func main() {
 		_, _ = fmt.Fprint(w, "Hi, my name is Clippy!")
 	})
 
-	handler.PathPrefix("/twirp/").Handler(pb.NewChatServiceServer(server, twirp.WithServerJSONSkipDefaults(true)))
+	// The API requires credentials, unless no key file is configured
+	api := handler.NewRoute().Subrouter()
+	if path := os.Getenv("AUTH_KEY_FILE"); path != "" {
+		auth, err := httpx.LoadAuthenticator(path)
+		if err != nil {
+			panic(err)
+		}
+		api.Use(httpx.Auth(auth))
+	} else {
+		slog.Warn("AUTH_KEY_FILE is not set, authentication is disabled and every caller sees every conversation")
+		api.Use(httpx.NoAuth())
+	}
+	api.Use(httpx.Tenant(), httpx.IdempotencyKey())
 
-	// Load .env file
-	err := godotenv.Load()
-	if err != nil {
-		panic("Error loading .env file")
+	// Rate limits are kept in memory unless replicas share them through MongoDB
+	var buckets httpx.RateLimitStore = httpx.NewMemoryRateLimitStore()
+	switch store := os.Getenv("RATE_LIMIT_STORE"); store {
+	case "", "memory":
+	case "mongo":
+		buckets = repo
+	default:
+		panic(fmt.Errorf("invalid RATE_LIMIT_STORE %q, expected memory or mongo", store))
 	}
+	api.Use(httpx.RateLimit(buckets, httpx.RateLimitsFromEnv()))
+
+	api.PathPrefix(pb.TenantServicePathPrefix).Handler(pb.NewTenantServiceServer(tenants, twirp.WithServerJSONSkipDefaults(true)))
+	api.PathPrefix(pb.PersonaServicePathPrefix).Handler(pb.NewPersonaServiceServer(personas, twirp.WithServerJSONSkipDefaults(true)))
+	api.PathPrefix("/twirp/").Handler(pb.NewChatServiceServer(server, twirp.WithServerJSONSkipDefaults(true)))
+	api.PathPrefix(chat.StreamPathPrefix).Handler(server.StreamHandler())
 
 	// Setup OpenTelemetry
 	metricExporter, _ := stdoutmetric.New()
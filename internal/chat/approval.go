@@ -0,0 +1,132 @@
+package chat
+
+import (
+	"context"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/acai-travel/tech-challenge/internal/chat/assistant"
+	"github.com/acai-travel/tech-challenge/internal/chat/model"
+	"github.com/acai-travel/tech-challenge/internal/pb"
+	"github.com/twitchtv/twirp"
+)
+
+func (s *Server) ApproveToolCall(ctx context.Context, req *pb.ApproveToolCallRequest) (*pb.ApproveToolCallResponse, error) {
+	result, err := instrument(ctx, "ApproveToolCall", func(ctx context.Context) (any, error) {
+		return s.approveToolCall(ctx, req, nil)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*pb.ApproveToolCallResponse), nil
+}
+
+// approveToolCall implements ApproveToolCall, reply progress is reported to emit when it is not nil.
+func (s *Server) approveToolCall(ctx context.Context, req *pb.ApproveToolCallRequest, emit func(assistant.Event)) (*pb.ApproveToolCallResponse, error) {
+	conversation, message, err := s.decideToolCall(ctx, req.GetConversationId(), req.GetToolCallId(), model.ApprovalApproved, "", emit)
+	if err != nil {
+		return nil, err
+	}
+
+	reply, messageID := replied(conversation, message)
+	return &pb.ApproveToolCallResponse{
+		Reply:            reply,
+		MessageId:        messageID,
+		AwaitingApproval: conversation.AwaitingApproval,
+		PendingToolCalls: pendingToolCalls(conversation),
+	}, nil
+}
+
+func (s *Server) RejectToolCall(ctx context.Context, req *pb.RejectToolCallRequest) (*pb.RejectToolCallResponse, error) {
+	result, err := instrument(ctx, "RejectToolCall", func(ctx context.Context) (any, error) {
+		return s.rejectToolCall(ctx, req, nil)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*pb.RejectToolCallResponse), nil
+}
+
+// rejectToolCall implements RejectToolCall, reply progress is reported to emit when it is not nil.
+func (s *Server) rejectToolCall(ctx context.Context, req *pb.RejectToolCallRequest, emit func(assistant.Event)) (*pb.RejectToolCallResponse, error) {
+	reason := strings.TrimSpace(req.GetReason())
+	conversation, message, err := s.decideToolCall(ctx, req.GetConversationId(), req.GetToolCallId(), model.ApprovalRejected, reason, emit)
+	if err != nil {
+		return nil, err
+	}
+
+	reply, messageID := replied(conversation, message)
+	return &pb.RejectToolCallResponse{
+		Reply:            reply,
+		MessageId:        messageID,
+		AwaitingApproval: conversation.AwaitingApproval,
+		PendingToolCalls: pendingToolCalls(conversation),
+	}, nil
+}
+
+// decideToolCall records the user's decision on a tool call the conversation awaits approval for. Once every pending
+// call was decided, the reply resumes and is returned, it is nil while other calls are still pending.
+func (s *Server) decideToolCall(ctx context.Context, conversationID, toolCallID string, approval model.Approval, reason string, emit func(assistant.Event)) (*model.Conversation, *model.Message, error) {
+	if conversationID == "" {
+		return nil, nil, twirp.RequiredArgumentError("conversation_id")
+	}
+
+	if toolCallID == "" {
+		return nil, nil, twirp.RequiredArgumentError("tool_call_id")
+	}
+
+	conversation, err := s.repo.DescribeConversation(ctx, conversationID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// providers number the calls of every turn alike, e.g. call_0, so only the pending ones are searched
+	isCall := func(m *model.Message) bool { return m.Role == model.RoleToolCall && m.ToolCall.ID == toolCallID }
+	i := slices.IndexFunc(conversation.PendingToolCalls(), isCall)
+	if !conversation.AwaitingApproval || i < 0 {
+		if !slices.ContainsFunc(conversation.Messages, isCall) {
+			return nil, nil, twirp.NotFoundError("tool call not found")
+		}
+		return nil, nil, twirp.NewError(twirp.FailedPrecondition, "tool call is not awaiting approval")
+	}
+
+	call := conversation.PendingToolCalls()[i]
+	pos := slices.Index(conversation.Messages, call)
+
+	call.ToolCall.Approval = approval
+	call.ToolCall.RejectionReason = reason
+
+	// the calls of the turn are rewritten along with their decisions
+	from := pos
+	for from > 0 && conversation.Messages[from-1].Role == model.RoleToolCall {
+		from--
+	}
+
+	if len(conversation.PendingToolCalls()) > 0 {
+		conversation.UpdatedAt = time.Now()
+		if err := s.repo.ReplaceMessages(ctx, conversation, from, conversation.Messages[from:]...); err != nil {
+			return nil, nil, err
+		}
+		return conversation, nil, nil
+	}
+
+	if err := s.checkBudget(ctx, conversation); err != nil {
+		return nil, nil, err
+	}
+
+	message, err := s.reply(ctx, conversation, emit)
+	if err != nil {
+		return nil, nil, replyFailed(err)
+	}
+	conversation.UpdatedAt = message.CreatedAt
+
+	err = s.writeRetitled(ctx, conversation, func() error {
+		return s.repo.ReplaceMessages(ctx, conversation, from, conversation.Messages[from:]...)
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return conversation, message, nil
+}
@@ -0,0 +1,199 @@
+package chat
+
+import (
+	"context"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"github.com/acai-travel/tech-challenge/internal/chat/assistant"
+	. "github.com/acai-travel/tech-challenge/internal/chat/testing"
+	"github.com/acai-travel/tech-challenge/internal/chat/tools"
+	"github.com/acai-travel/tech-challenge/internal/pb"
+	"github.com/twitchtv/twirp"
+)
+
+// registerBookingTool registers a tool needing approval, and returns how many times it ran.
+func registerBookingTool(t *testing.T) *atomic.Int32 {
+	var bookings atomic.Int32
+
+	type args struct {
+		City string `json:"city" required:"true"`
+	}
+	tools.Register(tools.Define("book_hotel", "Books a hotel room", func(ctx context.Context, a args) (string, error) {
+		bookings.Add(1)
+		return "booked in " + a.City, nil
+	}, tools.WithApproval()))
+	t.Cleanup(func() { delete(tools.Registry, "book_hotel") })
+
+	return &bookings
+}
+
+func TestServer_ToolCallApproval(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("the reply waits for approval and resumes once approved", WithFixture(func(t *testing.T, f *Fixture) {
+		bookings := registerBookingTool(t)
+		openai := StartFakeOpenAI(t).
+			Title("Hotel in Barcelona").
+			CallTools(
+				FakeToolCall{ID: "call_1", Name: "get_today_date", Arguments: "{}"},
+				FakeToolCall{ID: "call_2", Name: "book_hotel", Arguments: `{"city": "Barcelona"}`},
+			).
+			Reply("Your room in Barcelona is booked.")
+		srv := NewServer(f.ConversationStore, assistant.New(assistant.WithBaseURL(openai.URL)))
+
+		out, err := srv.StartConversation(ctx, &pb.StartConversationRequest{Message: "Book me a hotel in Barcelona for today"})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		f.Cleanup(out.GetConversationId())
+
+		if !out.GetAwaitingApproval() || out.GetReply() != "" || len(out.GetPendingToolCalls()) != 1 || out.GetPendingToolCalls()[0].GetId() != "call_2" {
+			t.Fatalf("expected the reply to await approval of the booking, got %v", out)
+		}
+
+		if n := bookings.Load(); n != 0 {
+			t.Fatalf("expected the booking not to run before approval, ran %d times", n)
+		}
+
+		described := awaitTitle(t, srv, ctx, out.GetConversationId()).GetConversation()
+		if !described.GetAwaitingApproval() || described.GetMessages()[2].GetToolCall().GetApproval() != pb.Conversation_APPROVAL_PENDING {
+			t.Errorf("expected the pending call to be saved, got %v", described)
+		}
+
+		_, err = srv.ContinueConversation(ctx, &pb.ContinueConversationRequest{ConversationId: out.GetConversationId(), Message: "Hurry up"})
+		if te, ok := err.(twirp.Error); !ok || te.Code() != twirp.FailedPrecondition {
+			t.Errorf("expected the conversation not to be continued while awaiting approval, got %v", err)
+		}
+
+		approved, err := srv.ApproveToolCall(ctx, &pb.ApproveToolCallRequest{ConversationId: out.GetConversationId(), ToolCallId: "call_2"})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if approved.GetAwaitingApproval() || approved.GetReply() != "Your room in Barcelona is booked." {
+			t.Errorf("expected the reply to resume, got %v", approved)
+		}
+
+		if n := bookings.Load(); n != 1 {
+			t.Errorf("expected the booking to run once, ran %d times", n)
+		}
+
+		_, replies := splitRequests(openai.Requests())
+		sent := replies[len(replies)-1].Messages
+		var results []string
+		for _, m := range sent {
+			if m.Role == "tool" {
+				results = append(results, m.ToolCallID+": "+m.Content)
+			}
+		}
+		if len(results) != 2 || !strings.HasPrefix(results[0], "call_1: ") || results[1] != "call_2: booked in Barcelona" {
+			t.Errorf("expected both calls to be answered in order, got %v", results)
+		}
+
+		resumed, err := srv.DescribeConversation(ctx, &pb.DescribeConversationRequest{ConversationId: out.GetConversationId()})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		msgs := resumed.GetConversation().GetMessages()
+		if resumed.GetConversation().GetAwaitingApproval() || len(msgs) != 6 || msgs[2].GetToolCall().GetApproval() != pb.Conversation_APPROVAL_APPROVED || msgs[5].GetId() != approved.GetMessageId() {
+			t.Errorf("expected the approval and the reply to be saved, got %v", resumed.GetConversation())
+		}
+
+		_, err = srv.ApproveToolCall(ctx, &pb.ApproveToolCallRequest{ConversationId: out.GetConversationId(), ToolCallId: "call_2"})
+		if te, ok := err.(twirp.Error); !ok || te.Code() != twirp.FailedPrecondition {
+			t.Errorf("expected a decided call not to be approved again, got %v", err)
+		}
+	}))
+
+	t.Run("rejected calls do not run and the model is told why", WithFixture(func(t *testing.T, f *Fixture) {
+		bookings := registerBookingTool(t)
+		conv := f.CreateConversation()
+		openai := StartFakeOpenAI(t).
+			CallTools(
+				FakeToolCall{ID: "call_1", Name: "book_hotel", Arguments: `{"city": "Barcelona"}`},
+				FakeToolCall{ID: "call_2", Name: "book_hotel", Arguments: `{"city": "Madrid"}`},
+			).
+			Reply("Only Madrid is booked.")
+		srv := NewServer(f.ConversationStore, assistant.New(assistant.WithBaseURL(openai.URL)))
+
+		out, err := srv.ContinueConversation(ctx, &pb.ContinueConversationRequest{ConversationId: conv.ID.Hex(), Message: "Book both"})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if len(out.GetPendingToolCalls()) != 2 {
+			t.Fatalf("expected both calls to await approval, got %v", out)
+		}
+
+		rejected, err := srv.RejectToolCall(ctx, &pb.RejectToolCallRequest{ConversationId: conv.ID.Hex(), ToolCallId: "call_1", Reason: "too expensive"})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if !rejected.GetAwaitingApproval() || len(rejected.GetPendingToolCalls()) != 1 || rejected.GetPendingToolCalls()[0].GetId() != "call_2" {
+			t.Fatalf("expected the other call to still be pending, got %v", rejected)
+		}
+
+		approved, err := srv.ApproveToolCall(ctx, &pb.ApproveToolCallRequest{ConversationId: conv.ID.Hex(), ToolCallId: "call_2"})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if approved.GetReply() != "Only Madrid is booked." || bookings.Load() != 1 {
+			t.Errorf("expected only the approved call to run, got %v after %d bookings", approved, bookings.Load())
+		}
+
+		sent := openai.Requests()[1].Messages
+		if last := sent[len(sent)-2]; last.ToolCallID != "call_1" || !strings.Contains(last.Content, `"rejected"`) || !strings.Contains(last.Content, "too expensive") {
+			t.Errorf("expected the rejection to reach the model, got %+v", last)
+		}
+	}))
+
+	t.Run("tool call ids reused by later turns are decided on the pending call", WithFixture(func(t *testing.T, f *Fixture) {
+		bookings := registerBookingTool(t)
+		conv := f.CreateConversation()
+		openai := StartFakeOpenAI(t).
+			CallTools(FakeToolCall{ID: "call_0", Name: "book_hotel", Arguments: `{"city": "Barcelona"}`}).
+			Reply("Barcelona is booked.").
+			CallTools(FakeToolCall{ID: "call_0", Name: "book_hotel", Arguments: `{"city": "Madrid"}`}).
+			Reply("Madrid is booked.")
+		srv := NewServer(f.ConversationStore, assistant.New(assistant.WithBaseURL(openai.URL)))
+
+		for _, city := range []string{"Barcelona", "Madrid"} {
+			out, err := srv.ContinueConversation(ctx, &pb.ContinueConversationRequest{ConversationId: conv.ID.Hex(), Message: "Book " + city})
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if len(out.GetPendingToolCalls()) != 1 || out.GetPendingToolCalls()[0].GetId() != "call_0" {
+				t.Fatalf("expected the booking in %s to await approval, got %v", city, out)
+			}
+
+			approved, err := srv.ApproveToolCall(ctx, &pb.ApproveToolCallRequest{ConversationId: conv.ID.Hex(), ToolCallId: "call_0"})
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if approved.GetReply() != city+" is booked." {
+				t.Errorf("expected the booking in %s to resume the reply, got %v", city, approved)
+			}
+		}
+
+		if n := bookings.Load(); n != 2 {
+			t.Errorf("expected both bookings to run, ran %d times", n)
+		}
+	}))
+
+	t.Run("unknown tool calls are not found", WithFixture(func(t *testing.T, f *Fixture) {
+		conv := f.CreateConversation()
+		srv := NewServer(f.ConversationStore, assistant.New(assistant.WithBaseURL(StartFakeOpenAI(t).URL)))
+
+		_, err := srv.ApproveToolCall(ctx, &pb.ApproveToolCallRequest{ConversationId: conv.ID.Hex(), ToolCallId: "call_1"})
+		if te, ok := err.(twirp.Error); !ok || te.Code() != twirp.NotFound {
+			t.Errorf("expected twirp.NotFound error, got %v", err)
+		}
+	}))
+}
@@ -1,54 +1,161 @@
 package assistant
 
 import (
+	"cmp"
 	"context"
 	"errors"
+	"fmt"
 	"log/slog"
+	"os"
+	"strconv"
 	"strings"
+	"time"
 
+	"github.com/acai-travel/tech-challenge/internal/chat/llm"
 	"github.com/acai-travel/tech-challenge/internal/chat/model"
 	"github.com/acai-travel/tech-challenge/internal/chat/tools"
-	"github.com/openai/openai-go/v2"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+const (
+	defaultTitleModel = "o1"
+	defaultReplyModel = "gpt-4.1"
+
+	defaultSystemPrompt = "You are a helpful, concise AI assistant. Provide accurate, safe, and clear responses."
 )
 
 type Assistant struct {
-	cli openai.Client
+	llm        llm.Provider
+	titleModel string
+	replyModel string
+
+	// budget is the number of tokens the conversation may take when replying, zero derives it from the model
+	budget int
+
+	// toolConcurrency is how many tool calls of a turn run at the same time
+	toolConcurrency int
+}
+
+type Option func(*Assistant)
+
+// WithProvider sets the provider generating completions, instead of the one configured by the environment.
+func WithProvider(p llm.Provider) Option {
+	return func(a *Assistant) { a.llm = p }
+}
+
+// WithBaseURL talks to the OpenAI compatible API at url instead of the configured provider, e.g. to a fake server in
+// tests.
+func WithBaseURL(url string) Option {
+	return WithProvider(llm.NewLocal(url))
+}
+
+// WithTitleModel sets the model used to generate conversation titles.
+func WithTitleModel(model string) Option {
+	return func(a *Assistant) { a.titleModel = model }
 }
 
-func New() *Assistant {
-	return &Assistant{cli: openai.NewClient()}
+// WithReplyModel sets the model used to reply to the user, unless the conversation's settings or persona choose
+// another one.
+func WithReplyModel(model string) Option {
+	return func(a *Assistant) { a.replyModel = model }
 }
 
-func (a *Assistant) Title(ctx context.Context, conv *model.Conversation) (string, error) {
+// WithContextBudget sets the number of tokens a conversation may take when replying to it, older messages are
+// summarized to stay within it. By default it is three quarters of the reply model's context window.
+func WithContextBudget(tokens int) Option {
+	return func(a *Assistant) { a.budget = tokens }
+}
+
+// WithToolConcurrency sets how many of the tool calls requested by the model in a single turn run at the same time.
+func WithToolConcurrency(n int) Option {
+	return func(a *Assistant) { a.toolConcurrency = n }
+}
+
+// New creates an assistant. Unless options say otherwise, the provider is chosen by llm.FromEnv, the models are read
+// from ASSISTANT_TITLE_MODEL and ASSISTANT_REPLY_MODEL, the context budget from ASSISTANT_CONTEXT_BUDGET and the
+// tool concurrency from ASSISTANT_TOOL_CONCURRENCY. New panics if the environment is misconfigured.
+func New(opts ...Option) *Assistant {
+	a := &Assistant{
+		titleModel: defaultTitleModel,
+		replyModel: defaultReplyModel,
+
+		toolConcurrency: defaultToolConcurrency,
+	}
+
+	if v := os.Getenv("ASSISTANT_TITLE_MODEL"); v != "" {
+		a.titleModel = v
+	}
+
+	if v := os.Getenv("ASSISTANT_REPLY_MODEL"); v != "" {
+		a.replyModel = v
+	}
+
+	if v := os.Getenv("ASSISTANT_CONTEXT_BUDGET"); v != "" {
+		budget, err := strconv.Atoi(v)
+		if err != nil || budget <= 0 {
+			panic(fmt.Errorf("invalid ASSISTANT_CONTEXT_BUDGET %q, expected a positive number of tokens", v))
+		}
+		a.budget = budget
+	}
+
+	if v := os.Getenv("ASSISTANT_TOOL_CONCURRENCY"); v != "" {
+		n, err := strconv.Atoi(v)
+		if err != nil || n <= 0 {
+			panic(fmt.Errorf("invalid ASSISTANT_TOOL_CONCURRENCY %q, expected a positive number", v))
+		}
+		a.toolConcurrency = n
+	}
+
+	for _, opt := range opts {
+		opt(a)
+	}
+
+	if a.llm == nil {
+		p, err := llm.FromEnv()
+		if err != nil {
+			panic(err)
+		}
+		a.llm = p
+	}
+
+	return a
+}
+
+// Title generates a title for the conversation from the user's questions, and returns it along with the tokens used to
+// generate it.
+func (a *Assistant) Title(ctx context.Context, conv *model.Conversation) (string, model.Usage, error) {
 	if len(conv.Messages) == 0 {
-		return "An empty conversation", nil
+		return "An empty conversation", model.Usage{}, nil
 	}
 
 	slog.InfoContext(ctx, "Generating title for conversation", "conversation_id", conv.ID)
 
-	msgs := []openai.ChatCompletionMessageParamUnion{
-		openai.SystemMessage("Summarize the user's question as a concise, descriptive title. The title should be a single line, no more than 80 characters, and should not include any special characters or emojis."),
+	msgs := []llm.Message{
+		llm.SystemMessage("Summarize the user's question as a concise, descriptive title. The title should be a single line, no more than 80 characters, and should not include any special characters or emojis."),
 	}
 	for _, m := range conv.Messages {
 		if m.Role == model.RoleUser {
-			msgs = append(msgs, openai.UserMessage(m.Content))
+			msgs = append(msgs, llm.UserMessage(m.Content))
 		}
 	}
 
-	resp, err := a.cli.Chat.Completions.New(ctx, openai.ChatCompletionNewParams{
-		Model:    openai.ChatModelO1,
+	resp, err := a.llm.Complete(ctx, llm.Request{
+		Model:    a.titleModel,
 		Messages: msgs,
 	})
 
 	if err != nil {
-		return "", err
+		return "", model.Usage{}, err
 	}
 
-	if len(resp.Choices) == 0 || strings.TrimSpace(resp.Choices[0].Message.Content) == "" {
-		return "", errors.New("empty response from OpenAI for title generation")
+	// a fallback model may have generated the title, see llm.Resilient
+	usage := usageOf(cmp.Or(resp.Model, a.titleModel), resp.Usage)
+
+	if strings.TrimSpace(resp.Message.Content) == "" {
+		return "", usage, errors.New("empty response from the model for title generation")
 	}
 
-	title := resp.Choices[0].Message.Content
+	title := resp.Message.Content
 	title = strings.ReplaceAll(title, "\n", " ")
 	title = strings.Trim(title, " \t\r\n-\"'")
 
@@ -56,75 +163,213 @@ func (a *Assistant) Title(ctx context.Context, conv *model.Conversation) (string
 		title = title[:80]
 	}
 
-	return title, nil
+	return title, usage, nil
+}
+
+// ReplyError is returned when generating a reply failed after the model was already called, e.g. when a later call
+// timed out. It carries the usage of the calls made until then, which were paid for all the same.
+type ReplyError struct {
+	Usage model.Usage
+	Err   error
+}
+
+func (e *ReplyError) Error() string {
+	return e.Err.Error()
+}
+
+func (e *ReplyError) Unwrap() error {
+	return e.Err
+}
+
+// Reply generates the assistant's reply to the conversation. It returns the new messages in order: the tools called
+// along the way and their results, followed by the assistant's final message, which carries the usage of the whole
+// reply. The messages are not added to the conversation. When the conversation is over the context budget, its summary
+// is updated, see fitContext.
+//
+// When the model calls tools that need the user's approval, the reply stops short of running the calls: the last
+// message returned is then a tool call, carrying the usage so far, whose approval is pending. Once every pending call was
+// approved or rejected, replying to the conversation runs the approved calls and carries on.
+//
+// A reply failing after tokens were spent returns a *ReplyError carrying their usage.
+func (a *Assistant) Reply(ctx context.Context, conv *model.Conversation) ([]*model.Message, error) {
+	return a.ReplyStream(ctx, conv, nil)
 }
 
-func (a *Assistant) Reply(ctx context.Context, conv *model.Conversation) (string, error) {
+// ReplyStream generates a reply just like Reply, but reports its progress to emit while doing so: reply tokens are
+// streamed from the model as they are generated, and tool calls are announced when they start and finish, or when they
+// need approval. A nil emit disables streaming altogether.
+func (a *Assistant) ReplyStream(ctx context.Context, conv *model.Conversation, emit func(Event)) ([]*model.Message, error) {
 	if len(conv.Messages) == 0 {
-		return "", errors.New("conversation has no messages")
+		return nil, errors.New("conversation has no messages")
 	}
 
 	slog.InfoContext(ctx, "Generating reply for conversation", "conversation_id", conv.ID)
 
-	msgs := []openai.ChatCompletionMessageParamUnion{
-		openai.SystemMessage("You are a helpful, concise AI assistant. Provide accurate, safe, and clear responses."),
-	}
+	p := a.profile(conv)
+	// a fallback model may reply instead, see llm.Resilient
+	replyModel := p.model
 
-	for _, m := range conv.Messages {
-		switch m.Role {
-		case model.RoleUser:
-			msgs = append(msgs, openai.UserMessage(m.Content))
-		case model.RoleAssistant:
-			msgs = append(msgs, openai.AssistantMessage(m.Content))
+	spent, err := a.fitContext(ctx, conv, p)
+	failed := func(err error) error {
+		if spent == (llm.Usage{}) {
+			return err
 		}
+		return &ReplyError{Usage: usageOf(replyModel, spent), Err: err}
+	}
+	if err != nil {
+		return nil, failed(err)
+	}
+
+	msgs := prompt(conv, p.system)
+
+	out, err := a.resume(ctx, conv, p, emit)
+	if err != nil {
+		return nil, failed(err)
+	}
+	for _, m := range out {
+		msgs = append(msgs, llm.ToolMessage(m.Content, m.ToolCall.ID))
 	}
 
 	for i := 0; i < 15; i++ {
-		toolDefs := []openai.ChatCompletionToolUnionParam{}
-		for _, tool := range tools.Registry {
-			toolDefs = append(toolDefs, openai.ChatCompletionFunctionTool(openai.FunctionDefinitionParam{
+		toolDefs := []llm.Tool{}
+		for _, tool := range p.tools {
+			toolDefs = append(toolDefs, llm.Tool{
 				Name:        tool.Name(),
-				Description: openai.String(tool.Description()),
+				Description: tool.Description(),
 				Parameters:  tool.Parameters(),
-			}))
+			})
 		}
-		resp, err := a.cli.Chat.Completions.New(ctx, openai.ChatCompletionNewParams{
-			Model:    openai.ChatModelGPT4_1,
-			Messages: msgs,
-			Tools:    toolDefs,
-		})
+
+		resp, err := a.complete(ctx, llm.Request{
+			Model:       p.model,
+			Messages:    msgs,
+			Tools:       toolDefs,
+			Temperature: p.temperature,
+			MaxTokens:   p.maxTokens,
+		}, emit)
 
 		if err != nil {
-			return "", err
+			return nil, failed(err)
 		}
 
-		if len(resp.Choices) == 0 {
-			return "", errors.New("no choices returned by OpenAI")
-		}
+		spent.Add(resp.Usage)
+		replyModel = cmp.Or(resp.Model, p.model)
+		message := &resp.Message
 
-		if message := resp.Choices[0].Message; len(message.ToolCalls) > 0 {
-			msgs = append(msgs, message.ToParam())
+		if len(message.ToolCalls) > 0 {
+			msgs = append(msgs, *message)
 
-			for _, call := range message.ToolCalls {
-				slog.InfoContext(ctx, "Tool call received", "name", call.Function.Name, "args", call.Function.Arguments)
-				tool, ok := tools.Registry[call.Function.Name]
-				if !ok {
-					return "", errors.New("unknown tool call: " + call.Function.Name)
+			calls := make([]*model.Message, len(message.ToolCalls))
+			awaiting := false
+			for i, call := range message.ToolCalls {
+				m := newMessage(model.RoleToolCall, "")
+				m.ToolCall = &model.ToolCall{ID: call.ID, Name: call.Name, Arguments: call.Arguments}
+				if i == 0 {
+					// text accompanying the tool calls, if any
+					m.Content = message.Content
 				}
-				result, err := tool.Handle(ctx, []byte(call.Function.Arguments))
-				if err != nil {
-					msgs = append(msgs, openai.ToolMessage(err.Error(), call.ID))
-					continue
+				if tool, ok := p.tools[call.Name]; ok && tools.NeedsApproval(tool) {
+					m.ToolCall.Approval = model.ApprovalPending
+					awaiting = true
 				}
-				msgs = append(msgs, openai.ToolMessage(result, call.ID))
+				calls[i] = m
+				out = append(out, m)
+			}
+
+			if awaiting {
+				// none of the turn's calls run until the user decided, the reply resumes with them, see resume
+				slog.InfoContext(ctx, "Tool calls await approval", "conversation_id", conv.ID)
+				usage := usageOf(replyModel, spent)
+				calls[len(calls)-1].Usage = &usage
+
+				for _, m := range calls {
+					if m.ToolCall.Approval == model.ApprovalPending && emit != nil {
+						emit(Event{Type: EventToolCallApprovalRequired, ToolCallID: m.ToolCall.ID, ToolName: m.ToolCall.Name, Arguments: m.ToolCall.Arguments})
+					}
+				}
+
+				return out, nil
+			}
+
+			results, err := a.callTools(ctx, p, calls, emit)
+			if err != nil {
+				return nil, failed(err)
+			}
 
+			for _, m := range results {
+				out = append(out, m)
+				msgs = append(msgs, llm.ToolMessage(m.Content, m.ToolCall.ID))
 			}
 
 			continue
 		}
 
-		return resp.Choices[0].Message.Content, nil
+		reply := newMessage(model.RoleAssistant, message.Content)
+		usage := usageOf(replyModel, spent)
+		reply.Usage = &usage
+
+		return append(out, reply), nil
+	}
+
+	return nil, failed(errors.New("too many tool calls, unable to generate reply"))
+}
+
+// history converts the messages of a conversation to the messages sent to the model. Consecutive tool calls were made
+// by a single assistant message, so they are merged back into it.
+func history(messages []*model.Message) []llm.Message {
+	var msgs []llm.Message
+
+	for i, m := range messages {
+		switch m.Role {
+		case model.RoleUser:
+			msgs = append(msgs, llm.UserMessage(m.Content))
+		case model.RoleAssistant:
+			msgs = append(msgs, llm.AssistantMessage(m.Content))
+		case model.RoleToolCall:
+			call := llm.ToolCall{ID: m.ToolCall.ID, Name: m.ToolCall.Name, Arguments: m.ToolCall.Arguments}
+			if i > 0 && messages[i-1].Role == model.RoleToolCall {
+				last := &msgs[len(msgs)-1]
+				last.ToolCalls = append(last.ToolCalls, call)
+				continue
+			}
+			msgs = append(msgs, llm.Message{Role: llm.RoleAssistant, Content: m.Content, ToolCalls: []llm.ToolCall{call}})
+		case model.RoleToolResult:
+			msgs = append(msgs, llm.ToolMessage(m.Content, m.ToolCall.ID))
+		}
 	}
 
-	return "", errors.New("too many tool calls, unable to generate reply")
+	return msgs
+}
+
+func newMessage(role model.Role, content string) *model.Message {
+	now := time.Now()
+	return &model.Message{
+		ID:        primitive.NewObjectID(),
+		Role:      role,
+		Content:   content,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+}
+
+// usageOf prices the tokens used by the model.
+func usageOf(modelName string, u llm.Usage) model.Usage {
+	return model.Usage{
+		Model:            modelName,
+		PromptTokens:     u.PromptTokens,
+		CompletionTokens: u.CompletionTokens,
+		Cost:             llm.LookupModel(modelName).Cost(u),
+	}
+}
+
+// complete requests a single chat completion. When emit is set the completion is streamed and every content delta is
+// forwarded to it.
+func (a *Assistant) complete(ctx context.Context, req llm.Request, emit func(Event)) (*llm.Response, error) {
+	if emit == nil {
+		return a.llm.Complete(ctx, req)
+	}
+
+	return a.llm.Stream(ctx, req, func(delta string) {
+		emit(Event{Type: EventDelta, Delta: delta})
+	})
 }
@@ -0,0 +1,402 @@
+package assistant
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"strings"
+	"testing"
+
+	"github.com/acai-travel/tech-challenge/internal/chat/llm"
+	"github.com/acai-travel/tech-challenge/internal/chat/model"
+	. "github.com/acai-travel/tech-challenge/internal/chat/testing"
+	"github.com/google/go-cmp/cmp"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+func conversation(messages ...string) *model.Conversation {
+	c := &model.Conversation{ID: primitive.NewObjectID()}
+	for i, content := range messages {
+		role := model.RoleUser
+		if i%2 == 1 {
+			role = model.RoleAssistant
+		}
+		c.AddMessage(&model.Message{ID: primitive.NewObjectID(), Role: role, Content: content})
+	}
+	return c
+}
+
+func TestAssistant_Title(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("title is cleaned up and generated with the title model", func(t *testing.T) {
+		p := llm.NewScripted(llm.Reply("\"Weather in Barcelona\"\n"))
+		a := New(WithProvider(p), WithTitleModel("title-model"))
+
+		title, _, err := a.Title(ctx, conversation("What is the weather like in Barcelona?"))
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if title != "Weather in Barcelona" {
+			t.Errorf("expected title %q, got %q", "Weather in Barcelona", title)
+		}
+
+		if got := p.Requests()[0].Model; got != "title-model" {
+			t.Errorf("expected title model to be used, got %q", got)
+		}
+	})
+
+	t.Run("long titles are truncated", func(t *testing.T) {
+		a := New(WithProvider(llm.NewScripted(llm.Reply(strings.Repeat("a", 100)))))
+
+		title, _, err := a.Title(ctx, conversation("Tell me a long story"))
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if len(title) != 80 {
+			t.Errorf("expected title to be truncated to 80 characters, got %d", len(title))
+		}
+	})
+
+	t.Run("empty title is an error", func(t *testing.T) {
+		a := New(WithProvider(llm.NewScripted(llm.Reply("  "))))
+
+		if _, _, err := a.Title(ctx, conversation("Hello")); err == nil {
+			t.Error("expected error for empty title")
+		}
+	})
+}
+
+func TestAssistant_Reply(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("tool calls are answered before replying", func(t *testing.T) {
+		p := llm.NewScripted(
+			llm.CallTools(llm.ToolCall{ID: "call_1", Name: "get_today_date", Arguments: "{}"}),
+			llm.Reply("Today is a good day."),
+		)
+		a := New(WithProvider(p), WithReplyModel("reply-model"))
+
+		msgs, err := a.Reply(ctx, conversation("What day is today?"))
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if len(msgs) != 3 {
+			t.Fatalf("expected tool call, tool result and reply messages, got %d messages", len(msgs))
+		}
+
+		if call := msgs[0]; call.Role != model.RoleToolCall || call.ToolCall.ID != "call_1" || call.ToolCall.Name != "get_today_date" {
+			t.Errorf("unexpected tool call message %+v", call)
+		}
+
+		if result := msgs[1]; result.Role != model.RoleToolResult || result.ToolCall.ID != "call_1" || result.ToolCall.Result == "" || result.Content != result.ToolCall.Result {
+			t.Errorf("unexpected tool result message %+v", result)
+		}
+
+		if reply := msgs[2]; reply.Role != model.RoleAssistant || reply.Content != "Today is a good day." {
+			t.Errorf("unexpected reply %+v", reply)
+		}
+
+		reqs := p.Requests()
+		if len(reqs) != 2 {
+			t.Fatalf("expected 2 requests, got %d", len(reqs))
+		}
+
+		if reqs[1].Model != "reply-model" {
+			t.Errorf("expected reply model to be used, got %q", reqs[1].Model)
+		}
+
+		last := reqs[1].Messages[len(reqs[1].Messages)-1]
+		if last.Role != llm.RoleTool || last.ToolCallID != "call_1" || last.Content == "" {
+			t.Errorf("expected tool result to be sent back to the model, got %+v", last)
+		}
+	})
+
+	t.Run("reply stream reports deltas and tool calls", func(t *testing.T) {
+		p := llm.NewScripted(
+			llm.CallTools(llm.ToolCall{ID: "call_1", Name: "get_today_date", Arguments: "{}"}),
+			llm.Reply("Today is a good day."),
+		)
+		a := New(WithProvider(p))
+
+		var deltas []string
+		var types []EventType
+		msgs, err := a.ReplyStream(ctx, conversation("What day is today?"), func(e Event) {
+			types = append(types, e.Type)
+			if e.Type == EventDelta {
+				deltas = append(deltas, e.Delta)
+			}
+		})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if got, reply := strings.Join(deltas, ""), msgs[len(msgs)-1].Content; got != reply {
+			t.Errorf("expected deltas to add up to the reply %q, got %q", reply, got)
+		}
+
+		if types[0] != EventToolCallStarted || types[1] != EventToolCallFinished {
+			t.Errorf("expected tool call events first, got %v", types)
+		}
+	})
+
+	t.Run("tool messages of previous turns are replayed to the model", func(t *testing.T) {
+		p := llm.NewScripted(llm.Reply("Tomorrow is Tuesday."))
+		a := New(WithProvider(p))
+
+		conv := conversation("What day is today?")
+		for _, m := range []*model.Message{
+			{Role: model.RoleToolCall, ToolCall: &model.ToolCall{ID: "call_1", Name: "get_today_date", Arguments: "{}"}},
+			{Role: model.RoleToolCall, ToolCall: &model.ToolCall{ID: "call_2", Name: "get_holidays", Arguments: "{}"}},
+			{Role: model.RoleToolResult, Content: "2025-08-18", ToolCall: &model.ToolCall{ID: "call_1", Name: "get_today_date"}},
+			{Role: model.RoleToolResult, Content: "no holidays", ToolCall: &model.ToolCall{ID: "call_2", Name: "get_holidays"}},
+			{Role: model.RoleAssistant, Content: "Today is Monday."},
+			{Role: model.RoleUser, Content: "And tomorrow?"},
+		} {
+			conv.AddMessage(m)
+		}
+
+		if _, err := a.Reply(ctx, conv); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		got := p.Requests()[0].Messages[1:]
+		want := []llm.Message{
+			llm.UserMessage("What day is today?"),
+			{Role: llm.RoleAssistant, ToolCalls: []llm.ToolCall{
+				{ID: "call_1", Name: "get_today_date", Arguments: "{}"},
+				{ID: "call_2", Name: "get_holidays", Arguments: "{}"},
+			}},
+			llm.ToolMessage("2025-08-18", "call_1"),
+			llm.ToolMessage("no holidays", "call_2"),
+			llm.AssistantMessage("Today is Monday."),
+			llm.UserMessage("And tomorrow?"),
+		}
+
+		if !cmp.Equal(got, want) {
+			t.Errorf("replayed messages mismatch (-got +want):\n%s", cmp.Diff(got, want))
+		}
+	})
+}
+
+func TestAssistant_OpenAI(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("reply with tool calls over the chat completions API", func(t *testing.T) {
+		f := StartFakeOpenAI(t).
+			CallTools(FakeToolCall{ID: "call_1", Name: "get_today_date", Arguments: "{}"}).WithUsage(100, 10).
+			Reply("Today is a good day.").WithUsage(120, 20)
+		a := New(WithBaseURL(f.URL))
+
+		msgs, err := a.Reply(ctx, conversation("What day is today?"))
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if reply := msgs[len(msgs)-1].Content; reply != "Today is a good day." {
+			t.Errorf("unexpected reply %q", reply)
+		}
+
+		if usage := msgs[len(msgs)-1].Usage; usage.PromptTokens != 220 || usage.CompletionTokens != 30 {
+			t.Errorf("expected usage to be read from the responses, got %+v", usage)
+		}
+
+		reqs := f.Requests()
+		if len(reqs) != 2 {
+			t.Fatalf("expected 2 requests, got %d", len(reqs))
+		}
+
+		sent := reqs[1].Messages
+		if call := sent[len(sent)-2]; len(call.ToolCalls) != 1 || call.ToolCalls[0].Function.Name != "get_today_date" {
+			t.Errorf("expected tool call to be sent back to the model, got %+v", call)
+		}
+		if result := sent[len(sent)-1]; result.Role != "tool" || result.ToolCallID != "call_1" {
+			t.Errorf("expected tool result to be sent back to the model, got %+v", result)
+		}
+	})
+
+	t.Run("streamed reply with tool calls over the chat completions API", func(t *testing.T) {
+		f := StartFakeOpenAI(t).
+			CallTools(FakeToolCall{ID: "call_1", Name: "get_today_date", Arguments: "{}"}).WithUsage(100, 10).
+			Reply("Today is a good day.").WithUsage(120, 20)
+		a := New(WithBaseURL(f.URL))
+
+		var deltas []string
+		msgs, err := a.ReplyStream(ctx, conversation("What day is today?"), func(e Event) {
+			if e.Type == EventDelta {
+				deltas = append(deltas, e.Delta)
+			}
+		})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if reply := msgs[len(msgs)-1].Content; reply != "Today is a good day." || strings.Join(deltas, "") != reply {
+			t.Errorf("unexpected reply %q from deltas %q", reply, deltas)
+		}
+
+		if usage := msgs[len(msgs)-1].Usage; usage.PromptTokens != 220 || usage.CompletionTokens != 30 {
+			t.Errorf("expected usage to be read from the end of the streams, got %+v", usage)
+		}
+
+		for _, req := range f.Requests() {
+			if !req.Stream || !req.StreamOptions.IncludeUsage {
+				t.Error("expected streamed requests including usage")
+			}
+		}
+	})
+}
+
+func TestAssistant_ContextBudget(t *testing.T) {
+	ctx := context.Background()
+
+	// every message takes roughly 30 tokens, so a budget of 160 tokens holds about 5 of them
+	turns := func(conv *model.Conversation, n int) {
+		for range n {
+			role := model.RoleUser
+			if len(conv.Messages)%2 == 1 {
+				role = model.RoleAssistant
+			}
+			content := fmt.Sprintf("message-%d %s", len(conv.Messages), strings.Repeat("word ", 20))
+			conv.AddMessage(&model.Message{ID: primitive.NewObjectID(), Role: role, Content: content})
+		}
+	}
+
+	t.Run("conversation within budget is sent as is", func(t *testing.T) {
+		p := llm.NewScripted(llm.Reply("Hello!"))
+		a := New(WithProvider(p), WithContextBudget(160))
+
+		conv := conversation()
+		turns(conv, 3)
+
+		if _, err := a.Reply(ctx, conv); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if conv.Summary != nil || len(p.Requests()) != 1 || len(p.Requests()[0].Messages) != 4 {
+			t.Errorf("expected no summary, got %+v", conv.Summary)
+		}
+	})
+
+	t.Run("older turns are replaced by a summary that is extended incrementally", func(t *testing.T) {
+		p := llm.NewScripted(llm.Reply("First summary."), llm.Reply("First answer."))
+		a := New(WithProvider(p), WithContextBudget(160))
+
+		conv := conversation()
+		turns(conv, 13)
+
+		if _, err := a.Reply(ctx, conv); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if conv.Summary == nil || conv.Summary.Content != "First summary." || conv.Summary.Position != 12 {
+			t.Fatalf("expected the turns before the last question to be summarized, got %+v", conv.Summary)
+		}
+
+		reqs := p.Requests()
+		if summarized := reqs[0].Messages[1].Content; !strings.Contains(summarized, "message-0 ") || !strings.Contains(summarized, "message-11 ") || strings.Contains(summarized, "message-12 ") {
+			t.Errorf("unexpected messages summarized: %s", summarized)
+		}
+
+		if sent := reqs[1].Messages; len(sent) != 3 || !strings.Contains(sent[1].Content, "First summary.") || !strings.HasPrefix(sent[2].Content, "message-12 ") {
+			t.Errorf("expected the reply to be based on the summary and the last question, got %+v", sent)
+		}
+
+		// the conversation goes on until it is over budget again
+		p.Script(llm.Reply("Second summary."), llm.Reply("Second answer."))
+		turns(conv, 4)
+
+		if _, err := a.Reply(ctx, conv); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		summarized := p.Requests()[2].Messages[1].Content
+		if !strings.Contains(summarized, "First summary.") || strings.Contains(summarized, "message-11 ") || !strings.Contains(summarized, "message-12 ") {
+			t.Errorf("expected only the messages since the last summary to be summarized, got: %s", summarized)
+		}
+
+		if conv.Summary.Content != "Second summary." || conv.Summary.Position != 16 {
+			t.Errorf("unexpected summary %+v", conv.Summary)
+		}
+	})
+}
+
+func TestAssistant_Usage(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("title usage is priced by the title model", func(t *testing.T) {
+		a := New(WithProvider(llm.NewScripted(llm.Reply("Weather").WithUsage(1000, 100))), WithTitleModel("o1"))
+
+		_, usage, err := a.Title(ctx, conversation("What is the weather like?"))
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if usage.Model != "o1" || usage.PromptTokens != 1000 || usage.CompletionTokens != 100 || math.Abs(usage.Cost-0.021) > 1e-9 {
+			t.Errorf("unexpected usage %+v", usage)
+		}
+	})
+
+	t.Run("reply carries the usage of every completion leading to it", func(t *testing.T) {
+		p := llm.NewScripted(
+			llm.CallTools(llm.ToolCall{ID: "call_1", Name: "get_today_date", Arguments: "{}"}).WithUsage(1000, 50),
+			llm.Reply("Today is a good day.").WithUsage(1200, 100),
+		)
+		a := New(WithProvider(p), WithReplyModel("gpt-4.1"))
+
+		msgs, err := a.Reply(ctx, conversation("What day is today?"))
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		for _, m := range msgs[:len(msgs)-1] {
+			if m.Usage != nil {
+				t.Errorf("expected tool messages to have no usage, got %+v", m.Usage)
+			}
+		}
+
+		usage := msgs[len(msgs)-1].Usage
+		if usage == nil || usage.Model != "gpt-4.1" || usage.PromptTokens != 2200 || usage.CompletionTokens != 150 || math.Abs(usage.Cost-0.0056) > 1e-9 {
+			t.Errorf("unexpected usage %+v", usage)
+		}
+	})
+	t.Run("failed reply carries the usage of the summary made before failing", func(t *testing.T) {
+		a := New(WithProvider(llm.NewScripted(llm.Reply("Summary.").WithUsage(1000, 100))), WithReplyModel("gpt-4.1"), WithContextBudget(160))
+
+		conv := conversation()
+		for i := range 13 {
+			conv.AddMessage(&model.Message{ID: primitive.NewObjectID(), Role: model.RoleUser, Content: fmt.Sprintf("message-%d %s", i, strings.Repeat("word ", 20))})
+		}
+		// the approved call can not be resumed, its tool is gone
+		conv.AddMessage(&model.Message{ID: primitive.NewObjectID(), Role: model.RoleToolCall, ToolCall: &model.ToolCall{ID: "call_1", Name: "gone", Arguments: "{}", Approval: model.ApprovalApproved}})
+
+		_, err := a.Reply(ctx, conv)
+
+		var re *ReplyError
+		if !errors.As(err, &re) {
+			t.Fatalf("expected a ReplyError, got %v", err)
+		}
+
+		if re.Usage.Model != "gpt-4.1" || re.Usage.PromptTokens != 1000 || re.Usage.CompletionTokens != 100 {
+			t.Errorf("unexpected usage %+v", re.Usage)
+		}
+	})
+	t.Run("usage is priced by the model that replied", func(t *testing.T) {
+		reply := llm.Reply("Today is a good day.").WithUsage(1000, 100)
+		reply.Model = "gpt-4.1-mini"
+		a := New(WithProvider(llm.NewScripted(reply)), WithReplyModel("gpt-4.1"))
+
+		msgs, err := a.Reply(ctx, conversation("What day is today?"))
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if usage := msgs[len(msgs)-1].Usage; usage == nil || usage.Model != "gpt-4.1-mini" {
+			t.Errorf("expected the fallback model to be priced, got %+v", usage)
+		}
+	})
+}
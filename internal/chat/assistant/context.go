@@ -0,0 +1,115 @@
+package assistant
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/acai-travel/tech-challenge/internal/chat/llm"
+	"github.com/acai-travel/tech-challenge/internal/chat/model"
+)
+
+const summaryPrompt = "You keep a running summary of a conversation between a user and an AI assistant, it replaces " +
+	"the older messages once the conversation gets too long. Extend the summary with the new messages. Keep the facts, " +
+	"names, numbers, decisions and open questions needed to carry on the conversation, leave out small talk. Reply " +
+	"with the updated summary only."
+
+// prompt returns the messages sent to the model to reply to the conversation: the system prompt, the summary of the
+// older messages if there is one, and the messages that follow it.
+func prompt(conv *model.Conversation, system llm.Message) []llm.Message {
+	msgs := []llm.Message{system}
+
+	if conv.Summary != nil {
+		msgs = append(msgs, llm.SystemMessage("Summary of the earlier conversation:\n"+conv.Summary.Content))
+	}
+
+	return append(msgs, history(conv.Messages[conv.Summarized():])...)
+}
+
+// contextBudget returns the number of tokens the prompt for the model may take, the rest of the context window is left
+// for tool results and the reply.
+func (a *Assistant) contextBudget(info llm.ModelInfo) int {
+	if a.budget > 0 {
+		return a.budget
+	}
+	return info.ContextWindow * 3 / 4
+}
+
+// fitContext makes sure the conversation fits the context budget. When it does not, the oldest turns are folded into
+// the conversation's rolling summary, keeping as many recent turns as fit in half of the budget. The summary is only
+// extended with the messages that followed it, and it is left to the caller to persist it along with the conversation.
+// It returns the tokens used to summarize, if any.
+func (a *Assistant) fitContext(ctx context.Context, conv *model.Conversation, p profile) (llm.Usage, error) {
+	info := llm.LookupModel(p.model)
+	budget := a.contextBudget(info)
+
+	if info.CountMessages(prompt(conv, p.system)...) <= budget {
+		return llm.Usage{}, nil
+	}
+
+	// cut at a question so tool calls stay with their results, the last question is always kept
+	start, cut := conv.Summarized(), -1
+	for i := len(conv.Messages) - 1; i > start; i-- {
+		if conv.Messages[i].Role != model.RoleUser {
+			continue
+		}
+		if cut >= 0 && info.CountMessages(history(conv.Messages[i:])...) > budget/2 {
+			break
+		}
+		cut = i
+	}
+
+	if cut < 0 {
+		slog.WarnContext(ctx, "Conversation is over the context budget but has nothing left to summarize", "conversation_id", conv.ID)
+		return llm.Usage{}, nil
+	}
+
+	slog.InfoContext(ctx, "Summarizing older messages of conversation", "conversation_id", conv.ID, "from", start, "to", cut)
+
+	previous := "(none yet)"
+	if conv.Summary != nil {
+		previous = conv.Summary.Content
+	}
+
+	resp, err := a.llm.Complete(ctx, llm.Request{
+		Model: p.model,
+		Messages: []llm.Message{
+			llm.SystemMessage(summaryPrompt),
+			llm.UserMessage("Summary so far:\n" + previous + "\n\nNew messages:\n" + transcript(conv.Messages[start:cut])),
+		},
+	})
+	if err != nil {
+		return llm.Usage{}, fmt.Errorf("failed to summarize conversation: %w", err)
+	}
+
+	summary := strings.TrimSpace(resp.Message.Content)
+	if summary == "" {
+		return resp.Usage, errors.New("empty response from the model for conversation summary")
+	}
+
+	conv.Summary = &model.Summary{Content: summary, Position: cut, UpdatedAt: time.Now()}
+	return resp.Usage, nil
+}
+
+// transcript renders messages as plain text for the summary.
+func transcript(messages []*model.Message) string {
+	var sb strings.Builder
+
+	for _, m := range messages {
+		switch m.Role {
+		case model.RoleUser:
+			fmt.Fprintf(&sb, "User: %s\n", m.Content)
+		case model.RoleAssistant:
+			fmt.Fprintf(&sb, "Assistant: %s\n", m.Content)
+		case model.RoleToolCall:
+			fmt.Fprintf(&sb, "Assistant called %s(%s)\n", m.ToolCall.Name, m.ToolCall.Arguments)
+		case model.RoleToolResult:
+			fmt.Fprintf(&sb, "%s returned: %s\n", m.ToolCall.Name, m.Content)
+		}
+	}
+
+	return sb.String()
+}
@@ -0,0 +1,29 @@
+package assistant
+
+// EventType identifies the kind of progress reported while a reply is being generated.
+type EventType string
+
+const (
+	// EventDelta carries a chunk of the reply text as soon as the model produces it.
+	EventDelta EventType = "delta"
+	// EventToolCallStarted is emitted right before a tool is invoked.
+	EventToolCallStarted EventType = "tool_call_started"
+	// EventToolCallFinished is emitted once a tool returned, Error is set if it failed.
+	EventToolCallFinished EventType = "tool_call_finished"
+	// EventToolCallApprovalRequired is emitted for each tool call the reply stopped at to wait for the user's approval.
+	EventToolCallApprovalRequired EventType = "tool_call_approval_required"
+	// EventTitleUpdated is emitted by the server once the title of a new conversation was generated, it is not part of
+	// ReplyStream.
+	EventTitleUpdated EventType = "title_updated"
+)
+
+// Event reports progress of ReplyStream.
+type Event struct {
+	Type       EventType `json:"-"`
+	Delta      string    `json:"delta,omitempty"`
+	ToolCallID string    `json:"tool_call_id,omitempty"`
+	ToolName   string    `json:"tool_name,omitempty"`
+	Arguments  string    `json:"arguments,omitempty"`
+	Error      string    `json:"error,omitempty"`
+	Title      string    `json:"title,omitempty"`
+}
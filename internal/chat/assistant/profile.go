@@ -0,0 +1,79 @@
+package assistant
+
+import (
+	"slices"
+
+	"github.com/acai-travel/tech-challenge/internal/chat/llm"
+	"github.com/acai-travel/tech-challenge/internal/chat/model"
+	"github.com/acai-travel/tech-challenge/internal/chat/tools"
+)
+
+// profile shapes the replies to a conversation. The conversation's settings take precedence over its persona, which
+// takes precedence over its tenant, which takes precedence over the assistant's defaults. The tools allowed by the
+// tenant bound those of the persona.
+type profile struct {
+	model       string
+	system      llm.Message
+	temperature *float64
+	maxTokens   int
+
+	// tools the model is offered, and allowed to call
+	tools map[string]tools.Tool
+}
+
+func (a *Assistant) profile(conv *model.Conversation) profile {
+	p := profile{
+		model:       a.replyModel,
+		system:      llm.SystemMessage(defaultSystemPrompt),
+		temperature: conv.Settings.Temperature,
+		maxTokens:   conv.Settings.MaxTokens,
+		tools:       tools.Registry,
+	}
+
+	if tenant := conv.Tenant; tenant != nil {
+		if tenant.Model != "" {
+			p.model = tenant.Model
+		}
+
+		if tenant.SystemPrompt != "" {
+			p.system = llm.SystemMessage(tenant.SystemPrompt)
+		}
+	}
+
+	if persona := conv.Persona; persona != nil {
+		if persona.Model != "" {
+			p.model = persona.Model
+		}
+
+		if persona.SystemPrompt != "" {
+			p.system = llm.SystemMessage(persona.SystemPrompt)
+		}
+
+		p.tools = map[string]tools.Tool{}
+		for _, name := range persona.Tools {
+			if tool, ok := tools.Registry[name]; ok {
+				p.tools[name] = tool
+			}
+		}
+	}
+
+	if tenant := conv.Tenant; tenant != nil && len(tenant.Tools) > 0 {
+		allowed := map[string]tools.Tool{}
+		for name, tool := range p.tools {
+			if slices.Contains(tenant.Tools, name) {
+				allowed[name] = tool
+			}
+		}
+		p.tools = allowed
+	}
+
+	if conv.Settings.Model != "" {
+		p.model = conv.Settings.Model
+	}
+
+	if conv.Settings.SystemPrompt != "" {
+		p.system = llm.SystemMessage(conv.Settings.SystemPrompt)
+	}
+
+	return p
+}
@@ -0,0 +1,141 @@
+package assistant
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/acai-travel/tech-challenge/internal/chat/model"
+	"github.com/acai-travel/tech-challenge/internal/chat/tools"
+)
+
+// defaultToolConcurrency is how many tool calls of a single turn run at the same time.
+const defaultToolConcurrency = 4
+
+// resume runs the tool calls ending the conversation, whose reply stopped to wait for the user's approval, and returns
+// their results. It fails if some calls are still pending.
+func (a *Assistant) resume(ctx context.Context, conv *model.Conversation, p profile, emit func(Event)) ([]*model.Message, error) {
+	if len(conv.PendingToolCalls()) > 0 {
+		return nil, errors.New("tool calls are awaiting approval")
+	}
+
+	first := len(conv.Messages)
+	for first > 0 && conv.Messages[first-1].Role == model.RoleToolCall {
+		first--
+	}
+
+	if first == len(conv.Messages) {
+		return nil, nil
+	}
+
+	slog.InfoContext(ctx, "Resuming reply with the decided tool calls", "conversation_id", conv.ID)
+	return a.callTools(ctx, p, conv.Messages[first:], emit)
+}
+
+// callTools runs the tool calls requested by the model in a single turn concurrently, at most a.toolConcurrency at a
+// time, and returns their results in call order. Calls the user rejected do not run.
+func (a *Assistant) callTools(ctx context.Context, p profile, calls []*model.Message, emit func(Event)) ([]*model.Message, error) {
+	for _, m := range calls {
+		if _, ok := p.tools[m.ToolCall.Name]; !ok {
+			return nil, errors.New("unknown tool call: " + m.ToolCall.Name)
+		}
+	}
+
+	// events are reported from the workers, emit does not need to be safe for concurrent use
+	var mu sync.Mutex
+	report := func(e Event) {
+		if emit != nil {
+			mu.Lock()
+			defer mu.Unlock()
+			emit(e)
+		}
+	}
+
+	results := make([]*model.Message, len(calls))
+	sem := make(chan struct{}, max(a.toolConcurrency, 1))
+
+	var wg sync.WaitGroup
+	for i, m := range calls {
+		call := *m.ToolCall
+
+		if call.Approval == model.ApprovalRejected {
+			slog.InfoContext(ctx, "Tool call rejected by the user", "name", call.Name)
+			results[i] = rejectedCall(call)
+			report(Event{Type: EventToolCallFinished, ToolCallID: call.ID, ToolName: call.Name, Error: results[i].ToolCall.Error})
+			continue
+		}
+
+		slog.InfoContext(ctx, "Tool call received", "name", call.Name, "args", call.Arguments)
+		report(Event{Type: EventToolCallStarted, ToolCallID: call.ID, ToolName: call.Name, Arguments: call.Arguments})
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			sem <- struct{}{}
+			defer func() { <-sem }()
+
+			m := callTool(ctx, p.tools[call.Name], call)
+			results[i] = m
+
+			report(Event{Type: EventToolCallFinished, ToolCallID: call.ID, ToolName: call.Name, Error: m.ToolCall.Error})
+		}()
+	}
+	wg.Wait()
+
+	return results, nil
+}
+
+// callTool calls the tool and returns the message with its result. Timeouts are reported to the model as a structured
+// error, so it can tell them apart from the tool's own failures.
+func callTool(ctx context.Context, tool tools.Tool, call model.ToolCall) *model.Message {
+	start := time.Now()
+	result, err := tools.Call(ctx, tool, json.RawMessage(call.Arguments))
+
+	m := newMessage(model.RoleToolResult, result)
+	m.ToolCall = &model.ToolCall{ID: call.ID, Name: call.Name, Arguments: call.Arguments, Result: result, Duration: time.Since(start)}
+
+	switch {
+	case errors.Is(err, tools.ErrTimeout):
+		slog.WarnContext(ctx, "Tool call timed out", "name", call.Name, "timeout", tools.TimeoutOf(tool))
+		m.Content = timeoutResult(tool)
+		m.ToolCall.Error = err.Error()
+	case err != nil:
+		m.Content = err.Error()
+		m.ToolCall.Error = err.Error()
+	}
+
+	return m
+}
+
+// rejectedCall returns the result of a tool call the user rejected, reported to the model as a structured error.
+func rejectedCall(call model.ToolCall) *model.Message {
+	b, _ := json.Marshal(map[string]any{
+		"error": map[string]any{
+			"type":    "rejected",
+			"message": "The user rejected the call, it did not run. Do not call it again unless the user asks to.",
+			"tool":    call.Name,
+			"reason":  call.RejectionReason,
+		},
+	})
+
+	m := newMessage(model.RoleToolResult, string(b))
+	m.ToolCall = &model.ToolCall{ID: call.ID, Name: call.Name, Arguments: call.Arguments, Error: "rejected by the user"}
+	return m
+}
+
+// timeoutResult is the result reported to the model for a tool call that timed out.
+func timeoutResult(tool tools.Tool) string {
+	b, _ := json.Marshal(map[string]any{
+		"error": map[string]any{
+			"type":            "timeout",
+			"message":         "The tool did not respond in time, the result is unknown. Try again later, or answer without it.",
+			"tool":            tool.Name(),
+			"timeout_seconds": tools.TimeoutOf(tool).Seconds(),
+		},
+	})
+	return string(b)
+}
@@ -0,0 +1,220 @@
+package assistant
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/acai-travel/tech-challenge/internal/chat/llm"
+	"github.com/acai-travel/tech-challenge/internal/chat/model"
+	"github.com/acai-travel/tech-challenge/internal/chat/tools"
+	"github.com/openai/openai-go/v2"
+)
+
+// fakeTool is a tool replying with handle, registered for the duration of a test.
+type fakeTool struct {
+	name     string
+	timeout  time.Duration
+	approval bool
+	handle   func(ctx context.Context, args json.RawMessage) (string, error)
+}
+
+func (f fakeTool) Name() string                          { return f.name }
+func (f fakeTool) Description() string                   { return "A tool for tests" }
+func (f fakeTool) Parameters() openai.FunctionParameters { return nil }
+func (f fakeTool) Timeout() time.Duration                { return f.timeout }
+func (f fakeTool) RequiresApproval() bool                { return f.approval }
+func (f fakeTool) Handle(ctx context.Context, args json.RawMessage) (string, error) {
+	return f.handle(ctx, args)
+}
+
+func registerTool(t *testing.T, tool fakeTool) {
+	tools.Register(tool)
+	t.Cleanup(func() { delete(tools.Registry, tool.name) })
+}
+
+func TestAssistant_ToolCalls(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("calls of a turn run concurrently and their results keep the call order", func(t *testing.T) {
+		// the first calls are the slowest, so they finish last
+		registerTool(t, fakeTool{name: "slow_echo", handle: func(ctx context.Context, args json.RawMessage) (string, error) {
+			var delay int
+			if err := json.Unmarshal(args, &delay); err != nil {
+				return "", err
+			}
+			time.Sleep(time.Duration(delay) * time.Millisecond)
+			return fmt.Sprint(delay), nil
+		}})
+
+		p := llm.NewScripted(
+			llm.CallTools(
+				llm.ToolCall{ID: "call_1", Name: "slow_echo", Arguments: "300"},
+				llm.ToolCall{ID: "call_2", Name: "slow_echo", Arguments: "200"},
+				llm.ToolCall{ID: "call_3", Name: "slow_echo", Arguments: "100"},
+			),
+			llm.Reply("Done."),
+		)
+		a := New(WithProvider(p))
+
+		start := time.Now()
+		msgs, err := a.Reply(ctx, conversation("Echo slowly"))
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if elapsed := time.Since(start); elapsed >= 600*time.Millisecond {
+			t.Errorf("expected the calls to run concurrently, took %s", elapsed)
+		}
+
+		results := msgs[3:6]
+		for i, want := range []string{"300", "200", "100"} {
+			if m := results[i]; m.Role != model.RoleToolResult || m.ToolCall.ID != fmt.Sprintf("call_%d", i+1) || m.Content != want {
+				t.Errorf("expected result %d to be %q, got %+v", i, want, m)
+			}
+		}
+
+		sent := p.Requests()[1].Messages
+		for i, m := range sent[len(sent)-3:] {
+			if m.ToolCallID != fmt.Sprintf("call_%d", i+1) {
+				t.Errorf("expected the results to be sent back in call order, got %q at %d", m.ToolCallID, i)
+			}
+		}
+	})
+
+	t.Run("concurrency is bounded", func(t *testing.T) {
+		var running, peak atomic.Int32
+		registerTool(t, fakeTool{name: "busy", handle: func(ctx context.Context, args json.RawMessage) (string, error) {
+			n := running.Add(1)
+			defer running.Add(-1)
+			for {
+				if p := peak.Load(); n <= p || peak.CompareAndSwap(p, n) {
+					break
+				}
+			}
+			time.Sleep(20 * time.Millisecond)
+			return "done", nil
+		}})
+
+		var calls []llm.ToolCall
+		for i := range 6 {
+			calls = append(calls, llm.ToolCall{ID: fmt.Sprintf("call_%d", i), Name: "busy", Arguments: "{}"})
+		}
+		a := New(WithProvider(llm.NewScripted(llm.CallTools(calls...), llm.Reply("Done."))), WithToolConcurrency(2))
+
+		if _, err := a.Reply(ctx, conversation("Keep busy")); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if n := peak.Load(); n != 2 {
+			t.Errorf("expected at most 2 calls at a time, got %d", n)
+		}
+	})
+
+	t.Run("timeouts reach the model as a structured error", func(t *testing.T) {
+		registerTool(t, fakeTool{name: "stuck", timeout: 20 * time.Millisecond, handle: func(ctx context.Context, args json.RawMessage) (string, error) {
+			// ignores ctx, the call must not wait for it
+			time.Sleep(time.Second)
+			return "too late", nil
+		}})
+
+		p := llm.NewScripted(
+			llm.CallTools(llm.ToolCall{ID: "call_1", Name: "stuck", Arguments: "{}"}),
+			llm.Reply("The tool is not responding."),
+		)
+		a := New(WithProvider(p))
+
+		start := time.Now()
+		msgs, err := a.Reply(ctx, conversation("Are you stuck?"))
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if elapsed := time.Since(start); elapsed >= time.Second {
+			t.Errorf("expected the call to time out, took %s", elapsed)
+		}
+
+		result := msgs[1]
+		if result.ToolCall.Error == "" {
+			t.Errorf("expected the timeout to be recorded, got %+v", result.ToolCall)
+		}
+
+		var body struct {
+			Error struct {
+				Type string `json:"type"`
+				Tool string `json:"tool"`
+			} `json:"error"`
+		}
+		if err := json.Unmarshal([]byte(p.Requests()[1].Messages[len(p.Requests()[1].Messages)-1].Content), &body); err != nil {
+			t.Fatalf("expected a JSON error result, got %v", err)
+		}
+		if body.Error.Type != "timeout" || body.Error.Tool != "stuck" {
+			t.Errorf("unexpected error result %+v", body)
+		}
+	})
+	t.Run("calls needing approval stop the reply until they are decided", func(t *testing.T) {
+		var booked atomic.Int32
+		registerTool(t, fakeTool{name: "book", approval: true, handle: func(ctx context.Context, args json.RawMessage) (string, error) {
+			booked.Add(1)
+			return "booked", nil
+		}})
+
+		p := llm.NewScripted(
+			llm.CallTools(
+				llm.ToolCall{ID: "call_1", Name: "book", Arguments: `"Barcelona"`},
+				llm.ToolCall{ID: "call_2", Name: "book", Arguments: `"Madrid"`},
+			).WithUsage(100, 10),
+			llm.Reply("Barcelona is booked."),
+		)
+		a := New(WithProvider(p))
+
+		conv := conversation("Book Barcelona and Madrid")
+		msgs, err := a.Reply(ctx, conv)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if len(msgs) != 2 || booked.Load() != 0 {
+			t.Fatalf("expected the reply to stop before running the calls, got %d messages after %d bookings", len(msgs), booked.Load())
+		}
+
+		for _, m := range msgs {
+			if m.Role != model.RoleToolCall || m.ToolCall.Approval != model.ApprovalPending {
+				t.Errorf("expected a pending tool call, got %+v", m)
+			}
+		}
+
+		if u := msgs[1].Usage; u == nil || u.PromptTokens != 100 {
+			t.Errorf("expected the usage on the last call, got %+v", u)
+		}
+
+		for _, m := range msgs {
+			conv.AddMessage(m)
+		}
+
+		if _, err := a.Reply(ctx, conv); err == nil {
+			t.Errorf("expected the reply not to resume while calls are pending")
+		}
+
+		msgs[0].ToolCall.Approval = model.ApprovalApproved
+		msgs[1].ToolCall.Approval = model.ApprovalRejected
+		msgs[1].ToolCall.RejectionReason = "only one city"
+
+		resumed, err := a.Reply(ctx, conv)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if len(resumed) != 3 || resumed[2].Content != "Barcelona is booked." || booked.Load() != 1 {
+			t.Fatalf("expected only the approved call to run before the reply, got %d messages after %d bookings", len(resumed), booked.Load())
+		}
+
+		if rejected := resumed[1]; rejected.ToolCall.Error == "" || !strings.Contains(rejected.Content, "only one city") {
+			t.Errorf("expected the rejection to be reported to the model, got %+v", rejected)
+		}
+	})
+}
@@ -0,0 +1,240 @@
+// Package budget caps how much each user, and all users together, may spend on the models per day and per month.
+package budget
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/acai-travel/tech-challenge/internal/chat/model"
+	"github.com/twitchtv/twirp"
+)
+
+const defaultSoftLimit = 0.8
+
+// Limit caps the tokens, prompt and completion together, and the cost in US dollars spent over a period. Zero values
+// are unlimited.
+type Limit = model.Limit
+
+// Limits are the budgets enforced per user and for all users together, days and months are in UTC.
+type Limits struct {
+	UserDaily     Limit
+	UserMonthly   Limit
+	GlobalDaily   Limit
+	GlobalMonthly Limit
+}
+
+// Budget records the usage of every reply and refuses new ones once a limit is exhausted. Usage is checked before
+// replying and recorded afterwards, so concurrent replies may overshoot a limit slightly.
+type Budget struct {
+	store  model.UsageCounterStore
+	limits Limits
+
+	// soft is the share of a limit past which a warning is logged
+	soft float64
+	now  func() time.Time
+}
+
+type Option func(*Budget)
+
+// WithLimits sets the limits, instead of the ones configured by the environment.
+func WithLimits(limits Limits) Option {
+	return func(b *Budget) { b.limits = limits }
+}
+
+// WithSoftLimit sets the share of a limit, between 0 and 1, past which a warning is logged.
+func WithSoftLimit(share float64) Option {
+	return func(b *Budget) { b.soft = share }
+}
+
+// WithClock sets the clock deciding the current day and month, e.g. in tests.
+func WithClock(now func() time.Time) Option {
+	return func(b *Budget) { b.now = now }
+}
+
+// New creates a budget counting usage in store. Unless options say otherwise, the limits are read from
+// BUDGET_{USER,GLOBAL}_{DAILY,MONTHLY}_{TOKENS,COST}, e.g. BUDGET_USER_DAILY_COST=5, and the soft limit from
+// BUDGET_SOFT_LIMIT, 0.8 by default. New panics if the environment is misconfigured.
+func New(store model.UsageCounterStore, opts ...Option) *Budget {
+	b := &Budget{
+		store: store,
+		soft:  defaultSoftLimit,
+		now:   time.Now,
+		limits: Limits{
+			UserDaily:     limitFromEnv("BUDGET_USER_DAILY"),
+			UserMonthly:   limitFromEnv("BUDGET_USER_MONTHLY"),
+			GlobalDaily:   limitFromEnv("BUDGET_GLOBAL_DAILY"),
+			GlobalMonthly: limitFromEnv("BUDGET_GLOBAL_MONTHLY"),
+		},
+	}
+
+	if v := os.Getenv("BUDGET_SOFT_LIMIT"); v != "" {
+		soft, err := strconv.ParseFloat(v, 64)
+		if err != nil || soft <= 0 || soft > 1 {
+			panic(fmt.Errorf("invalid BUDGET_SOFT_LIMIT %q, expected a share between 0 and 1", v))
+		}
+		b.soft = soft
+	}
+
+	for _, opt := range opts {
+		opt(b)
+	}
+
+	return b
+}
+
+func limitFromEnv(prefix string) Limit {
+	var l Limit
+
+	if v := os.Getenv(prefix + "_TOKENS"); v != "" {
+		tokens, err := strconv.Atoi(v)
+		if err != nil || tokens < 0 {
+			panic(fmt.Errorf("invalid %s_TOKENS %q, expected a number of tokens", prefix, v))
+		}
+		l.Tokens = tokens
+	}
+
+	if v := os.Getenv(prefix + "_COST"); v != "" {
+		cost, err := strconv.ParseFloat(v, 64)
+		if err != nil || cost < 0 {
+			panic(fmt.Errorf("invalid %s_COST %q, expected an amount of US dollars", prefix, v))
+		}
+		l.Cost = cost
+	}
+
+	return l
+}
+
+// period is a limit applied to a user, a tenant, or to everyone, over a day or a month.
+type period struct {
+	name   string
+	key    string
+	limit  Limit
+	resets time.Time
+}
+
+// periods returns the periods the usage of the user counts towards at the given time. Anonymous users, with an empty
+// ID, only count towards the global and tenant ones. Users of a tenant count towards its budgets too, and its user
+// limits replace the server's ones. User IDs are only unique within a tenant, so their usage is counted per tenant.
+func (b *Budget) periods(user string, tenant *model.Tenant, at time.Time) []period {
+	at = at.UTC()
+	day, month := at.Format("2006-01-02"), at.Format("2006-01")
+	tomorrow := time.Date(at.Year(), at.Month(), at.Day()+1, 0, 0, 0, 0, time.UTC)
+	nextMonth := time.Date(at.Year(), at.Month()+1, 1, 0, 0, 0, 0, time.UTC)
+
+	periods := []period{
+		{name: "global_daily", key: "global:day:" + day, limit: b.limits.GlobalDaily, resets: tomorrow},
+		{name: "global_monthly", key: "global:month:" + month, limit: b.limits.GlobalMonthly, resets: nextMonth},
+	}
+
+	userDaily, userMonthly := b.limits.UserDaily, b.limits.UserMonthly
+	userPrefix := "user:" + user
+
+	if tenant != nil {
+		prefix := "tenant:" + tenant.ID
+		userPrefix = prefix + ":" + userPrefix
+		periods = append(periods,
+			period{name: "tenant_daily", key: prefix + ":day:" + day, limit: tenant.Budgets.Daily, resets: tomorrow},
+			period{name: "tenant_monthly", key: prefix + ":month:" + month, limit: tenant.Budgets.Monthly, resets: nextMonth},
+		)
+
+		if tenant.Budgets.UserDaily != (Limit{}) {
+			userDaily = tenant.Budgets.UserDaily
+		}
+		if tenant.Budgets.UserMonthly != (Limit{}) {
+			userMonthly = tenant.Budgets.UserMonthly
+		}
+	}
+
+	if user != "" {
+		periods = append(periods,
+			period{name: "user_daily", key: userPrefix + ":day:" + day, limit: userDaily, resets: tomorrow},
+			period{name: "user_monthly", key: userPrefix + ":month:" + month, limit: userMonthly, resets: nextMonth},
+		)
+	}
+
+	return periods
+}
+
+// Check returns a twirp.ResourceExhausted error if the user, their tenant if any, or everyone exhausted a limit. The
+// error's metadata names the exhausted budget and when it resets, along with what remains of every limited budget, e.g.
+// "user_daily_remaining_cost". A warning is logged for budgets past the soft limit.
+func (b *Budget) Check(ctx context.Context, user string, tenant *model.Tenant) error {
+	var limited []period
+	var keys []string
+	for _, p := range b.periods(user, tenant, b.now()) {
+		if p.limit != (Limit{}) {
+			limited = append(limited, p)
+			keys = append(keys, p.key)
+		}
+	}
+
+	if len(limited) == 0 {
+		return nil
+	}
+
+	counted, err := b.store.CountedUsage(ctx, keys...)
+	if err != nil {
+		return fmt.Errorf("failed to read usage counters: %w", err)
+	}
+
+	var exhausted *period
+	meta := map[string]string{}
+
+	for _, p := range limited {
+		spent := counted[p.key]
+		tokens := spent.PromptTokens + spent.CompletionTokens
+
+		if p.limit.Tokens > 0 {
+			meta[p.name+"_remaining_tokens"] = strconv.Itoa(max(p.limit.Tokens-tokens, 0))
+		}
+		if p.limit.Cost > 0 {
+			meta[p.name+"_remaining_cost"] = strconv.FormatFloat(max(p.limit.Cost-spent.Cost, 0), 'f', -1, 64)
+		}
+
+		switch {
+		case reached(p.limit, tokens, spent.Cost, 1):
+			if exhausted == nil {
+				exhausted = &p
+			}
+		case reached(p.limit, tokens, spent.Cost, b.soft):
+			slog.WarnContext(ctx, "Budget soft limit reached", "budget", p.name, "user", user, "tokens", tokens, "cost", spent.Cost)
+		}
+	}
+
+	if exhausted == nil {
+		return nil
+	}
+
+	slog.WarnContext(ctx, "Budget exhausted", "budget", exhausted.name, "user", user)
+
+	resets := exhausted.resets.Format(time.RFC3339)
+	te := twirp.NewError(twirp.ResourceExhausted, fmt.Sprintf("%s budget exhausted, it resets at %s", exhausted.name, resets)).
+		WithMeta("budget", exhausted.name).
+		WithMeta("resets_at", resets)
+	for k, v := range meta {
+		te = te.WithMeta(k, v)
+	}
+
+	return te
+}
+
+// reached reports whether the given share of the limit was spent.
+func reached(l Limit, tokens int, cost float64, share float64) bool {
+	return (l.Tokens > 0 && float64(tokens) >= share*float64(l.Tokens)) ||
+		(l.Cost > 0 && cost >= share*l.Cost)
+}
+
+// Record counts the usage towards the budgets of the user, their tenant if any, and everyone, whether they are limited
+// or not, so limits configured later on apply to the usage of the ongoing day and month.
+func (b *Budget) Record(ctx context.Context, user string, tenant *model.Tenant, u model.Usage) error {
+	var keys []string
+	for _, p := range b.periods(user, tenant, b.now()) {
+		keys = append(keys, p.key)
+	}
+
+	return b.store.IncrementUsage(ctx, u, keys...)
+}
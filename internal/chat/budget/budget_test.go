@@ -0,0 +1,118 @@
+package budget
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/acai-travel/tech-challenge/internal/chat/model"
+	"github.com/twitchtv/twirp"
+)
+
+func TestBudget(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2025, 8, 20, 10, 0, 0, 0, time.UTC)
+	clock := func() time.Time { return now }
+
+	expectExhausted := func(t *testing.T, err error, budget string) twirp.Error {
+		t.Helper()
+		te, ok := err.(twirp.Error)
+		if !ok || te.Code() != twirp.ResourceExhausted || te.Meta("budget") != budget {
+			t.Fatalf("expected twirp.ResourceExhausted error for the %s budget, got %v", budget, err)
+		}
+		return te
+	}
+
+	t.Run("user daily limit blocks the user until the next day", func(t *testing.T) {
+		store := model.NewMemoryStore()
+		b := New(store, WithClock(clock), WithLimits(Limits{UserDaily: Limit{Tokens: 1000}}))
+
+		if err := b.Record(ctx, "alice", nil, model.Usage{PromptTokens: 500, CompletionTokens: 100}); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if err := b.Check(ctx, "alice", nil); err != nil {
+			t.Fatalf("expected budget to be left, got %v", err)
+		}
+
+		if err := b.Record(ctx, "alice", nil, model.Usage{PromptTokens: 400, CompletionTokens: 100}); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		te := expectExhausted(t, b.Check(ctx, "alice", nil), "user_daily")
+		if te.Meta("user_daily_remaining_tokens") != "0" || te.Meta("resets_at") != "2025-08-21T00:00:00Z" {
+			t.Errorf("unexpected error metadata %v", te.MetaMap())
+		}
+
+		if err := b.Check(ctx, "bob", nil); err != nil {
+			t.Errorf("expected other users to be left alone, got %v", err)
+		}
+
+		tomorrow := New(store, WithClock(func() time.Time { return now.Add(24 * time.Hour) }), WithLimits(Limits{UserDaily: Limit{Tokens: 1000}}))
+		if err := tomorrow.Check(ctx, "alice", nil); err != nil {
+			t.Errorf("expected budget to reset the next day, got %v", err)
+		}
+	})
+
+	t.Run("global monthly limit blocks everyone and reports what is left of the others", func(t *testing.T) {
+		b := New(model.NewMemoryStore(), WithClock(clock), WithLimits(Limits{
+			UserDaily:     Limit{Cost: 5},
+			GlobalMonthly: Limit{Cost: 10},
+		}))
+
+		if err := b.Record(ctx, "alice", nil, model.Usage{Cost: 4}); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if err := b.Record(ctx, "", nil, model.Usage{Cost: 6}); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		te := expectExhausted(t, b.Check(ctx, "alice", nil), "global_monthly")
+		if te.Meta("user_daily_remaining_cost") != "1" || te.Meta("global_monthly_remaining_cost") != "0" || te.Meta("resets_at") != "2025-09-01T00:00:00Z" {
+			t.Errorf("unexpected error metadata %v", te.MetaMap())
+		}
+
+		expectExhausted(t, b.Check(ctx, "", nil), "global_monthly")
+	})
+
+	t.Run("tenant budgets apply to the tenant and replace the user limits", func(t *testing.T) {
+		b := New(model.NewMemoryStore(), WithClock(clock), WithLimits(Limits{UserDaily: Limit{Tokens: 100}}))
+		sales := &model.Tenant{ID: "sales", Budgets: model.TenantBudgets{UserDaily: Limit{Tokens: 1000}, Daily: Limit{Tokens: 1500}}}
+
+		if err := b.Record(ctx, "alice", sales, model.Usage{PromptTokens: 800}); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if err := b.Check(ctx, "alice", sales); err != nil {
+			t.Fatalf("expected the tenant's user limit to apply, got %v", err)
+		}
+		if err := b.Check(ctx, "alice", &model.Tenant{ID: "support"}); err != nil {
+			t.Errorf("expected users of the same name in other tenants to keep their budget, got %v", err)
+		}
+
+		if err := b.Record(ctx, "bob", sales, model.Usage{PromptTokens: 800}); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		te := expectExhausted(t, b.Check(ctx, "carol", sales), "tenant_daily")
+		if te.Meta("user_daily_remaining_tokens") != "1000" {
+			t.Errorf("unexpected error metadata %v", te.MetaMap())
+		}
+
+		if err := b.Check(ctx, "carol", &model.Tenant{ID: "support"}); err != nil {
+			t.Errorf("expected other tenants to keep their budget, got %v", err)
+		}
+	})
+
+	t.Run("unlimited budgets are not checked", func(t *testing.T) {
+		b := New(model.NewMemoryStore(), WithClock(clock), WithLimits(Limits{}))
+
+		if err := b.Record(ctx, "alice", nil, model.Usage{PromptTokens: 1_000_000, Cost: 100}); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if err := b.Check(ctx, "alice", nil); err != nil {
+			t.Errorf("expected no limit, got %v", err)
+		}
+	})
+}
@@ -0,0 +1,154 @@
+package chat
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/acai-travel/tech-challenge/internal/chat/model"
+	"github.com/acai-travel/tech-challenge/internal/httpx"
+	"github.com/google/uuid"
+	"github.com/twitchtv/twirp"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	// idempotencyTTL is how long retries get the response of the first attempt.
+	idempotencyTTL = 24 * time.Hour
+
+	// idempotencyLease outlives the slowest replies. Attempts are cut short once it runs out, and a retry may take the
+	// request over from an attempt that crashed.
+	idempotencyLease = 5 * time.Minute
+
+	// idempotencyPoll is how often a retry checks whether the attempt it waits for is done.
+	idempotencyPoll = 100 * time.Millisecond
+)
+
+// WithIdempotency runs requests made with an idempotency key only once, retries get the response of the first attempt
+// for a day. Retries arriving while the first attempt is running wait for it up to wait, then fail with a twirp.Aborted
+// error. The key is taken from the httpx.IdempotencyKeyHeader, or the request's idempotency_key field.
+func WithIdempotency(store model.IdempotencyStore, wait time.Duration) Option {
+	return func(s *Server) {
+		s.idempotency = store
+		s.idempotencyWait = wait
+	}
+}
+
+// idempotentRequest is a request that may carry an idempotency key.
+type idempotentRequest interface {
+	proto.Message
+	GetIdempotencyKey() string
+}
+
+// idempotent runs fn unless the request was already made with the same idempotency key, in which case it returns the
+// response of that attempt instead. Attempts run to completion even when their caller gives up, which is usually what
+// makes it retry, and are forgotten when they fail so that they can be retried.
+func idempotent[R proto.Message](ctx context.Context, s *Server, method string, req idempotentRequest, fn func(ctx context.Context) (R, error)) (R, error) {
+	var zero R
+
+	key := httpx.IdempotencyKeyFrom(ctx)
+	if key == "" {
+		key = strings.TrimSpace(req.GetIdempotencyKey())
+	}
+
+	if key == "" || s.idempotency == nil {
+		return fn(ctx)
+	}
+
+	if len(key) > httpx.MaxIdempotencyKeyLength {
+		return zero, twirp.InvalidArgumentError("idempotency_key", "is too long")
+	}
+
+	// keys are chosen by clients, so they are scoped to the caller
+	scope := sha256.Sum256([]byte(strings.Join([]string{httpx.TenantFrom(ctx), httpx.UserFrom(ctx), method, key}, "\x00")))
+	key = "idempotency:" + hex.EncodeToString(scope[:])
+
+	fingerprint, err := fingerprint(req)
+	if err != nil {
+		return zero, err
+	}
+
+	claim := uuid.New().String()
+	deadline := time.Now().Add(s.idempotencyWait)
+	for {
+		now := time.Now()
+		held, err := s.idempotency.ClaimRequest(ctx, &model.IdempotentRequest{
+			Key:         key,
+			Claim:       claim,
+			Fingerprint: fingerprint,
+			LockedUntil: now.Add(idempotencyLease),
+			ExpiresAt:   now.Add(idempotencyTTL),
+		})
+		if err != nil {
+			return zero, err
+		}
+
+		if held == nil {
+			break
+		}
+
+		if held.Fingerprint != fingerprint {
+			return zero, twirp.InvalidArgumentError("idempotency_key", "was already used for another request")
+		}
+
+		if held.Done {
+			resp := zero.ProtoReflect().New().Interface().(R)
+			if err := proto.Unmarshal(held.Response, resp); err != nil {
+				return zero, err
+			}
+
+			slog.InfoContext(ctx, "Replaying response of idempotent request", "method", method)
+			return resp, nil
+		}
+
+		if !now.Before(deadline) {
+			return zero, twirp.NewError(twirp.Aborted, "a request with the same idempotency key is in progress, retry later")
+		}
+
+		select {
+		case <-ctx.Done():
+			return zero, ctx.Err()
+		case <-time.After(min(idempotencyPoll, time.Until(deadline))):
+		}
+	}
+
+	detached, cancel := context.WithTimeout(context.WithoutCancel(ctx), idempotencyLease)
+	defer cancel()
+
+	resp, err := fn(detached)
+	if err != nil {
+		if err := s.idempotency.ReleaseRequest(detached, key, claim); err != nil {
+			slog.ErrorContext(ctx, "Failed to release idempotent request", "method", method, "error", err)
+		}
+		return resp, err
+	}
+
+	data, err := proto.Marshal(resp)
+	if err == nil {
+		err = s.idempotency.CompleteRequest(detached, key, claim, data)
+	}
+	if err != nil {
+		// the request succeeded regardless, retries will run it again once its claim runs out
+		slog.ErrorContext(ctx, "Failed to store response of idempotent request", "method", method, "error", err)
+	}
+
+	return resp, nil
+}
+
+// fingerprint hashes the request without its idempotency key, so retries of it are told apart from other requests.
+func fingerprint(req idempotentRequest) (string, error) {
+	clone := proto.Clone(req)
+	m := clone.ProtoReflect()
+	m.Clear(m.Descriptor().Fields().ByName("idempotency_key"))
+
+	data, err := proto.MarshalOptions{Deterministic: true}.Marshal(clone)
+	if err != nil {
+		return "", err
+	}
+
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:]), nil
+}
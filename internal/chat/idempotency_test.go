@@ -0,0 +1,189 @@
+package chat
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/acai-travel/tech-challenge/internal/chat/assistant"
+	"github.com/acai-travel/tech-challenge/internal/chat/model"
+	. "github.com/acai-travel/tech-challenge/internal/chat/testing"
+	"github.com/acai-travel/tech-challenge/internal/httpx"
+	"github.com/acai-travel/tech-challenge/internal/pb"
+	"github.com/twitchtv/twirp"
+	"google.golang.org/protobuf/proto"
+)
+
+// blockingAssistant holds replies back until they are released.
+type blockingAssistant struct {
+	Assistant
+	started chan struct{}
+	release chan struct{}
+}
+
+func (a *blockingAssistant) Reply(ctx context.Context, conv *model.Conversation) ([]*model.Message, error) {
+	a.started <- struct{}{}
+	<-a.release
+	return a.Assistant.Reply(ctx, conv)
+}
+
+func TestServer_Idempotency(t *testing.T) {
+	ctx := httpx.WithIdempotencyKey(context.Background(), "7d1c0e8a")
+
+	t.Run("retries of a finished request get its response without replying again", WithFixture(func(t *testing.T, f *Fixture) {
+		openai := StartFakeOpenAI(t).Title("Weather today").Reply("It is sunny.")
+		srv := NewServer(f.ConversationStore, assistant.New(assistant.WithBaseURL(openai.URL)), WithIdempotency(f.Idempotency, time.Second))
+
+		req := &pb.StartConversationRequest{Message: "What is the weather like?"}
+		first, err := srv.StartConversation(ctx, req)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		f.Cleanup(first.GetConversationId())
+		awaitTitle(t, srv, ctx, first.GetConversationId())
+
+		retry, err := srv.StartConversation(ctx, req)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if !proto.Equal(first, retry) {
+			t.Errorf("expected the retry to get the original response %v, got %v", first, retry)
+		}
+
+		if reqs := openai.Requests(); len(reqs) != 2 {
+			t.Errorf("expected a title and a reply request, got %d requests", len(reqs))
+		}
+
+		listed, err := srv.ListConversations(ctx, &pb.ListConversationsRequest{})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if n := len(listed.GetConversations()); n != 1 {
+			t.Errorf("expected a single conversation, got %d", n)
+		}
+	}))
+
+	t.Run("the key may be sent in the request", WithFixture(func(t *testing.T, f *Fixture) {
+		conv := f.CreateConversation()
+		openai := StartFakeOpenAI(t).Reply("It is sunny.")
+		srv := NewServer(f.ConversationStore, assistant.New(assistant.WithBaseURL(openai.URL)), WithIdempotency(f.Idempotency, time.Second))
+
+		req := &pb.ContinueConversationRequest{ConversationId: conv.ID.Hex(), Message: "And tomorrow?", IdempotencyKey: "7d1c0e8a"}
+		for range 2 {
+			if _, err := srv.ContinueConversation(context.Background(), req); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+		}
+
+		described, err := srv.DescribeConversation(context.Background(), &pb.DescribeConversationRequest{ConversationId: conv.ID.Hex()})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if n := len(described.GetConversation().GetMessages()); n != len(conv.Messages)+2 {
+			t.Errorf("expected the question and reply to be added once, got %d messages", n)
+		}
+	}))
+
+	t.Run("keys reused for another request are rejected", WithFixture(func(t *testing.T, f *Fixture) {
+		openai := StartFakeOpenAI(t).Title("Weather today").Reply("It is sunny.")
+		srv := NewServer(f.ConversationStore, assistant.New(assistant.WithBaseURL(openai.URL)), WithIdempotency(f.Idempotency, time.Second))
+
+		out, err := srv.StartConversation(ctx, &pb.StartConversationRequest{Message: "What is the weather like?"})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		f.Cleanup(out.GetConversationId())
+
+		_, err = srv.StartConversation(ctx, &pb.StartConversationRequest{Message: "What time is it?"})
+		if terr, ok := err.(twirp.Error); !ok || terr.Code() != twirp.InvalidArgument {
+			t.Errorf("expected invalid argument error, got %v", err)
+		}
+	}))
+
+	t.Run("keys are scoped to the caller", WithFixture(func(t *testing.T, f *Fixture) {
+		openai := StartFakeOpenAI(t).Title("Weather today").Reply("It is sunny.").Title("Weather today").Reply("It is rainy.")
+		srv := NewServer(f.ConversationStore, assistant.New(assistant.WithBaseURL(openai.URL)), WithIdempotency(f.Idempotency, time.Second))
+
+		req := &pb.StartConversationRequest{Message: "What is the weather like?"}
+		for _, user := range []string{"alice", "bob"} {
+			userCtx := httpx.WithUser(ctx, user)
+			out, err := srv.StartConversation(userCtx, req)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			f.Cleanup(out.GetConversationId())
+			awaitTitle(t, srv, userCtx, out.GetConversationId())
+		}
+
+		if reqs := openai.Requests(); len(reqs) != 4 {
+			t.Errorf("expected both users to be replied to, got %d requests", len(reqs))
+		}
+	}))
+
+	t.Run("failed requests can be retried", WithFixture(func(t *testing.T, f *Fixture) {
+		conv := f.CreateConversation()
+		openai := StartFakeOpenAI(t).Fail(400, "invalid request").Reply("It is sunny.")
+		srv := NewServer(f.ConversationStore, assistant.New(assistant.WithBaseURL(openai.URL)), WithIdempotency(f.Idempotency, time.Second))
+
+		req := &pb.ContinueConversationRequest{ConversationId: conv.ID.Hex(), Message: "And tomorrow?"}
+		if _, err := srv.ContinueConversation(ctx, req); err == nil {
+			t.Fatal("expected the first attempt to fail")
+		}
+
+		out, err := srv.ContinueConversation(ctx, req)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if out.GetReply() != "It is sunny." {
+			t.Errorf("unexpected reply: %q", out.GetReply())
+		}
+	}))
+
+	t.Run("retries wait for the request in progress", WithFixture(func(t *testing.T, f *Fixture) {
+		conv := f.CreateConversation()
+		openai := StartFakeOpenAI(t).Reply("It is sunny.")
+		assist := &blockingAssistant{
+			Assistant: assistant.New(assistant.WithBaseURL(openai.URL)),
+			started:   make(chan struct{}, 1),
+			release:   make(chan struct{}),
+		}
+		srv := NewServer(f.ConversationStore, assist, WithIdempotency(f.Idempotency, 50*time.Millisecond))
+
+		req := &pb.ContinueConversationRequest{ConversationId: conv.ID.Hex(), Message: "And tomorrow?"}
+		first := make(chan *pb.ContinueConversationResponse)
+		go func() {
+			out, err := srv.ContinueConversation(ctx, req)
+			if err != nil {
+				t.Errorf("unexpected error: %v", err)
+			}
+			first <- out
+		}()
+		<-assist.started
+
+		_, err := srv.ContinueConversation(ctx, req)
+		if terr, ok := err.(twirp.Error); !ok || terr.Code() != twirp.Aborted {
+			t.Errorf("expected aborted error while the request is in progress, got %v", err)
+		}
+
+		retried := make(chan *pb.ContinueConversationResponse)
+		go func() {
+			srv := NewServer(f.ConversationStore, assist, WithIdempotency(f.Idempotency, 5*time.Second))
+			out, err := srv.ContinueConversation(ctx, req)
+			if err != nil {
+				t.Errorf("unexpected error: %v", err)
+			}
+			retried <- out
+		}()
+
+		close(assist.release)
+		original, retry := <-first, <-retried
+		if !proto.Equal(original, retry) {
+			t.Errorf("expected the retry to get the original response %v, got %v", original, retry)
+		}
+
+		if reqs := openai.Requests(); len(reqs) != 1 {
+			t.Errorf("expected a single reply request, got %d requests", len(reqs))
+		}
+	}))
+}
@@ -0,0 +1,63 @@
+package llm
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const defaultLocalBaseURL = "http://localhost:11434/v1/"
+
+// FromEnv creates the provider selected by the LLM_PROVIDER environment variable:
+//   - "openai" (default) uses the OpenAI API, configured with OPENAI_API_KEY.
+//   - "local" uses an OpenAI compatible endpoint at LLM_BASE_URL, which defaults to a local Ollama instance.
+//
+// The provider is wrapped with NewResilient, configured with LLM_MAX_ATTEMPTS, LLM_CALL_TIMEOUT and
+// LLM_FALLBACK_MODELS, a comma separated list of models.
+func FromEnv() (Provider, error) {
+	var next Provider
+	switch provider := os.Getenv("LLM_PROVIDER"); provider {
+	case "", "openai":
+		next = NewOpenAI()
+	case "local":
+		baseURL := os.Getenv("LLM_BASE_URL")
+		if baseURL == "" {
+			baseURL = defaultLocalBaseURL
+		}
+		next = NewLocal(baseURL)
+	default:
+		return nil, fmt.Errorf("unknown LLM provider %q", provider)
+	}
+
+	var opts []ResilientOption
+
+	if v := os.Getenv("LLM_MAX_ATTEMPTS"); v != "" {
+		n, err := strconv.Atoi(v)
+		if err != nil || n < 1 {
+			return nil, fmt.Errorf("invalid LLM_MAX_ATTEMPTS %q", v)
+		}
+		opts = append(opts, WithMaxAttempts(n))
+	}
+
+	if v := os.Getenv("LLM_CALL_TIMEOUT"); v != "" {
+		d, err := time.ParseDuration(v)
+		if err != nil || d <= 0 {
+			return nil, fmt.Errorf("invalid LLM_CALL_TIMEOUT %q", v)
+		}
+		opts = append(opts, WithCallTimeout(d))
+	}
+
+	if v := os.Getenv("LLM_FALLBACK_MODELS"); v != "" {
+		var models []string
+		for _, m := range strings.Split(v, ",") {
+			if m = strings.TrimSpace(m); m != "" {
+				models = append(models, m)
+			}
+		}
+		opts = append(opts, WithFallbackModels(models...))
+	}
+
+	return NewResilient(next, opts...), nil
+}
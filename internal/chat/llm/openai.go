@@ -0,0 +1,181 @@
+package llm
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/openai/openai-go/v2"
+	"github.com/openai/openai-go/v2/option"
+)
+
+var _ Provider = (*OpenAI)(nil)
+
+// OpenAI is a provider for the OpenAI Chat Completions API, and any other API compatible with it.
+type OpenAI struct {
+	cli openai.Client
+}
+
+// NewOpenAI creates a provider for the OpenAI API. By default, the client is configured from the environment, see
+// openai.NewClient, opts can override that. The client does not retry failed requests, wrap the provider with
+// NewResilient for that.
+func NewOpenAI(opts ...option.RequestOption) *OpenAI {
+	return &OpenAI{cli: openai.NewClient(append([]option.RequestOption{option.WithMaxRetries(0)}, opts...)...)}
+}
+
+// NewLocal creates a provider for a local, OpenAI compatible endpoint such as Ollama (http://localhost:11434/v1/) or
+// llama.cpp's server (http://localhost:8080/v1/). Local endpoints usually do not check API keys, so a placeholder is
+// sent unless opts configure one.
+func NewLocal(baseURL string, opts ...option.RequestOption) *OpenAI {
+	return NewOpenAI(append([]option.RequestOption{option.WithBaseURL(baseURL), option.WithAPIKey("local")}, opts...)...)
+}
+
+func (p *OpenAI) Complete(ctx context.Context, req Request) (*Response, error) {
+	resp, err := p.cli.Chat.Completions.New(ctx, p.params(req))
+	if err != nil {
+		return nil, apiError(err)
+	}
+
+	if len(resp.Choices) == 0 {
+		return nil, ErrNoChoices
+	}
+
+	return p.response(resp.Choices[0].Message, resp.Usage), nil
+}
+
+func (p *OpenAI) Stream(ctx context.Context, req Request, onDelta func(delta string)) (*Response, error) {
+	params := p.params(req)
+	// usage is only reported at the end of a stream when asked for
+	params.StreamOptions.IncludeUsage = openai.Bool(true)
+
+	stream := p.cli.Chat.Completions.NewStreaming(ctx, params)
+	defer func() {
+		_ = stream.Close()
+	}()
+
+	var acc openai.ChatCompletionAccumulator
+	for stream.Next() {
+		chunk := stream.Current()
+		acc.AddChunk(chunk)
+
+		if len(chunk.Choices) > 0 && chunk.Choices[0].Delta.Content != "" {
+			onDelta(chunk.Choices[0].Delta.Content)
+		}
+	}
+
+	if err := stream.Err(); err != nil {
+		return nil, apiError(err)
+	}
+
+	if len(acc.Choices) == 0 {
+		return nil, ErrNoChoices
+	}
+
+	return p.response(acc.Choices[0].Message, acc.Usage), nil
+}
+
+func (p *OpenAI) params(req Request) openai.ChatCompletionNewParams {
+	params := openai.ChatCompletionNewParams{
+		Model: req.Model,
+	}
+
+	if req.Temperature != nil {
+		params.Temperature = openai.Float(*req.Temperature)
+	}
+
+	if req.MaxTokens > 0 {
+		params.MaxCompletionTokens = openai.Int(int64(req.MaxTokens))
+	}
+
+	for _, m := range req.Messages {
+		switch m.Role {
+		case RoleSystem:
+			params.Messages = append(params.Messages, openai.SystemMessage(m.Content))
+		case RoleUser:
+			params.Messages = append(params.Messages, openai.UserMessage(m.Content))
+		case RoleTool:
+			params.Messages = append(params.Messages, openai.ToolMessage(m.Content, m.ToolCallID))
+		case RoleAssistant:
+			msg := openai.ChatCompletionAssistantMessageParam{}
+			if m.Content != "" {
+				msg.Content.OfString = openai.String(m.Content)
+			}
+
+			for _, call := range m.ToolCalls {
+				msg.ToolCalls = append(msg.ToolCalls, openai.ChatCompletionMessageToolCallUnionParam{
+					OfFunction: &openai.ChatCompletionMessageFunctionToolCallParam{
+						ID: call.ID,
+						Function: openai.ChatCompletionMessageFunctionToolCallFunctionParam{
+							Name:      call.Name,
+							Arguments: call.Arguments,
+						},
+					},
+				})
+			}
+
+			params.Messages = append(params.Messages, openai.ChatCompletionMessageParamUnion{OfAssistant: &msg})
+		}
+	}
+
+	for _, tool := range req.Tools {
+		params.Tools = append(params.Tools, openai.ChatCompletionFunctionTool(openai.FunctionDefinitionParam{
+			Name:        tool.Name,
+			Description: openai.String(tool.Description),
+			Parameters:  tool.Parameters,
+		}))
+	}
+
+	return params
+}
+
+func (p *OpenAI) response(msg openai.ChatCompletionMessage, usage openai.CompletionUsage) *Response {
+	resp := &Response{
+		Message: Message{Role: RoleAssistant, Content: msg.Content},
+		Usage:   Usage{PromptTokens: int(usage.PromptTokens), CompletionTokens: int(usage.CompletionTokens)},
+	}
+
+	for _, call := range msg.ToolCalls {
+		resp.Message.ToolCalls = append(resp.Message.ToolCalls, ToolCall{
+			ID:        call.ID,
+			Name:      call.Function.Name,
+			Arguments: call.Function.Arguments,
+		})
+	}
+
+	return resp
+}
+
+// apiError converts the error responses of the API to an APIError.
+func apiError(err error) error {
+	var apiErr *openai.Error
+	if !errors.As(err, &apiErr) {
+		return err
+	}
+
+	e := &APIError{StatusCode: apiErr.StatusCode, Err: err}
+	if apiErr.Response != nil {
+		e.RetryAfter = retryAfter(apiErr.Response.Header, time.Now())
+	}
+	return e
+}
+
+// retryAfter reads how long to wait before retrying from the headers of a response: OpenAI's retry-after-ms, or the
+// standard Retry-After, in seconds or as a date.
+func retryAfter(h http.Header, now time.Time) time.Duration {
+	if ms, err := strconv.ParseFloat(h.Get("Retry-After-Ms"), 64); err == nil && ms > 0 {
+		return time.Duration(ms * float64(time.Millisecond))
+	}
+
+	v := h.Get("Retry-After")
+	if seconds, err := strconv.Atoi(v); err == nil && seconds > 0 {
+		return time.Duration(seconds) * time.Second
+	}
+
+	if at, err := http.ParseTime(v); err == nil && at.After(now) {
+		return at.Sub(now)
+	}
+
+	return 0
+}
@@ -0,0 +1,119 @@
+// Package llm abstracts the language models used by the assistant behind a small provider interface, so the assistant
+// does not depend on a specific vendor SDK.
+package llm
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+// ErrNoChoices is returned when a provider responds without any completion.
+var ErrNoChoices = errors.New("no choices returned by the model")
+
+// APIError is an error response of the provider's API.
+type APIError struct {
+	StatusCode int
+
+	// RetryAfter is how long the API asked to wait before trying again, zero if it did not say.
+	RetryAfter time.Duration
+
+	Err error
+}
+
+func (e *APIError) Error() string {
+	return e.Err.Error()
+}
+
+func (e *APIError) Unwrap() error {
+	return e.Err
+}
+
+type Role string
+
+const (
+	RoleSystem    Role = "system"
+	RoleUser      Role = "user"
+	RoleAssistant Role = "assistant"
+	RoleTool      Role = "tool"
+)
+
+// ToolCall is a function call requested by the model.
+type ToolCall struct {
+	ID        string
+	Name      string
+	Arguments string
+}
+
+// Message is a single message of the chat sent to the model. Assistant messages may carry tool calls, tool messages
+// carry the result of the call identified by ToolCallID.
+type Message struct {
+	Role       Role
+	Content    string
+	ToolCalls  []ToolCall
+	ToolCallID string
+}
+
+// Tool describes a function the model may call, Parameters is a JSON schema of its arguments.
+type Tool struct {
+	Name        string
+	Description string
+	Parameters  map[string]any
+}
+
+// Request is a chat completion request.
+type Request struct {
+	Model    string
+	Messages []Message
+	Tools    []Tool
+
+	// Temperature and MaxTokens are left to the model's defaults when unset.
+	Temperature *float64
+	MaxTokens   int
+}
+
+// Response is the completion returned by the model.
+type Response struct {
+	Message Message
+	Usage   Usage
+
+	// Model that generated the response, when it may differ from the requested one, see Resilient.
+	Model string
+}
+
+// Usage is the number of tokens a completion consumed, as reported by the provider.
+type Usage struct {
+	PromptTokens     int
+	CompletionTokens int
+}
+
+// Add adds the tokens of o to u.
+func (u *Usage) Add(o Usage) {
+	u.PromptTokens += o.PromptTokens
+	u.CompletionTokens += o.CompletionTokens
+}
+
+// Provider generates chat completions.
+type Provider interface {
+	// Complete returns the completion for the request once it is fully generated.
+	Complete(ctx context.Context, req Request) (*Response, error)
+
+	// Stream is like Complete, but reports every chunk of content to onDelta as soon as the model produces it.
+	Stream(ctx context.Context, req Request, onDelta func(delta string)) (*Response, error)
+}
+
+func SystemMessage(content string) Message {
+	return Message{Role: RoleSystem, Content: content}
+}
+
+func UserMessage(content string) Message {
+	return Message{Role: RoleUser, Content: content}
+}
+
+func AssistantMessage(content string) Message {
+	return Message{Role: RoleAssistant, Content: content}
+}
+
+func ToolMessage(content, toolCallID string) Message {
+	return Message{Role: RoleTool, Content: content, ToolCallID: toolCallID}
+}
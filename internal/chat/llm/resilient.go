@@ -0,0 +1,359 @@
+package llm
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"math/rand/v2"
+	"net"
+	"net/http"
+	"slices"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+var _ Provider = (*Resilient)(nil)
+
+const (
+	defaultMaxAttempts     = 3
+	defaultBaseBackoff     = 500 * time.Millisecond
+	defaultMaxBackoff      = 20 * time.Second
+	defaultCallTimeout     = 2 * time.Minute
+	defaultBreakerFailures = 5
+	defaultBreakerCooldown = 30 * time.Second
+)
+
+var (
+	// ErrTimeout is returned when a call to the model took longer than the call timeout, see WithCallTimeout.
+	ErrTimeout = errors.New("model call timed out")
+
+	// ErrCircuitOpen is returned without calling a model that kept failing, until it cooled down, see
+	// WithCircuitBreaker.
+	ErrCircuitOpen = errors.New("model is unavailable, circuit breaker is open")
+)
+
+var tracer = otel.Tracer("llm")
+
+// Resilient wraps a provider so transient failures of the model do not reach the user:
+//   - Calls failing with an error that may go away, e.g. a rate limit, a server error or a timeout, are retried with
+//     jittered exponential backoff. Waits asked for by the API with Retry-After are honoured.
+//   - Every call is bounded by a timeout.
+//   - A circuit breaker per model stops calling a model after consecutive failures, until it cooled down.
+//   - When a model stays unavailable, the fallback models are tried in order.
+//
+// Streams are only retried until they produced content, the content already forwarded could not be taken back.
+// Every attempt is recorded as an event of the call's span. Responses name the model that generated them.
+type Resilient struct {
+	next        Provider
+	maxAttempts int
+	baseBackoff time.Duration
+	maxBackoff  time.Duration
+	callTimeout time.Duration
+	fallbacks   []string
+
+	breakerFailures int
+	breakerCooldown time.Duration
+
+	mu       sync.Mutex
+	breakers map[string]*breaker
+
+	now   func() time.Time
+	sleep func(ctx context.Context, d time.Duration) error
+}
+
+type ResilientOption func(*Resilient)
+
+// WithMaxAttempts sets how many times a model is called before giving up on it, including the first call.
+func WithMaxAttempts(n int) ResilientOption {
+	return func(r *Resilient) { r.maxAttempts = max(n, 1) }
+}
+
+// WithBackoff sets the wait before the first retry, doubled on every retry up to max. Models asking to wait longer
+// than max are given up on right away.
+func WithBackoff(base, max time.Duration) ResilientOption {
+	return func(r *Resilient) { r.baseBackoff, r.maxBackoff = base, max }
+}
+
+// WithCallTimeout bounds every call to the model, streamed or not.
+func WithCallTimeout(d time.Duration) ResilientOption {
+	return func(r *Resilient) { r.callTimeout = d }
+}
+
+// WithFallbackModels sets the models tried in order when the requested one is unavailable.
+func WithFallbackModels(models ...string) ResilientOption {
+	return func(r *Resilient) { r.fallbacks = models }
+}
+
+// WithCircuitBreaker opens the circuit of a model after the given number of consecutive failures. Calls to it then
+// fail with ErrCircuitOpen until the cooldown passed, after which a single call probes whether it recovered.
+func WithCircuitBreaker(failures int, cooldown time.Duration) ResilientOption {
+	return func(r *Resilient) { r.breakerFailures, r.breakerCooldown = max(failures, 1), cooldown }
+}
+
+// NewResilient wraps the provider, see Resilient.
+func NewResilient(next Provider, opts ...ResilientOption) *Resilient {
+	r := &Resilient{
+		next:            next,
+		maxAttempts:     defaultMaxAttempts,
+		baseBackoff:     defaultBaseBackoff,
+		maxBackoff:      defaultMaxBackoff,
+		callTimeout:     defaultCallTimeout,
+		breakerFailures: defaultBreakerFailures,
+		breakerCooldown: defaultBreakerCooldown,
+		breakers:        map[string]*breaker{},
+		now:             time.Now,
+		sleep:           sleep,
+	}
+
+	for _, opt := range opts {
+		opt(r)
+	}
+
+	return r
+}
+
+func (r *Resilient) Complete(ctx context.Context, req Request) (*Response, error) {
+	return r.call(ctx, "llm.Complete", req, r.next.Complete, func() bool { return false })
+}
+
+func (r *Resilient) Stream(ctx context.Context, req Request, onDelta func(delta string)) (*Response, error) {
+	streamed := false
+	stream := func(ctx context.Context, req Request) (*Response, error) {
+		return r.next.Stream(ctx, req, func(delta string) {
+			streamed = true
+			onDelta(delta)
+		})
+	}
+
+	return r.call(ctx, "llm.Stream", req, stream, func() bool { return streamed })
+}
+
+// call calls the requested model, then the fallback models while they are unavailable. Once committed reports true,
+// failures are returned as they are.
+func (r *Resilient) call(ctx context.Context, name string, req Request, attempt func(context.Context, Request) (*Response, error), committed func() bool) (*Response, error) {
+	ctx, span := tracer.Start(ctx, name, trace.WithAttributes(attribute.String("llm.model", req.Model)))
+	defer span.End()
+
+	models := []string{req.Model}
+	for _, m := range r.fallbacks {
+		if !slices.Contains(models, m) {
+			models = append(models, m)
+		}
+	}
+
+	var err error
+	for i, model := range models {
+		if i > 0 {
+			slog.WarnContext(ctx, "Model unavailable, falling back", "model", models[i-1], "fallback", model, "error", err)
+		}
+
+		req.Model = model
+
+		var resp *Response
+		resp, err = r.try(ctx, req, attempt, committed)
+		if err == nil {
+			resp.Model = model
+			span.SetAttributes(attribute.String("llm.response_model", model))
+			return resp, nil
+		}
+
+		if ctx.Err() != nil || committed() || !(retryable(err) || errors.Is(err, ErrCircuitOpen)) {
+			break
+		}
+	}
+
+	span.SetStatus(codes.Error, err.Error())
+	return nil, err
+}
+
+// try calls the model until it succeeds, fails with an error retrying does not fix, or ran out of attempts.
+func (r *Resilient) try(ctx context.Context, req Request, attempt func(context.Context, Request) (*Response, error), committed func() bool) (*Response, error) {
+	b := r.breaker(req.Model)
+
+	for n := 1; ; n++ {
+		if !b.allow(r.now()) {
+			r.record(ctx, req.Model, n, 0, ErrCircuitOpen)
+			return nil, ErrCircuitOpen
+		}
+
+		callCtx, cancel := context.WithTimeout(ctx, r.callTimeout)
+		start := r.now()
+		resp, err := attempt(callCtx, req)
+		timedOut := callCtx.Err() == context.DeadlineExceeded && ctx.Err() == nil
+		cancel()
+
+		if err != nil && timedOut {
+			err = fmt.Errorf("%w after %s: %w", ErrTimeout, r.callTimeout, err)
+		}
+
+		r.record(ctx, req.Model, n, r.now().Sub(start), err)
+
+		switch {
+		case err == nil:
+			b.succeeded()
+			return resp, nil
+		case ctx.Err() != nil:
+			// the caller gave up, it says nothing about the model
+			b.abandoned()
+			return nil, err
+		case !retryable(err):
+			// the model answered, the request is at fault
+			b.succeeded()
+			return nil, err
+		}
+
+		b.failed(r.now())
+
+		if n >= r.maxAttempts || committed() {
+			return nil, err
+		}
+
+		delay := r.backoff(n, err)
+		if delay > r.maxBackoff {
+			slog.WarnContext(ctx, "Model asked to wait longer than the maximum backoff, giving up on it", "model", req.Model, "retry_after", delay)
+			return nil, err
+		}
+
+		slog.InfoContext(ctx, "Model call failed, retrying", "model", req.Model, "attempt", n, "delay", delay, "error", err)
+		if err := r.sleep(ctx, delay); err != nil {
+			return nil, err
+		}
+	}
+}
+
+// backoff returns how long to wait after the given failed attempt: the exponential backoff with equal jitter, or as
+// long as the API asked for if that is longer.
+func (r *Resilient) backoff(attempt int, err error) time.Duration {
+	exp := min(r.baseBackoff<<(attempt-1), r.maxBackoff)
+	delay := exp/2 + rand.N(exp/2+1)
+
+	var apiErr *APIError
+	if errors.As(err, &apiErr) && apiErr.RetryAfter > delay {
+		delay = apiErr.RetryAfter
+	}
+
+	return delay
+}
+
+// record adds an event for an attempt to the span of the call.
+func (r *Resilient) record(ctx context.Context, model string, attempt int, duration time.Duration, err error) {
+	attrs := []attribute.KeyValue{
+		attribute.String("llm.model", model),
+		attribute.Int("llm.attempt", attempt),
+		attribute.Int64("llm.duration_ms", duration.Milliseconds()),
+	}
+
+	if err != nil {
+		attrs = append(attrs, attribute.String("error", err.Error()))
+
+		var apiErr *APIError
+		if errors.As(err, &apiErr) {
+			attrs = append(attrs, attribute.Int("http.status_code", apiErr.StatusCode))
+		}
+	}
+
+	trace.SpanFromContext(ctx).AddEvent("llm.attempt", trace.WithAttributes(attrs...))
+}
+
+func (r *Resilient) breaker(model string) *breaker {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	b, ok := r.breakers[model]
+	if !ok {
+		b = &breaker{threshold: r.breakerFailures, cooldown: r.breakerCooldown}
+		r.breakers[model] = b
+	}
+	return b
+}
+
+// retryable reports whether the error may go away by trying again: timeouts, network errors, rate limits, conflicts
+// and server errors.
+func retryable(err error) bool {
+	if errors.Is(err, ErrTimeout) || errors.Is(err, io.ErrUnexpectedEOF) {
+		return true
+	}
+
+	var apiErr *APIError
+	if errors.As(err, &apiErr) {
+		switch apiErr.StatusCode {
+		case http.StatusRequestTimeout, http.StatusConflict, http.StatusTooManyRequests:
+			return true
+		}
+		return apiErr.StatusCode >= 500
+	}
+
+	var netErr net.Error
+	return errors.As(err, &netErr)
+}
+
+func sleep(ctx context.Context, d time.Duration) error {
+	t := time.NewTimer(d)
+	defer t.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-t.C:
+		return nil
+	}
+}
+
+// breaker is the circuit breaker of a model. It opens after threshold consecutive failures, and lets a single call
+// probe the model once the cooldown passed: the circuit closes if it succeeds, and opens again otherwise.
+type breaker struct {
+	mu        sync.Mutex
+	threshold int
+	cooldown  time.Duration
+	failures  int
+	openUntil time.Time
+	probing   bool
+}
+
+func (b *breaker) allow(now time.Time) bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.failures < b.threshold {
+		return true
+	}
+
+	if now.Before(b.openUntil) || b.probing {
+		return false
+	}
+
+	b.probing = true
+	return true
+}
+
+func (b *breaker) succeeded() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.failures, b.probing = 0, false
+}
+
+func (b *breaker) failed(now time.Time) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.failures++
+	b.probing = false
+	if b.failures >= b.threshold {
+		b.openUntil = now.Add(b.cooldown)
+	}
+}
+
+func (b *breaker) abandoned() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.probing = false
+}
@@ -0,0 +1,301 @@
+package llm
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"slices"
+	"sync"
+	"testing"
+	"time"
+
+	"go.opentelemetry.io/otel"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+// stub is a provider answering every call with the next outcome scripted for the requested model, and recording the
+// models it was called with.
+type stub struct {
+	mu       sync.Mutex
+	outcomes map[string][]func(ctx context.Context, onDelta func(string)) (*Response, error)
+	calls    []string
+}
+
+func newStub() *stub {
+	return &stub{outcomes: map[string][]func(context.Context, func(string)) (*Response, error){}}
+}
+
+func (s *stub) reply(model, content string) *stub {
+	return s.then(model, func(context.Context, func(string)) (*Response, error) {
+		resp := Reply(content)
+		return &resp, nil
+	})
+}
+
+func (s *stub) fail(model string, err error) *stub {
+	return s.then(model, func(context.Context, func(string)) (*Response, error) { return nil, err })
+}
+
+func (s *stub) then(model string, outcome func(ctx context.Context, onDelta func(string)) (*Response, error)) *stub {
+	s.outcomes[model] = append(s.outcomes[model], outcome)
+	return s
+}
+
+func (s *stub) Complete(ctx context.Context, req Request) (*Response, error) {
+	return s.Stream(ctx, req, func(string) {})
+}
+
+func (s *stub) Stream(ctx context.Context, req Request, onDelta func(delta string)) (*Response, error) {
+	s.mu.Lock()
+	s.calls = append(s.calls, req.Model)
+	outcomes := s.outcomes[req.Model]
+	if len(outcomes) == 0 {
+		s.mu.Unlock()
+		return nil, errors.New("no outcome left for " + req.Model)
+	}
+	s.outcomes[req.Model] = outcomes[1:]
+	s.mu.Unlock()
+
+	return outcomes[0](ctx, onDelta)
+}
+
+func (s *stub) Calls() []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return slices.Clone(s.calls)
+}
+
+// resilient wraps the provider without waiting between attempts, the waits are recorded instead.
+func resilient(next Provider, opts ...ResilientOption) (*Resilient, *[]time.Duration) {
+	var waits []time.Duration
+	r := NewResilient(next, opts...)
+	r.sleep = func(ctx context.Context, d time.Duration) error {
+		waits = append(waits, d)
+		return ctx.Err()
+	}
+	return r, &waits
+}
+
+func status(code int) error {
+	return &APIError{StatusCode: code, Err: errors.New(http.StatusText(code))}
+}
+
+func TestResilient(t *testing.T) {
+	ctx := context.Background()
+	req := Request{Model: "primary"}
+
+	t.Run("transient errors are retried with backoff", func(t *testing.T) {
+		p := newStub().fail("primary", status(429)).fail("primary", status(503)).reply("primary", "Hello")
+		r, waits := resilient(p, WithBackoff(100*time.Millisecond, time.Second))
+
+		resp, err := r.Complete(ctx, req)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if resp.Message.Content != "Hello" || resp.Model != "primary" {
+			t.Errorf("expected the reply of the primary model, got %q from %q", resp.Message.Content, resp.Model)
+		}
+
+		if len(*waits) != 2 {
+			t.Fatalf("expected 2 waits, got %v", *waits)
+		}
+
+		// equal jitter keeps every wait between half and all of the exponential backoff
+		for i, wait := range *waits {
+			exp := 100 * time.Millisecond << i
+			if wait < exp/2 || wait > exp {
+				t.Errorf("expected wait %d between %s and %s, got %s", i, exp/2, exp, wait)
+			}
+		}
+	})
+
+	t.Run("retry after is honoured", func(t *testing.T) {
+		p := newStub().
+			fail("primary", &APIError{StatusCode: 429, RetryAfter: 700 * time.Millisecond, Err: errors.New("slow down")}).
+			reply("primary", "Hello")
+		r, waits := resilient(p, WithBackoff(100*time.Millisecond, time.Second))
+
+		if _, err := r.Complete(ctx, req); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if !slices.Equal(*waits, []time.Duration{700 * time.Millisecond}) {
+			t.Errorf("expected to wait as asked, got %v", *waits)
+		}
+	})
+
+	t.Run("other errors are returned right away", func(t *testing.T) {
+		p := newStub().fail("primary", status(400))
+		r, _ := resilient(p, WithFallbackModels("fallback"))
+
+		_, err := r.Complete(ctx, req)
+
+		var apiErr *APIError
+		if !errors.As(err, &apiErr) || apiErr.StatusCode != 400 {
+			t.Fatalf("expected the bad request error, got %v", err)
+		}
+
+		if calls := p.Calls(); !slices.Equal(calls, []string{"primary"}) {
+			t.Errorf("expected a single call, got %v", calls)
+		}
+	})
+
+	t.Run("fallback models take over when the primary stays unavailable", func(t *testing.T) {
+		p := newStub().
+			fail("primary", status(500)).fail("primary", status(500)).
+			fail("first", status(502)).fail("first", status(502)).
+			reply("second", "Hello")
+		r, _ := resilient(p, WithMaxAttempts(2), WithFallbackModels("first", "primary", "second"))
+
+		resp, err := r.Complete(ctx, req)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if resp.Model != "second" {
+			t.Errorf("expected the second fallback to reply, got %q", resp.Model)
+		}
+
+		if calls := p.Calls(); !slices.Equal(calls, []string{"primary", "primary", "first", "first", "second"}) {
+			t.Errorf("unexpected calls %v", calls)
+		}
+	})
+
+	t.Run("waits longer than the maximum backoff move on to the fallback", func(t *testing.T) {
+		p := newStub().
+			fail("primary", &APIError{StatusCode: 429, RetryAfter: time.Hour, Err: errors.New("quota exceeded")}).
+			reply("fallback", "Hello")
+		r, waits := resilient(p, WithFallbackModels("fallback"))
+
+		resp, err := r.Complete(ctx, req)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if resp.Model != "fallback" || len(*waits) != 0 {
+			t.Errorf("expected the fallback to reply without waiting, got %q after %v", resp.Model, *waits)
+		}
+	})
+
+	t.Run("slow calls time out and are retried", func(t *testing.T) {
+		p := newStub().
+			then("primary", func(ctx context.Context, _ func(string)) (*Response, error) {
+				<-ctx.Done()
+				return nil, ctx.Err()
+			}).
+			reply("primary", "Hello")
+		r, _ := resilient(p, WithCallTimeout(10*time.Millisecond))
+
+		if _, err := r.Complete(ctx, req); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if calls := p.Calls(); len(calls) != 2 {
+			t.Errorf("expected the call to be retried, got %v", calls)
+		}
+	})
+
+	t.Run("timeouts are reported", func(t *testing.T) {
+		p := newStub().then("primary", func(ctx context.Context, _ func(string)) (*Response, error) {
+			<-ctx.Done()
+			return nil, ctx.Err()
+		})
+		r, _ := resilient(p, WithMaxAttempts(1), WithCallTimeout(10*time.Millisecond))
+
+		if _, err := r.Complete(ctx, req); !errors.Is(err, ErrTimeout) {
+			t.Errorf("expected a timeout, got %v", err)
+		}
+	})
+
+	t.Run("circuit breaker opens and probes after the cooldown", func(t *testing.T) {
+		p := newStub().fail("primary", status(500)).fail("primary", status(500)).reply("primary", "Hello")
+		r, _ := resilient(p, WithMaxAttempts(1), WithCircuitBreaker(2, time.Minute))
+
+		now := time.Now()
+		r.now = func() time.Time { return now }
+
+		for range 2 {
+			if _, err := r.Complete(ctx, req); err == nil {
+				t.Fatal("expected the call to fail")
+			}
+		}
+
+		if _, err := r.Complete(ctx, req); !errors.Is(err, ErrCircuitOpen) {
+			t.Fatalf("expected the circuit to be open, got %v", err)
+		}
+
+		if calls := p.Calls(); len(calls) != 2 {
+			t.Errorf("expected the open circuit not to call the model, got %v", calls)
+		}
+
+		now = now.Add(time.Minute)
+
+		if _, err := r.Complete(ctx, req); err != nil {
+			t.Fatalf("expected the probe to succeed, got %v", err)
+		}
+
+		if _, err := r.Complete(ctx, req); errors.Is(err, ErrCircuitOpen) {
+			t.Error("expected the circuit to be closed after the probe succeeded")
+		}
+	})
+
+	t.Run("streams are not retried once they produced content", func(t *testing.T) {
+		p := newStub().
+			then("primary", func(_ context.Context, onDelta func(string)) (*Response, error) {
+				onDelta("Hel")
+				return nil, status(500)
+			}).
+			reply("primary", "Hello")
+		r, _ := resilient(p, WithFallbackModels("fallback"))
+
+		var deltas []string
+		_, err := r.Stream(ctx, req, func(delta string) { deltas = append(deltas, delta) })
+		if err == nil {
+			t.Fatal("expected the stream to fail")
+		}
+
+		if calls := p.Calls(); len(calls) != 1 || !slices.Equal(deltas, []string{"Hel"}) {
+			t.Errorf("expected a single attempt, got %v with deltas %v", calls, deltas)
+		}
+	})
+
+	t.Run("attempts are recorded as span events", func(t *testing.T) {
+		recorder := tracetest.NewSpanRecorder()
+		prev := otel.GetTracerProvider()
+		otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)))
+		t.Cleanup(func() { otel.SetTracerProvider(prev) })
+
+		p := newStub().fail("primary", status(500)).reply("primary", "Hello")
+		r, _ := resilient(p)
+
+		if _, err := r.Complete(ctx, req); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		spans := recorder.Ended()
+		if len(spans) != 1 || spans[0].Name() != "llm.Complete" {
+			t.Fatalf("expected an llm.Complete span, got %v", spans)
+		}
+
+		var attempts []string
+		for _, e := range spans[0].Events() {
+			if e.Name != "llm.attempt" {
+				continue
+			}
+			failed := "ok"
+			for _, a := range e.Attributes {
+				if a.Key == "error" {
+					failed = "error"
+				}
+			}
+			attempts = append(attempts, failed)
+		}
+
+		if !slices.Equal(attempts, []string{"error", "ok"}) {
+			t.Errorf("expected a failed then a successful attempt, got %v", attempts)
+		}
+	})
+}
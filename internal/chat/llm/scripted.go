@@ -0,0 +1,91 @@
+package llm
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"sync"
+)
+
+var _ Provider = (*Scripted)(nil)
+
+// Scripted is a deterministic provider for tests: it replies with the scripted responses in order, and records the
+// requests it received.
+type Scripted struct {
+	mu        sync.Mutex
+	responses []Response
+	requests  []Request
+}
+
+// NewScripted creates a provider replying with the given responses, one per request.
+func NewScripted(responses ...Response) *Scripted {
+	return &Scripted{responses: responses}
+}
+
+// Reply scripts a response with the given content.
+func Reply(content string) Response {
+	return Response{Message: Message{Role: RoleAssistant, Content: content}}
+}
+
+// CallTools scripts a response requesting the given tool calls.
+func CallTools(calls ...ToolCall) Response {
+	return Response{Message: Message{Role: RoleAssistant, ToolCalls: calls}}
+}
+
+// Script appends more responses to the script.
+func (s *Scripted) Script(responses ...Response) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.responses = append(s.responses, responses...)
+}
+
+// Requests returns the requests received so far.
+func (s *Scripted) Requests() []Request {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return append([]Request(nil), s.requests...)
+}
+
+func (s *Scripted) Complete(ctx context.Context, req Request) (*Response, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	s.requests = append(s.requests, req)
+
+	if len(s.responses) == 0 {
+		return nil, errors.New("scripted provider has no responses left")
+	}
+
+	resp := s.responses[0]
+	s.responses = s.responses[1:]
+
+	return &resp, nil
+}
+
+// Stream returns the next scripted response, reporting its content word by word.
+func (s *Scripted) Stream(ctx context.Context, req Request, onDelta func(delta string)) (*Response, error) {
+	resp, err := s.Complete(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, word := range strings.SplitAfter(resp.Message.Content, " ") {
+		if word != "" {
+			onDelta(word)
+		}
+	}
+
+	return resp, nil
+}
+
+// WithUsage sets the usage reported with a scripted response.
+func (r Response) WithUsage(promptTokens, completionTokens int) Response {
+	r.Usage = Usage{PromptTokens: promptTokens, CompletionTokens: completionTokens}
+	return r
+}
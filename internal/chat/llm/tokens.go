@@ -0,0 +1,96 @@
+package llm
+
+import (
+	"math"
+	"strings"
+)
+
+// TokenCounter estimates how many tokens a model needs for a text. Counts are meant to keep requests within the
+// context window, they are not exact and should not be used for billing.
+type TokenCounter interface {
+	CountTokens(text string) int
+}
+
+// ModelInfo is what the assistant needs to know about a model to size its requests and account for their cost.
+type ModelInfo struct {
+	// ContextWindow is the maximum number of tokens of a request and its completion.
+	ContextWindow int
+	Tokens        TokenCounter
+	Pricing       Pricing
+}
+
+// Pricing is the price of a model in US dollars per million tokens. Unknown models, e.g. local ones, are free.
+type Pricing struct {
+	Input  float64
+	Output float64
+}
+
+// Cost returns the price in US dollars of the tokens a completion consumed.
+func (mi ModelInfo) Cost(u Usage) float64 {
+	return (float64(u.PromptTokens)*mi.Pricing.Input + float64(u.CompletionTokens)*mi.Pricing.Output) / 1_000_000
+}
+
+// CountMessages estimates the tokens a request with the given messages takes, including the few tokens of overhead
+// the chat format adds to every message.
+func (mi ModelInfo) CountMessages(msgs ...Message) int {
+	const perMessage, perRequest = 4, 3
+
+	n := perRequest
+	for _, m := range msgs {
+		n += perMessage + mi.Tokens.CountTokens(m.Content)
+		for _, call := range m.ToolCalls {
+			n += mi.Tokens.CountTokens(call.Name) + mi.Tokens.CountTokens(call.Arguments)
+		}
+	}
+
+	return n
+}
+
+// estimator approximates a tokenizer by the average number of bytes per token for English text. Text with many
+// non-ASCII characters takes more bytes, which roughly matches it taking more tokens as well.
+type estimator struct {
+	bytesPerToken float64
+}
+
+func (e estimator) CountTokens(text string) int {
+	n := int(math.Ceil(float64(len(text)) / e.bytesPerToken))
+
+	// every word is at least one token, whatever its length
+	return max(n, len(strings.Fields(text)))
+}
+
+var (
+	o200k   = estimator{bytesPerToken: 4}
+	cl100k  = estimator{bytesPerToken: 3.7}
+	unknown = estimator{bytesPerToken: 3}
+)
+
+// models is keyed by model name prefix, the longest matching prefix wins.
+var models = map[string]ModelInfo{
+	"gpt-4.1":       {ContextWindow: 1_047_576, Tokens: o200k, Pricing: Pricing{Input: 2, Output: 8}},
+	"gpt-4.1-mini":  {ContextWindow: 1_047_576, Tokens: o200k, Pricing: Pricing{Input: 0.4, Output: 1.6}},
+	"gpt-4.1-nano":  {ContextWindow: 1_047_576, Tokens: o200k, Pricing: Pricing{Input: 0.1, Output: 0.4}},
+	"gpt-4o":        {ContextWindow: 128_000, Tokens: o200k, Pricing: Pricing{Input: 2.5, Output: 10}},
+	"gpt-4o-mini":   {ContextWindow: 128_000, Tokens: o200k, Pricing: Pricing{Input: 0.15, Output: 0.6}},
+	"o1":            {ContextWindow: 200_000, Tokens: o200k, Pricing: Pricing{Input: 15, Output: 60}},
+	"o1-mini":       {ContextWindow: 128_000, Tokens: o200k, Pricing: Pricing{Input: 1.1, Output: 4.4}},
+	"o3":            {ContextWindow: 200_000, Tokens: o200k, Pricing: Pricing{Input: 2, Output: 8}},
+	"o3-mini":       {ContextWindow: 200_000, Tokens: o200k, Pricing: Pricing{Input: 1.1, Output: 4.4}},
+	"o4":            {ContextWindow: 200_000, Tokens: o200k, Pricing: Pricing{Input: 1.1, Output: 4.4}},
+	"gpt-4-turbo":   {ContextWindow: 128_000, Tokens: cl100k, Pricing: Pricing{Input: 10, Output: 30}},
+	"gpt-4":         {ContextWindow: 8_192, Tokens: cl100k, Pricing: Pricing{Input: 30, Output: 60}},
+	"gpt-3.5-turbo": {ContextWindow: 16_385, Tokens: cl100k, Pricing: Pricing{Input: 0.5, Output: 1.5}},
+}
+
+// LookupModel returns what is known about the model. Unknown models, e.g. local ones, get a conservative default.
+func LookupModel(name string) ModelInfo {
+	info, prefix := ModelInfo{ContextWindow: 8_192, Tokens: unknown}, ""
+
+	for p, mi := range models {
+		if strings.HasPrefix(name, p) && len(p) > len(prefix) {
+			info, prefix = mi, p
+		}
+	}
+
+	return info
+}
@@ -0,0 +1,65 @@
+package model
+
+import (
+	"context"
+	"time"
+
+	"github.com/acai-travel/tech-challenge/internal/httpx"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+var _ httpx.RateLimitStore = (*Repository)(nil)
+
+const (
+	bucketCollection = "rate_limit_buckets"
+
+	// bucketTTL outlives the time buckets take to refill, at most an hour with rates per hour, a bucket that expired is
+	// full again anyway.
+	bucketTTL = 2 * time.Hour
+)
+
+// TakeToken implements httpx.RateLimitStore, so replicas share their rate limits. Buckets are refilled and taken from in
+// a single atomic update, timed by the database's clock so replicas with skewed clocks agree.
+func (r *Repository) TakeToken(ctx context.Context, key string, rate float64, burst int) (bool, time.Duration, error) {
+	elapsed := bson.M{"$divide": bson.A{
+		bson.M{"$subtract": bson.A{"$$NOW", bson.M{"$ifNull": bson.A{"$updated_at", "$$NOW"}}}},
+		1000,
+	}}
+	refilled := bson.M{"$min": bson.A{
+		burst,
+		bson.M{"$add": bson.A{bson.M{"$ifNull": bson.A{"$tokens", burst}}, bson.M{"$multiply": bson.A{elapsed, rate}}}},
+	}}
+	available := bson.M{"$gte": bson.A{"$tokens", 1}}
+
+	update := mongo.Pipeline{
+		{{Key: "$set", Value: bson.M{"tokens": refilled, "updated_at": "$$NOW"}}},
+		{{Key: "$set", Value: bson.M{
+			"taken":  available,
+			"tokens": bson.M{"$cond": bson.A{available, bson.M{"$subtract": bson.A{"$tokens", 1}}, "$tokens"}},
+		}}},
+	}
+
+	var doc struct {
+		Tokens float64 `bson:"tokens"`
+		Taken  bool    `bson:"taken"`
+	}
+
+	opts := options.FindOneAndUpdate().SetUpsert(true).SetReturnDocument(options.After)
+	err := r.conn.Collection(bucketCollection).FindOneAndUpdate(ctx, bson.M{"_id": key}, update, opts).Decode(&doc)
+	if mongo.IsDuplicateKeyError(err) {
+		// another request created the bucket concurrently, it exists now
+		err = r.conn.Collection(bucketCollection).FindOneAndUpdate(ctx, bson.M{"_id": key}, update, opts).Decode(&doc)
+	}
+
+	if err != nil {
+		return false, 0, err
+	}
+
+	if !doc.Taken {
+		return false, time.Duration((1 - doc.Tokens) / rate * float64(time.Second)), nil
+	}
+
+	return true, 0, nil
+}
@@ -1,6 +1,7 @@
 package model
 
 import (
+	"slices"
 	"time"
 
 	"github.com/acai-travel/tech-challenge/internal/pb"
@@ -13,7 +14,149 @@ type Conversation struct {
 	Title     string             `bson:"subject"`
 	CreatedAt time.Time          `bson:"created_at"`
 	UpdatedAt time.Time          `bson:"updated_at"`
-	Messages  []*Message         `bson:"messages"`
+	Archived  bool               `bson:"archived"`
+
+	// TitlePending is set while the title is generated in the background, renaming the conversation clears it so the
+	// generated title does not override the new one.
+	TitlePending bool `bson:"title_pending"`
+
+	// AwaitingApproval is set while the reply waits for the user to approve or reject the tool calls ending the
+	// conversation, see PendingToolCalls.
+	AwaitingApproval bool `bson:"awaiting_approval"`
+
+	// OwnerID is the user who started the conversation, queries scoped to another owner do not find it, see WithOwner.
+	// It is empty for conversations started anonymously, when authentication is disabled.
+	OwnerID string `bson:"owner_id,omitempty"`
+
+	// TenantID is the tenant the conversation was started in, queries scoped to another tenant do not find it, see
+	// WithTenant. Tenant is not stored, it is loaded on demand.
+	TenantID string  `bson:"tenant_id,omitempty"`
+	Tenant   *Tenant `bson:"-"`
+
+	// ParentID and ForkPoint are set on conversations forked from another one, ForkPoint is the ID of the parent's
+	// message the fork branched off after.
+	ParentID  primitive.ObjectID `bson:"parent_id,omitempty"`
+	ForkPoint primitive.ObjectID `bson:"fork_point,omitempty"`
+
+	Settings Settings `bson:"settings"`
+
+	// PersonaID is the persona replying to the conversation, if any. Persona is not stored, it is loaded on demand.
+	PersonaID primitive.ObjectID `bson:"persona_id,omitempty"`
+	Persona   *Persona           `bson:"-"`
+
+	// Summary condenses the oldest messages once the conversation outgrew the assistant's context budget.
+	Summary *Summary `bson:"summary,omitempty"`
+
+	// Usage adds up all the tokens used by the conversation, including those of messages that were since replaced or
+	// dropped. TitleUsage is the part used to generate the title.
+	Usage      Usage  `bson:"usage"`
+	TitleUsage *Usage `bson:"title_usage,omitempty"`
+
+	// Version is incremented on every write, writes based on an outdated version are rejected.
+	Version int64 `bson:"version"`
+
+	// Messages are stored in a collection of their own, see Repository.
+	Messages []*Message `bson:"-"`
+}
+
+// Summary is a rolling summary of the messages before Position, it replaces them when replying to the conversation.
+// It is extended as the conversation grows, so only the messages since the last update need to be summarized.
+type Summary struct {
+	Content   string    `bson:"content"`
+	Position  int       `bson:"position"`
+	UpdatedAt time.Time `bson:"updated_at"`
+}
+
+// PendingToolCalls returns the messages of the tool calls ending the conversation that await the user's approval.
+func (c *Conversation) PendingToolCalls() []*Message {
+	var pending []*Message
+	for i := len(c.Messages) - 1; i >= 0 && c.Messages[i].Role == RoleToolCall; i-- {
+		if c.Messages[i].ToolCall.Approval == ApprovalPending {
+			pending = append([]*Message{c.Messages[i]}, pending...)
+		}
+	}
+	return pending
+}
+
+// Summarized returns the position of the first message not covered by the summary.
+func (c *Conversation) Summarized() int {
+	if c.Summary == nil {
+		return 0
+	}
+	return c.Summary.Position
+}
+
+// Truncate drops the messages from the given position onwards, along with the summary if it covered any of them.
+func (c *Conversation) Truncate(pos int) {
+	c.Messages = c.Messages[:pos]
+	if c.Summarized() > pos {
+		c.Summary = nil
+	}
+}
+
+// AddMessage appends a message to the conversation, assigning its position and adding its usage to the conversation's.
+// The message still needs to be persisted using Repository.AppendMessages.
+func (c *Conversation) AddMessage(m *Message) {
+	m.ConversationID = c.ID
+	m.Position = len(c.Messages)
+	c.Messages = append(c.Messages, m)
+
+	if m.Usage != nil {
+		c.Usage.Add(*m.Usage)
+	}
+}
+
+// SetTitle sets the title of the conversation and records the usage of generating it.
+func (c *Conversation) SetTitle(title string, usage Usage) {
+	c.Title = title
+	c.TitleUsage = &usage
+	c.Usage.Add(usage)
+}
+
+// Fork returns a new conversation with copies of the messages up to and including the message with the given ID, or
+// nil if there is no such message. The fork still needs to be persisted using Repository.CreateConversation. The fork's
+// title is pending while the conversation's is, as the title being generated is only saved to the conversation.
+func (c *Conversation) Fork(messageID primitive.ObjectID, at time.Time) *Conversation {
+	pos := slices.IndexFunc(c.Messages, func(m *Message) bool {
+		return m.ID == messageID
+	})
+	if pos < 0 {
+		return nil
+	}
+
+	fork := &Conversation{
+		ID:           primitive.NewObjectID(),
+		Title:        c.Title,
+		TitlePending: c.TitlePending,
+		CreatedAt:    at,
+		UpdatedAt:    at,
+		OwnerID:      c.OwnerID,
+		TenantID:     c.TenantID,
+		ParentID:     c.ID,
+		ForkPoint:    messageID,
+		Settings:     c.Settings.clone(),
+		PersonaID:    c.PersonaID,
+	}
+
+	if c.Summary != nil && c.Summary.Position <= pos+1 {
+		summary := *c.Summary
+		fork.Summary = &summary
+	}
+
+	for _, m := range c.Messages[:pos+1] {
+		cp := *m
+		cp.ID = primitive.NewObjectID()
+		cp.Versions = slices.Clone(m.Versions)
+		// the fork did not cost anything, the usage stays with the parent
+		cp.Usage = nil
+		if m.ToolCall != nil {
+			tc := *m.ToolCall
+			cp.ToolCall = &tc
+		}
+		fork.AddMessage(&cp)
+	}
+
+	return fork
 }
 
 func (c *Conversation) Proto() *pb.Conversation {
@@ -21,6 +164,23 @@ func (c *Conversation) Proto() *pb.Conversation {
 		Id:        c.ID.Hex(),
 		Title:     c.Title,
 		Timestamp: timestamppb.New(c.UpdatedAt),
+		Archived:  c.Archived,
+		Settings:  c.Settings.Proto(),
+		Usage:     c.Usage.Proto(),
+		OwnerId:   c.OwnerID,
+		TenantId:  c.TenantID,
+
+		TitlePending:     c.TitlePending,
+		AwaitingApproval: c.AwaitingApproval,
+	}
+
+	if !c.PersonaID.IsZero() {
+		proto.PersonaId = c.PersonaID.Hex()
+	}
+
+	if !c.ParentID.IsZero() {
+		proto.ParentId = c.ParentID.Hex()
+		proto.ForkPointMessageId = c.ForkPoint.Hex()
 	}
 
 	for _, m := range c.Messages {
@@ -0,0 +1,31 @@
+package model
+
+import "context"
+
+func (s *MemoryStore) IncrementUsage(ctx context.Context, u Usage, keys ...string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	u.Model = ""
+	for _, key := range keys {
+		counter := s.counters[key]
+		counter.Add(u)
+		s.counters[key] = counter
+	}
+
+	return nil
+}
+
+func (s *MemoryStore) CountedUsage(ctx context.Context, keys ...string) (map[string]Usage, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	counted := map[string]Usage{}
+	for _, key := range keys {
+		if counter, ok := s.counters[key]; ok {
+			counted[key] = counter
+		}
+	}
+
+	return counted, nil
+}
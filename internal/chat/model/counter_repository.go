@@ -0,0 +1,72 @@
+package model
+
+import (
+	"context"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+const (
+	counterCollection = "usage_counters"
+
+	// counterTTL outlives the longest period counters are kept for, a month, with room to spare.
+	counterTTL = 62 * 24 * time.Hour
+)
+
+func (r *Repository) IncrementUsage(ctx context.Context, u Usage, keys ...string) error {
+	if len(keys) == 0 {
+		return nil
+	}
+
+	now := time.Now()
+	writes := make([]mongo.WriteModel, len(keys))
+	for i, key := range keys {
+		writes[i] = mongo.NewUpdateOneModel().
+			SetFilter(bson.M{"_id": key}).
+			SetUpdate(bson.M{
+				"$inc": bson.M{
+					"prompt_tokens":     u.PromptTokens,
+					"completion_tokens": u.CompletionTokens,
+					"cost":              u.Cost,
+				},
+				"$set": bson.M{"updated_at": now},
+			}).
+			SetUpsert(true)
+	}
+
+	_, err := r.conn.Collection(counterCollection).BulkWrite(ctx, writes)
+	return err
+}
+
+func (r *Repository) CountedUsage(ctx context.Context, keys ...string) (map[string]Usage, error) {
+	counted := map[string]Usage{}
+	if len(keys) == 0 {
+		return counted, nil
+	}
+
+	cursor, err := r.conn.Collection(counterCollection).Find(ctx, bson.M{"_id": bson.M{"$in": keys}})
+	if err != nil {
+		return nil, err
+	}
+
+	defer func() {
+		_ = cursor.Close(ctx)
+	}()
+
+	for cursor.Next(ctx) {
+		var doc struct {
+			Key   string `bson:"_id"`
+			Usage `bson:",inline"`
+		}
+
+		if err := cursor.Decode(&doc); err != nil {
+			return nil, err
+		}
+
+		counted[doc.Key] = doc.Usage
+	}
+
+	return counted, cursor.Err()
+}
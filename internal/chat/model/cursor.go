@@ -0,0 +1,43 @@
+package model
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// Cursor marks a position in the list of conversations, which is sorted by most recently updated first. It is handed
+// out to clients as an opaque page token.
+type Cursor struct {
+	UpdatedAt time.Time          `json:"u"`
+	ID        primitive.ObjectID `json:"i"`
+}
+
+// CursorOf returns a cursor pointing right after the given conversation.
+func CursorOf(c *Conversation) *Cursor {
+	return &Cursor{UpdatedAt: c.UpdatedAt, ID: c.ID}
+}
+
+// Token encodes the cursor as an opaque page token.
+func (c *Cursor) Token() string {
+	data, _ := json.Marshal(c)
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+// ParseCursor decodes a page token created by Cursor.Token.
+func ParseCursor(token string) (*Cursor, error) {
+	data, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, errors.New("malformed page token")
+	}
+
+	var c Cursor
+	if err := json.Unmarshal(data, &c); err != nil || c.ID.IsZero() {
+		return nil, errors.New("malformed page token")
+	}
+
+	return &c, nil
+}
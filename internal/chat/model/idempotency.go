@@ -0,0 +1,28 @@
+package model
+
+import "time"
+
+// IdempotentRequest is a request made with an idempotency key, so that retries of it get the response of the first
+// attempt instead of running it again.
+type IdempotentRequest struct {
+	// Key identifies the request, callers scope it so that the keys of different callers and methods never clash.
+	Key string `bson:"_id"`
+
+	// Claim identifies the attempt holding the key. Attempts complete or release the request only while they hold it,
+	// so an attempt whose claim ran out does not overwrite the retry that took the key over.
+	Claim string `bson:"claim"`
+
+	// Fingerprint of the request, to tell retries apart from other requests reusing the key.
+	Fingerprint string `bson:"fingerprint"`
+
+	// Response of the request once it is done, encoded by the caller.
+	Response []byte `bson:"response,omitempty"`
+	Done     bool   `bson:"done"`
+
+	// LockedUntil is when the claim of a request that is not done runs out, so a crashed attempt does not hold on to
+	// the key until it expires.
+	LockedUntil time.Time `bson:"locked_until"`
+
+	// ExpiresAt is when the key is forgotten, and may be used again.
+	ExpiresAt time.Time `bson:"expires_at"`
+}
@@ -0,0 +1,44 @@
+package model
+
+import (
+	"context"
+	"slices"
+	"time"
+)
+
+func (s *MemoryStore) ClaimRequest(ctx context.Context, r *IdempotentRequest) (*IdempotentRequest, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := time.Now()
+	if held, ok := s.idempotent[r.Key]; ok && now.Before(held.ExpiresAt) && (held.Done || now.Before(held.LockedUntil)) {
+		held := *held
+		held.Response = slices.Clone(held.Response)
+		return &held, nil
+	}
+
+	claimed := *r
+	claimed.Done, claimed.Response = false, nil
+	s.idempotent[r.Key] = &claimed
+	return nil, nil
+}
+
+func (s *MemoryStore) CompleteRequest(ctx context.Context, key, claim string, response []byte) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if r, ok := s.idempotent[key]; ok && r.Claim == claim {
+		r.Done, r.Response = true, slices.Clone(response)
+	}
+	return nil
+}
+
+func (s *MemoryStore) ReleaseRequest(ctx context.Context, key, claim string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if r, ok := s.idempotent[key]; ok && r.Claim == claim && !r.Done {
+		delete(s.idempotent, key)
+	}
+	return nil
+}
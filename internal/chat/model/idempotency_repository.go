@@ -0,0 +1,74 @@
+package model
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+const idempotencyCollection = "idempotency_keys"
+
+// ClaimRequest takes the key over with an upsert that only matches claimable requests, so when another attempt holds
+// the key the upsert collides with it on _id and that attempt is returned instead.
+func (r *Repository) ClaimRequest(ctx context.Context, req *IdempotentRequest) (*IdempotentRequest, error) {
+	coll := r.conn.Collection(idempotencyCollection)
+
+	for {
+		now := time.Now()
+		filter := bson.M{
+			"_id": req.Key,
+			"$or": bson.A{
+				bson.M{"done": false, "locked_until": bson.M{"$lte": now}},
+				// expired requests linger until the TTL monitor removes them
+				bson.M{"expires_at": bson.M{"$lte": now}},
+			},
+		}
+		update := bson.M{
+			"$set": bson.M{
+				"claim":        req.Claim,
+				"fingerprint":  req.Fingerprint,
+				"done":         false,
+				"locked_until": req.LockedUntil,
+				"expires_at":   req.ExpiresAt,
+			},
+			"$unset": bson.M{"response": ""},
+		}
+
+		_, err := coll.UpdateOne(ctx, filter, update, options.Update().SetUpsert(true))
+		if err == nil {
+			return nil, nil
+		}
+		if !mongo.IsDuplicateKeyError(err) {
+			return nil, err
+		}
+
+		var held IdempotentRequest
+		err = coll.FindOne(ctx, bson.M{"_id": req.Key}).Decode(&held)
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			// the attempt holding the key was released meanwhile, claim it again
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		return &held, nil
+	}
+}
+
+func (r *Repository) CompleteRequest(ctx context.Context, key, claim string, response []byte) error {
+	_, err := r.conn.Collection(idempotencyCollection).UpdateOne(ctx,
+		bson.M{"_id": key, "claim": claim},
+		bson.M{"$set": bson.M{"done": true, "response": response}},
+	)
+	return err
+}
+
+func (r *Repository) ReleaseRequest(ctx context.Context, key, claim string) error {
+	_, err := r.conn.Collection(idempotencyCollection).DeleteOne(ctx, bson.M{"_id": key, "claim": claim, "done": false})
+	return err
+}
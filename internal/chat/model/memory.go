@@ -0,0 +1,333 @@
+package model
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/twitchtv/twirp"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// MemoryStore is a thread-safe implementation of all the stores keeping everything in memory, e.g. for tests. It
+// hands out copies, so changes to returned conversations are only visible once they are written back, just like with
+// Repository.
+type MemoryStore struct {
+	mu            sync.RWMutex
+	conversations map[primitive.ObjectID]*Conversation
+	personas      map[primitive.ObjectID]*Persona
+	tenants       map[string]*Tenant
+	counters      map[string]Usage
+	idempotent    map[string]*IdempotentRequest
+	usage         []UsageRecord
+}
+
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{
+		conversations: map[primitive.ObjectID]*Conversation{},
+		personas:      map[primitive.ObjectID]*Persona{},
+		tenants:       map[string]*Tenant{},
+		counters:      map[string]Usage{},
+		idempotent:    map[string]*IdempotentRequest{},
+	}
+}
+
+func (s *MemoryStore) CreateConversation(ctx context.Context, c *Conversation) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.conversations[c.ID]; ok {
+		return errors.New("conversation already exists")
+	}
+
+	for i, m := range c.Messages {
+		m.ConversationID = c.ID
+		m.Position = i
+	}
+
+	s.conversations[c.ID] = c.clone(true)
+	return nil
+}
+
+func (s *MemoryStore) DescribeConversation(ctx context.Context, id string) (*Conversation, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	c, err := s.find(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.clone(true), nil
+}
+
+func (s *MemoryStore) ListConversations(ctx context.Context, lo ListOptions) ([]*Conversation, *Cursor, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	var items []*Conversation
+	for _, c := range s.conversations {
+		if visible(ctx, c) && lo.matches(c) {
+			items = append(items, c.clone(false))
+		}
+	}
+
+	slices.SortFunc(items, func(a, b *Conversation) int {
+		if c := b.UpdatedAt.Compare(a.UpdatedAt); c != 0 {
+			return c
+		}
+		return bytes.Compare(b.ID[:], a.ID[:])
+	})
+
+	if lo.Limit > 0 && len(items) > lo.Limit {
+		items = items[:lo.Limit]
+		return items, CursorOf(items[len(items)-1]), nil
+	}
+
+	return items, nil, nil
+}
+
+func (s *MemoryStore) UpdateConversation(ctx context.Context, c *Conversation) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	stored, err := s.current(ctx, c)
+	if err != nil {
+		return err
+	}
+
+	s.save(c, stored.Messages, nil)
+	return nil
+}
+
+func (s *MemoryStore) AppendMessages(ctx context.Context, c *Conversation, msgs ...*Message) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	stored, err := s.current(ctx, c)
+	if err != nil {
+		return err
+	}
+
+	for i, m := range msgs {
+		if m.Position != len(stored.Messages)+i {
+			return ErrConflict
+		}
+	}
+
+	s.save(c, stored.Messages, msgs)
+	return nil
+}
+
+func (s *MemoryStore) ReplaceMessages(ctx context.Context, c *Conversation, from int, msgs ...*Message) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	stored, err := s.current(ctx, c)
+	if err != nil {
+		return err
+	}
+
+	if from < 0 || from > len(stored.Messages) {
+		return ErrConflict
+	}
+
+	for i, m := range msgs {
+		if m.Position != from+i {
+			return ErrConflict
+		}
+	}
+
+	s.save(c, stored.Messages[:from:from], msgs)
+	return nil
+}
+
+func (s *MemoryStore) DeleteConversation(ctx context.Context, id string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	c, err := s.find(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	delete(s.conversations, c.ID)
+	return nil
+}
+
+func (s *MemoryStore) RecordUsage(ctx context.Context, r *UsageRecord) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	record := *r
+	record.CreatedAt = normalizeTime(r.CreatedAt)
+	s.usage = append(s.usage, record)
+	return nil
+}
+
+func (s *MemoryStore) UsageReport(ctx context.Context, opts UsageReportOptions) ([]UsageReportRow, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	type key struct{ day, model string }
+	usage := map[key]*Usage{}
+
+	for _, r := range s.usage {
+		if owner := OwnerFrom(ctx); owner != "" && r.OwnerID != owner {
+			continue
+		}
+		if !inTenant(ctx, r.TenantID) || !inRange(r.CreatedAt, opts.Start, opts.End) {
+			continue
+		}
+
+		k := key{day: r.CreatedAt.Format(usageDayFormat), model: r.Usage.Model}
+		if usage[k] == nil {
+			usage[k] = &Usage{Model: r.Usage.Model}
+		}
+		usage[k].Add(r.Usage)
+	}
+
+	rows := []UsageReportRow{}
+	for k, u := range usage {
+		rows = append(rows, UsageReportRow{Day: k.day, Usage: *u})
+	}
+
+	slices.SortFunc(rows, func(a, b UsageReportRow) int {
+		if c := strings.Compare(a.Day, b.Day); c != 0 {
+			return c
+		}
+		return strings.Compare(a.Usage.Model, b.Usage.Model)
+	})
+
+	return rows, nil
+}
+
+// save stores the conversation's own fields with the given messages followed by msgs, and bumps its version.
+func (s *MemoryStore) save(c *Conversation, messages []*Message, msgs []*Message) {
+	updated := c.clone(false)
+	updated.Messages = messages
+	for _, m := range msgs {
+		updated.Messages = append(updated.Messages, m.clone())
+	}
+	updated.Version++
+
+	s.conversations[c.ID] = updated
+	c.Version++
+}
+
+func (s *MemoryStore) find(ctx context.Context, id string) (*Conversation, error) {
+	oid, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return nil, twirp.NotFoundError("invalid conversation ID")
+	}
+
+	c, ok := s.conversations[oid]
+	if !ok || !visible(ctx, c) {
+		return nil, twirp.NotFoundError("conversation not found")
+	}
+
+	return c, nil
+}
+
+// current returns the stored conversation, provided it has the same version as c.
+func (s *MemoryStore) current(ctx context.Context, c *Conversation) (*Conversation, error) {
+	stored, ok := s.conversations[c.ID]
+	if !ok || !visible(ctx, stored) {
+		return nil, twirp.NotFoundError("conversation not found")
+	}
+
+	if stored.Version != c.Version {
+		return nil, ErrConflict
+	}
+
+	return stored, nil
+}
+
+func (lo ListOptions) matches(c *Conversation) bool {
+	switch {
+	case !lo.IncludeArchived && c.Archived:
+		return false
+	case !inRange(c.CreatedAt, lo.CreatedAfter, lo.CreatedBefore):
+		return false
+	case !inRange(c.UpdatedAt, lo.UpdatedAfter, lo.UpdatedBefore):
+		return false
+	case !strings.HasPrefix(c.Title, lo.TitlePrefix):
+		return false
+	case !lo.ParentID.IsZero() && c.ParentID != lo.ParentID:
+		return false
+	case lo.After != nil:
+		if c.UpdatedAt.Equal(lo.After.UpdatedAt) {
+			return bytes.Compare(c.ID[:], lo.After.ID[:]) < 0
+		}
+		return c.UpdatedAt.Before(lo.After.UpdatedAt)
+	}
+
+	return true
+}
+
+func inRange(t, from, to time.Time) bool {
+	return (from.IsZero() || !t.Before(from)) && (to.IsZero() || t.Before(to))
+}
+
+// clone deep copies the conversation, including its messages if requested. Times are normalized the way MongoDB
+// stores them.
+func (c *Conversation) clone(messages bool) *Conversation {
+	cp := *c
+	cp.CreatedAt = normalizeTime(c.CreatedAt)
+	cp.UpdatedAt = normalizeTime(c.UpdatedAt)
+	cp.Messages = nil
+	cp.Settings = c.Settings.clone()
+	cp.Persona = nil
+	cp.Tenant = nil
+
+	if c.TitleUsage != nil {
+		usage := *c.TitleUsage
+		cp.TitleUsage = &usage
+	}
+
+	if c.Summary != nil {
+		summary := *c.Summary
+		summary.UpdatedAt = normalizeTime(summary.UpdatedAt)
+		cp.Summary = &summary
+	}
+
+	if messages {
+		for _, m := range c.Messages {
+			cp.Messages = append(cp.Messages, m.clone())
+		}
+	}
+
+	return &cp
+}
+
+func (m *Message) clone() *Message {
+	cp := *m
+	cp.CreatedAt = normalizeTime(m.CreatedAt)
+	cp.UpdatedAt = normalizeTime(m.UpdatedAt)
+
+	if m.ToolCall != nil {
+		tc := *m.ToolCall
+		cp.ToolCall = &tc
+	}
+
+	if m.Usage != nil {
+		usage := *m.Usage
+		cp.Usage = &usage
+	}
+
+	cp.Versions = nil
+	for _, v := range m.Versions {
+		cp.Versions = append(cp.Versions, MessageVersion{Content: v.Content, CreatedAt: normalizeTime(v.CreatedAt)})
+	}
+
+	return &cp
+}
+
+// normalizeTime truncates to milliseconds in UTC, which is what MongoDB gives back.
+func normalizeTime(t time.Time) time.Time {
+	return t.Truncate(time.Millisecond).UTC()
+}
@@ -0,0 +1,18 @@
+package model_test
+
+import (
+	"testing"
+
+	"github.com/acai-travel/tech-challenge/internal/chat/model"
+	. "github.com/acai-travel/tech-challenge/internal/chat/testing"
+)
+
+func TestMemoryStore(t *testing.T) {
+	store := model.NewMemoryStore()
+
+	RunConversationStoreContract(t, store)
+	RunPersonaStoreContract(t, store)
+	RunTenantStoreContract(t, store)
+	RunUsageCounterStoreContract(t, store)
+	RunIdempotencyStoreContract(t, store)
+}
@@ -5,22 +5,116 @@ import (
 
 	"github.com/acai-travel/tech-challenge/internal/pb"
 	"go.mongodb.org/mongo-driver/bson/primitive"
+	"google.golang.org/protobuf/types/known/durationpb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 type Message struct {
-	ID        primitive.ObjectID `bson:"_id"`
-	Role      Role               `bson:"role"`
-	Content   string             `bson:"content"`
-	CreatedAt time.Time          `bson:"created_at"`
-	UpdatedAt time.Time          `bson:"updated_at"`
+	ID             primitive.ObjectID `bson:"_id"`
+	ConversationID primitive.ObjectID `bson:"conversation_id"`
+	Position       int                `bson:"position"`
+	Role           Role               `bson:"role"`
+	Content        string             `bson:"content"`
+	CreatedAt      time.Time          `bson:"created_at"`
+	UpdatedAt      time.Time          `bson:"updated_at"`
+
+	// ToolCall is set on RoleToolCall and RoleToolResult messages.
+	ToolCall *ToolCall `bson:"tool_call,omitempty"`
+
+	// Versions holds the contents the message had before it was edited or regenerated, oldest first.
+	Versions []MessageVersion `bson:"versions,omitempty"`
+
+	// Usage is set on RoleAssistant messages, it includes the tool calls leading to the message and, once regenerated,
+	// its previous versions. A reply stopped to await approval of tool calls sets it on the last call instead.
+	Usage *Usage `bson:"usage,omitempty"`
+}
+
+// MessageVersion is a replaced content of a message.
+type MessageVersion struct {
+	Content   string    `bson:"content"`
+	CreatedAt time.Time `bson:"created_at"`
+}
+
+// Revise replaces the content of the message, the current content is kept in its versions.
+func (m *Message) Revise(content string, at time.Time) {
+	m.Versions = append(m.Versions, MessageVersion{Content: m.Content, CreatedAt: m.UpdatedAt})
+	m.Content = content
+	m.UpdatedAt = at
+}
+
+// ToolCall describes a tool called by the assistant. Result, Error and Duration are only known once the tool returned,
+// so they are only set on RoleToolResult messages.
+type ToolCall struct {
+	ID        string        `bson:"id"`
+	Name      string        `bson:"name"`
+	Arguments string        `bson:"arguments"`
+	Result    string        `bson:"result,omitempty"`
+	Error     string        `bson:"error,omitempty"`
+	Duration  time.Duration `bson:"duration,omitempty"`
+
+	// Approval is set on RoleToolCall messages of tools that need the user's approval before running, along with the
+	// reason the user gave when rejecting the call.
+	Approval        Approval `bson:"approval,omitempty"`
+	RejectionReason string   `bson:"rejection_reason,omitempty"`
+}
+
+// Approval is the user's decision on a tool call that needs it.
+type Approval string
+
+const (
+	ApprovalPending  Approval = "pending"
+	ApprovalApproved Approval = "approved"
+	ApprovalRejected Approval = "rejected"
+)
+
+func (a Approval) Proto() pb.Conversation_Approval {
+	switch a {
+	case ApprovalPending:
+		return pb.Conversation_APPROVAL_PENDING
+	case ApprovalApproved:
+		return pb.Conversation_APPROVAL_APPROVED
+	case ApprovalRejected:
+		return pb.Conversation_APPROVAL_REJECTED
+	default:
+		return pb.Conversation_APPROVAL_NOT_REQUIRED
+	}
 }
 
 func (m *Message) Proto() *pb.Conversation_Message {
-	return &pb.Conversation_Message{
+	proto := &pb.Conversation_Message{
 		Id:        m.ID.Hex(),
 		Role:      m.Role.Proto(),
 		Content:   m.Content,
 		Timestamp: timestamppb.New(m.CreatedAt),
 	}
+
+	if tc := m.ToolCall; tc != nil {
+		proto.ToolCall = &pb.Conversation_ToolCall{
+			Id:        tc.ID,
+			Name:      tc.Name,
+			Arguments: tc.Arguments,
+			Result:    tc.Result,
+			Error:     tc.Error,
+
+			Approval:        tc.Approval.Proto(),
+			RejectionReason: tc.RejectionReason,
+		}
+
+		if m.Role == RoleToolResult {
+			proto.ToolCall.Duration = durationpb.New(tc.Duration)
+		}
+	}
+
+	if m.Usage != nil {
+		proto.Usage = m.Usage.Proto()
+	}
+
+	for _, v := range m.Versions {
+		proto.Versions = append(proto.Versions, &pb.Conversation_MessageVersion{
+			Content:   v.Content,
+			Timestamp: timestamppb.New(v.CreatedAt),
+		})
+	}
+
+	return proto
 }
@@ -0,0 +1,143 @@
+package model
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// migrateMessages moves the messages embedded in conversations, as they were stored before they got a collection of
+// their own, to the messages collection, and sets the version of conversations that have none. Messages are inserted
+// before being removed from their conversation, and inserting them again is harmless, so an interrupted migration picks
+// up where it left off the next time.
+func (r *Repository) migrateMessages(ctx context.Context) error {
+	conversations := r.conn.Collection(conversationCollection)
+
+	_, err := conversations.UpdateMany(ctx, bson.M{"version": bson.M{"$exists": false}}, bson.M{"$set": bson.M{"version": 0}})
+	if err != nil {
+		return err
+	}
+
+	cursor, err := conversations.Find(ctx,
+		bson.M{"messages": bson.M{"$exists": true}},
+		options.Find().SetProjection(bson.M{"messages": 1}))
+	if err != nil {
+		return err
+	}
+
+	defer func() {
+		_ = cursor.Close(ctx)
+	}()
+
+	for cursor.Next(ctx) {
+		var doc struct {
+			ID       primitive.ObjectID `bson:"_id"`
+			Messages []*Message         `bson:"messages"`
+		}
+
+		if err := cursor.Decode(&doc); err != nil {
+			return err
+		}
+
+		if len(doc.Messages) > 0 {
+			docs := make([]any, len(doc.Messages))
+			for i, m := range doc.Messages {
+				m.ConversationID = doc.ID
+				m.Position = i
+				docs[i] = m
+			}
+
+			// messages moved by an interrupted migration are skipped
+			_, err := r.conn.Collection(messageCollection).InsertMany(ctx, docs, options.InsertMany().SetOrdered(false))
+			if err != nil && !onlyDuplicates(err) {
+				return err
+			}
+		}
+
+		_, err := conversations.UpdateOne(ctx, bson.M{"_id": doc.ID}, bson.M{"$unset": bson.M{"messages": ""}})
+		if err != nil {
+			return err
+		}
+	}
+
+	return cursor.Err()
+}
+
+// onlyDuplicates reports whether every write of a bulk write failed for inserting a duplicate.
+func onlyDuplicates(err error) bool {
+	var bwe mongo.BulkWriteException
+	if !errors.As(err, &bwe) || bwe.WriteConcernError != nil {
+		return false
+	}
+
+	for _, we := range bwe.WriteErrors {
+		if !mongo.IsDuplicateKeyError(we.WriteError) {
+			return false
+		}
+	}
+	return true
+}
+
+const migrationCollection = "migrations"
+
+// migrateUsage records the usage of the stored messages and conversation titles in the ledger, as it was not kept
+// before. Records take the IDs of the messages and conversations they are made of, so an interrupted migration does
+// not record them twice when it runs again. Once done, the migration is marked as such and never runs again, the
+// messages and titles of later conversations are recorded as they are generated.
+func (r *Repository) migrateUsage(ctx context.Context) error {
+	migrations := r.conn.Collection(migrationCollection)
+
+	err := migrations.FindOne(ctx, bson.M{"_id": usageCollection}).Err()
+	if err == nil {
+		return nil
+	}
+	if !errors.Is(err, mongo.ErrNoDocuments) {
+		return err
+	}
+
+	pipeline := mongo.Pipeline{
+		{{Key: "$match", Value: bson.M{"usage": bson.M{"$exists": true}}}},
+		{{Key: "$lookup", Value: bson.M{
+			"from":         conversationCollection,
+			"localField":   "conversation_id",
+			"foreignField": "_id",
+			"as":           "conversation",
+		}}},
+		{{Key: "$unwind", Value: "$conversation"}},
+		{{Key: "$project", Value: bson.M{
+			"conversation_id": 1,
+			"owner_id":        "$conversation.owner_id",
+			"tenant_id":       "$conversation.tenant_id",
+			"usage":           1,
+			"created_at":      1,
+		}}},
+		{{Key: "$unionWith", Value: bson.M{
+			"coll": conversationCollection,
+			"pipeline": bson.A{
+				bson.M{"$match": bson.M{"title_usage": bson.M{"$exists": true}}},
+				bson.M{"$project": bson.M{
+					"conversation_id": "$_id",
+					"owner_id":        1,
+					"tenant_id":       1,
+					"usage":           "$title_usage",
+					"created_at":      1,
+				}},
+			},
+		}}},
+		{{Key: "$merge", Value: bson.M{"into": usageCollection, "whenMatched": "keepExisting"}}},
+	}
+
+	cursor, err := r.conn.Collection(messageCollection).Aggregate(ctx, pipeline)
+	if err != nil {
+		return err
+	}
+	_ = cursor.Close(ctx)
+
+	_, err = migrations.InsertOne(ctx, bson.M{"_id": usageCollection, "migrated_at": time.Now()})
+	return err
+}
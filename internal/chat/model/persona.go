@@ -0,0 +1,40 @@
+package model
+
+import (
+	"time"
+
+	"github.com/acai-travel/tech-challenge/internal/pb"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Persona is a named assistant with its own system prompt, model and tools. Conversations started with a persona
+// follow it, unless their own settings say otherwise.
+type Persona struct {
+	ID primitive.ObjectID `bson:"_id"`
+
+	// TenantID is the tenant the persona belongs to, queries scoped to another tenant do not find it, see WithTenant.
+	// Personas are shared by the users of their tenant.
+	TenantID string `bson:"tenant_id,omitempty"`
+
+	Name         string    `bson:"name"`
+	SystemPrompt string    `bson:"system_prompt"`
+	Model        string    `bson:"model,omitempty"`
+	CreatedAt    time.Time `bson:"created_at"`
+	UpdatedAt    time.Time `bson:"updated_at"`
+
+	// Tools is the allow-list of tools the persona may call, by name. The persona can not call any tool when empty,
+	// unlike a tenant, see Tenant.Tools: personas pick the tools they need, tenants only narrow down what is allowed.
+	Tools []string `bson:"tools"`
+}
+
+func (p *Persona) Proto() *pb.Persona {
+	return &pb.Persona{
+		Id:           p.ID.Hex(),
+		Name:         p.Name,
+		SystemPrompt: p.SystemPrompt,
+		Model:        p.Model,
+		Tools:        p.Tools,
+		Timestamp:    timestamppb.New(p.UpdatedAt),
+	}
+}
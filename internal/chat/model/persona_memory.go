@@ -0,0 +1,113 @@
+package model
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"strings"
+
+	"github.com/twitchtv/twirp"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+func (s *MemoryStore) CreatePersona(ctx context.Context, p *Persona) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.personas[p.ID]; ok {
+		return errors.New("persona already exists")
+	}
+
+	if s.nameTaken(p) {
+		return ErrPersonaExists
+	}
+
+	s.personas[p.ID] = p.clone()
+	return nil
+}
+
+func (s *MemoryStore) DescribePersona(ctx context.Context, id string) (*Persona, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	oid, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return nil, twirp.NotFoundError("invalid persona ID")
+	}
+
+	p, ok := s.personas[oid]
+	if !ok || !inTenant(ctx, p.TenantID) {
+		return nil, twirp.NotFoundError("persona not found")
+	}
+
+	return p.clone(), nil
+}
+
+func (s *MemoryStore) ListPersonas(ctx context.Context) ([]*Persona, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	var items []*Persona
+	for _, p := range s.personas {
+		if inTenant(ctx, p.TenantID) {
+			items = append(items, p.clone())
+		}
+	}
+
+	slices.SortFunc(items, func(a, b *Persona) int {
+		return strings.Compare(a.Name, b.Name)
+	})
+
+	return items, nil
+}
+
+func (s *MemoryStore) UpdatePersona(ctx context.Context, p *Persona) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if existing, ok := s.personas[p.ID]; !ok || !inTenant(ctx, existing.TenantID) {
+		return twirp.NotFoundError("persona not found")
+	}
+
+	if s.nameTaken(p) {
+		return ErrPersonaExists
+	}
+
+	s.personas[p.ID] = p.clone()
+	return nil
+}
+
+func (s *MemoryStore) DeletePersona(ctx context.Context, id string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	oid, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return twirp.NotFoundError("invalid persona ID")
+	}
+
+	if p, ok := s.personas[oid]; !ok || !inTenant(ctx, p.TenantID) {
+		return twirp.NotFoundError("persona not found")
+	}
+
+	delete(s.personas, oid)
+	return nil
+}
+
+// nameTaken reports whether another persona of the tenant of p has its name.
+func (s *MemoryStore) nameTaken(p *Persona) bool {
+	for _, other := range s.personas {
+		if other.ID != p.ID && other.TenantID == p.TenantID && other.Name == p.Name {
+			return true
+		}
+	}
+	return false
+}
+
+func (p *Persona) clone() *Persona {
+	cp := *p
+	cp.CreatedAt = normalizeTime(p.CreatedAt)
+	cp.UpdatedAt = normalizeTime(p.UpdatedAt)
+	cp.Tools = slices.Clone(p.Tools)
+	return &cp
+}
@@ -0,0 +1,97 @@
+package model
+
+import (
+	"context"
+	"errors"
+
+	"github.com/twitchtv/twirp"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+const personaCollection = "personas"
+
+// ErrPersonaExists is returned when a persona is saved with the name of another one of its tenant.
+var ErrPersonaExists = twirp.NewError(twirp.AlreadyExists, "a persona with this name already exists")
+
+func (r *Repository) CreatePersona(ctx context.Context, p *Persona) error {
+	_, err := r.conn.Collection(personaCollection).InsertOne(ctx, p)
+	if mongo.IsDuplicateKeyError(err) {
+		return ErrPersonaExists
+	}
+
+	return err
+}
+
+func (r *Repository) DescribePersona(ctx context.Context, id string) (*Persona, error) {
+	oid, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return nil, twirp.NotFoundError("invalid persona ID")
+	}
+
+	var p Persona
+	err = r.conn.Collection(personaCollection).FindOne(ctx, tenanted(ctx, "", bson.M{"_id": oid})).Decode(&p)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return nil, twirp.NotFoundError("persona not found")
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &p, nil
+}
+
+// ListPersonas returns all personas sorted by name.
+func (r *Repository) ListPersonas(ctx context.Context) ([]*Persona, error) {
+	cursor, err := r.conn.Collection(personaCollection).Find(ctx, tenanted(ctx, "", bson.M{}),
+		options.Find().SetSort(bson.D{{Key: "name", Value: 1}}))
+
+	if err != nil {
+		return nil, err
+	}
+
+	var items []*Persona
+	if err := cursor.All(ctx, &items); err != nil {
+		return nil, err
+	}
+
+	return items, nil
+}
+
+func (r *Repository) UpdatePersona(ctx context.Context, p *Persona) error {
+	res, err := r.conn.Collection(personaCollection).ReplaceOne(ctx, tenanted(ctx, "", bson.M{"_id": p.ID}), p)
+	if mongo.IsDuplicateKeyError(err) {
+		return ErrPersonaExists
+	}
+
+	if err != nil {
+		return err
+	}
+
+	if res.MatchedCount == 0 {
+		return twirp.NotFoundError("persona not found")
+	}
+
+	return nil
+}
+
+func (r *Repository) DeletePersona(ctx context.Context, id string) error {
+	oid, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return twirp.NotFoundError("invalid persona ID")
+	}
+
+	res, err := r.conn.Collection(personaCollection).DeleteOne(ctx, tenanted(ctx, "", bson.M{"_id": oid}))
+	if err != nil {
+		return err
+	}
+
+	if res.DeletedCount == 0 {
+		return twirp.NotFoundError("persona not found")
+	}
+
+	return nil
+}
@@ -3,6 +3,10 @@ package model
 import (
 	"context"
 	"errors"
+	"fmt"
+	"regexp"
+	"sync"
+	"time"
 
 	"github.com/twitchtv/twirp"
 	"go.mongodb.org/mongo-driver/bson"
@@ -13,10 +17,23 @@ import (
 
 const (
 	conversationCollection = "conversations"
+	messageCollection      = "messages"
+	usageCollection        = "usage_records"
 )
 
+// ErrConflict is returned when a conversation was modified since it was read, the caller should read it again and
+// retry.
+var ErrConflict = twirp.NewError(twirp.Aborted, "conversation was modified concurrently, please retry")
+
+// Repository stores conversations, and everything else, in MongoDB. Writes of a conversation along with its messages
+// run in a transaction when the deployment supports them, i.e. on replica sets and sharded clusters. On a standalone
+// server they are undone as far as possible when failing half way, see atomically.
 type Repository struct {
 	conn *mongo.Database
+
+	// transactions tells whether the deployment supports transactions, nil until asked, see supportsTransactions
+	mu           sync.Mutex
+	transactions *bool
 }
 
 func New(conn *mongo.Database) *Repository {
@@ -25,11 +42,130 @@ func New(conn *mongo.Database) *Repository {
 	}
 }
 
-func (r *Repository) CreateConversation(ctx context.Context, c *Conversation) error {
-	_, err := r.conn.Collection(conversationCollection).InsertOne(ctx, c)
+// EnsureIndexes creates the indexes the repository relies on, and migrates what earlier versions stored, see
+// migrateMessages and migrateUsage. The unique index on message positions also guarantees that concurrent appends can never
+// interleave messages.
+func (r *Repository) EnsureIndexes(ctx context.Context) error {
+	_, err := r.conn.Collection(conversationCollection).Indexes().CreateOne(ctx, mongo.IndexModel{
+		Keys: bson.D{{Key: "updated_at", Value: -1}, {Key: "_id", Value: -1}},
+	})
+	if err != nil {
+		return err
+	}
+
+	// listings scoped to a tenant and an owner
+	_, err = r.conn.Collection(conversationCollection).Indexes().CreateOne(ctx, mongo.IndexModel{
+		Keys: bson.D{{Key: "tenant_id", Value: 1}, {Key: "owner_id", Value: 1}, {Key: "updated_at", Value: -1}, {Key: "_id", Value: -1}},
+	})
+	if err != nil {
+		return err
+	}
+
+	_, err = r.conn.Collection(conversationCollection).Indexes().CreateOne(ctx, mongo.IndexModel{
+		Keys:    bson.D{{Key: "parent_id", Value: 1}},
+		Options: options.Index().SetSparse(true),
+	})
+	if err != nil {
+		return err
+	}
+
+	// persona names used to be unique across tenants
+	if err := dropIndex(ctx, r.conn.Collection(personaCollection), "name_1"); err != nil {
+		return err
+	}
+
+	_, err = r.conn.Collection(personaCollection).Indexes().CreateOne(ctx, mongo.IndexModel{
+		Keys:    bson.D{{Key: "tenant_id", Value: 1}, {Key: "name", Value: 1}},
+		Options: options.Index().SetUnique(true),
+	})
+	if err != nil {
+		return err
+	}
+
+	_, err = r.conn.Collection(messageCollection).Indexes().CreateOne(ctx, mongo.IndexModel{
+		Keys:    bson.D{{Key: "conversation_id", Value: 1}, {Key: "position", Value: 1}},
+		Options: options.Index().SetUnique(true),
+	})
+	if err != nil {
+		return err
+	}
+
+	if err := r.migrateMessages(ctx); err != nil {
+		return err
+	}
+
+	// usage reports used to sum the usage of messages
+	if err := dropIndex(ctx, r.conn.Collection(messageCollection), "created_at_1"); err != nil {
+		return err
+	}
+
+	_, err = r.conn.Collection(usageCollection).Indexes().CreateOne(ctx, mongo.IndexModel{
+		Keys: bson.D{{Key: "created_at", Value: 1}},
+	})
+	if err != nil {
+		return err
+	}
+
+	if err := r.migrateUsage(ctx); err != nil {
+		return err
+	}
+
+	_, err = r.conn.Collection(counterCollection).Indexes().CreateOne(ctx, mongo.IndexModel{
+		Keys:    bson.D{{Key: "updated_at", Value: 1}},
+		Options: options.Index().SetExpireAfterSeconds(int32(counterTTL.Seconds())),
+	})
+	if err != nil {
+		return err
+	}
+
+	_, err = r.conn.Collection(bucketCollection).Indexes().CreateOne(ctx, mongo.IndexModel{
+		Keys:    bson.D{{Key: "updated_at", Value: 1}},
+		Options: options.Index().SetExpireAfterSeconds(int32(bucketTTL.Seconds())),
+	})
+	if err != nil {
+		return err
+	}
+
+	_, err = r.conn.Collection(idempotencyCollection).Indexes().CreateOne(ctx, mongo.IndexModel{
+		Keys:    bson.D{{Key: "expires_at", Value: 1}},
+		Options: options.Index().SetExpireAfterSeconds(0),
+	})
+
 	return err
 }
 
+// dropIndex drops an index that is no longer wanted, if it exists.
+func dropIndex(ctx context.Context, coll *mongo.Collection, name string) error {
+	_, err := coll.Indexes().DropOne(ctx, name)
+
+	// namespace or index not found
+	var ce mongo.CommandError
+	if errors.As(err, &ce) && (ce.Code == 26 || ce.Code == 27) {
+		return nil
+	}
+
+	return err
+}
+
+func (r *Repository) CreateConversation(ctx context.Context, c *Conversation) error {
+	for i, m := range c.Messages {
+		m.ConversationID = c.ID
+		m.Position = i
+	}
+
+	// messages of a conversation that does not exist are never read
+	return r.atomically(ctx, func(ctx context.Context) error {
+		if err := r.insertMessages(ctx, c.Messages); err != nil {
+			return err
+		}
+
+		_, err := r.conn.Collection(conversationCollection).InsertOne(ctx, c)
+		return err
+	}, func(ctx context.Context) error {
+		return r.deleteMessages(ctx, c.Messages)
+	})
+}
+
 func (r *Repository) DescribeConversation(ctx context.Context, id string) (*Conversation, error) {
 	var c Conversation
 
@@ -38,7 +174,7 @@ func (r *Repository) DescribeConversation(ctx context.Context, id string) (*Conv
 		return nil, twirp.NotFoundError("invalid conversation ID")
 	}
 
-	err = r.conn.Collection(conversationCollection).FindOne(ctx, map[string]any{"_id": oid}).Decode(&c)
+	err = r.conn.Collection(conversationCollection).FindOne(ctx, owned(ctx, bson.M{"_id": oid})).Decode(&c)
 	if errors.Is(err, mongo.ErrNoDocuments) {
 		return nil, twirp.NotFoundError("conversation not found")
 	}
@@ -47,18 +183,114 @@ func (r *Repository) DescribeConversation(ctx context.Context, id string) (*Conv
 		return nil, err
 	}
 
+	cursor, err := r.conn.Collection(messageCollection).Find(ctx,
+		bson.M{"conversation_id": oid},
+		options.Find().SetSort(bson.D{{Key: "position", Value: 1}}))
+
+	if err != nil {
+		return nil, err
+	}
+
+	if err := cursor.All(ctx, &c.Messages); err != nil {
+		return nil, err
+	}
+
 	return &c, nil
 }
 
-func (r *Repository) ListConversations(ctx context.Context) ([]*Conversation, error) {
+// ListOptions narrows down the conversations returned by ListConversations.
+type ListOptions struct {
+	// IncludeArchived also returns archived conversations, which are left out by default.
+	IncludeArchived bool
+
+	// Limit is the maximum number of conversations to return, zero means no limit.
+	Limit int
+	// After continues the listing right after the given cursor.
+	After *Cursor
+
+	// Optional bounds on creation and last update time, After bounds are inclusive and Before bounds are exclusive.
+	CreatedAfter  time.Time
+	CreatedBefore time.Time
+	UpdatedAfter  time.Time
+	UpdatedBefore time.Time
+
+	// TitlePrefix only returns conversations whose title starts with the prefix.
+	TitlePrefix string
+
+	// ParentID only returns conversations forked from the given conversation.
+	ParentID primitive.ObjectID
+}
+
+// filter returns the filter on conversations matching the options within the given scope, see owned.
+func (lo ListOptions) filter(scope bson.M) bson.D {
+	filter := bson.D{}
+
+	for k, v := range scope {
+		filter = append(filter, bson.E{Key: k, Value: v})
+	}
+
+	if !lo.IncludeArchived {
+		filter = append(filter, bson.E{Key: "archived", Value: bson.M{"$ne": true}})
+	}
+
+	if r := timeRange(lo.CreatedAfter, lo.CreatedBefore); r != nil {
+		filter = append(filter, bson.E{Key: "created_at", Value: r})
+	}
+
+	if r := timeRange(lo.UpdatedAfter, lo.UpdatedBefore); r != nil {
+		filter = append(filter, bson.E{Key: "updated_at", Value: r})
+	}
+
+	if lo.TitlePrefix != "" {
+		filter = append(filter, bson.E{Key: "subject", Value: bson.M{"$regex": "^" + regexp.QuoteMeta(lo.TitlePrefix)}})
+	}
+
+	if !lo.ParentID.IsZero() {
+		filter = append(filter, bson.E{Key: "parent_id", Value: lo.ParentID})
+	}
+
+	if lo.After != nil {
+		filter = append(filter, bson.E{Key: "$or", Value: bson.A{
+			bson.M{"updated_at": bson.M{"$lt": lo.After.UpdatedAt}},
+			bson.M{"updated_at": lo.After.UpdatedAt, "_id": bson.M{"$lt": lo.After.ID}},
+		}})
+	}
+
+	return filter
+}
+
+func timeRange(from, to time.Time) bson.M {
+	if from.IsZero() && to.IsZero() {
+		return nil
+	}
+
+	r := bson.M{}
+	if !from.IsZero() {
+		r["$gte"] = from
+	}
+	if !to.IsZero() {
+		r["$lt"] = to
+	}
+
+	return r
+}
+
+// ListConversations returns conversations sorted by most recently updated first, messages are not loaded. When there
+// are more conversations than lo.Limit, the returned cursor points to the next page, otherwise it is nil.
+func (r *Repository) ListConversations(ctx context.Context, lo ListOptions) ([]*Conversation, *Cursor, error) {
 	opts := options.Find().
-		SetSort(bson.D{{Key: "created_at", Value: -1}})
+		SetSort(bson.D{{Key: "updated_at", Value: -1}, {Key: "_id", Value: -1}})
+
+	if lo.Limit > 0 {
+		// fetch one extra item to know if there is a next page
+		opts.SetLimit(int64(lo.Limit) + 1)
+	}
 
 	cursor, err := r.conn.Collection(conversationCollection).
-		Find(ctx, map[string]any{}, opts)
+		Find(ctx, lo.filter(owned(ctx, bson.M{})), opts)
 
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	defer func() {
@@ -71,36 +303,354 @@ func (r *Repository) ListConversations(ctx context.Context) ([]*Conversation, er
 		var c Conversation
 
 		if err := cursor.Decode(&c); err != nil {
-			return nil, err
+			return nil, nil, err
 		}
 
 		items = append(items, &c)
 	}
 
 	if err := cursor.Err(); err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
-	return items, nil
+	if lo.Limit > 0 && len(items) > lo.Limit {
+		items = items[:lo.Limit]
+		return items, CursorOf(items[len(items)-1]), nil
+	}
+
+	return items, nil, nil
 }
 
+// UpdateConversation saves the conversation's own fields, its messages are left untouched. It fails with ErrConflict
+// if the conversation was modified since it was read.
 func (r *Repository) UpdateConversation(ctx context.Context, c *Conversation) error {
-	_, err := r.conn.Collection(conversationCollection).UpdateOne(ctx,
-		map[string]any{"_id": c.ID},
-		map[string]any{"$set": c})
+	update, err := changes(c)
+	if err != nil {
+		return err
+	}
 
-	if errors.Is(err, mongo.ErrNoDocuments) {
-		return twirp.NotFoundError("conversation not found")
+	if err := r.bumpVersion(ctx, c, update); err != nil {
+		return err
+	}
+
+	c.Version++
+	return nil
+}
+
+// AppendMessages persists messages that were added to the conversation with Conversation.AddMessage, and saves the
+// conversation's own fields like UpdateConversation does. It fails with ErrConflict if the conversation was modified
+// since it was read, so concurrent replies can not overwrite each other.
+func (r *Repository) AppendMessages(ctx context.Context, c *Conversation, msgs ...*Message) error {
+	update, err := changes(c)
+	if err != nil {
+		return err
+	}
+
+	// the version is bumped last, so concurrent writers conflict on the positions of the messages first
+	err = r.atomically(ctx, func(ctx context.Context) error {
+		if err := r.insertMessages(ctx, msgs); err != nil {
+			return err
+		}
+		return r.bumpVersion(ctx, c, update)
+	}, func(ctx context.Context) error {
+		return r.deleteMessages(ctx, msgs)
+	})
+	if err != nil {
+		return err
+	}
+
+	c.Version++
+	return nil
+}
+
+// ReplaceMessages drops the messages from position from onwards and persists msgs in their place, they should have
+// been added to the conversation with Conversation.AddMessage after truncating it. Like AppendMessages, it saves the
+// conversation's own fields and fails with ErrConflict if the conversation was modified since it was read.
+func (r *Repository) ReplaceMessages(ctx context.Context, c *Conversation, from int, msgs ...*Message) error {
+	update, err := changes(c)
+	if err != nil {
+		return err
+	}
+
+	// the replaced messages are read first, so they can be put back
+	var replaced []*Message
+	deleted := false
+	err = r.atomically(ctx, func(ctx context.Context) error {
+		var err error
+		if replaced, err = r.messagesFrom(ctx, c.ID, from); err != nil {
+			return err
+		}
+
+		if err := r.deleteMessagesFrom(ctx, c.ID, from); err != nil {
+			return err
+		}
+		deleted = true
+
+		if err := r.insertMessages(ctx, msgs); err != nil {
+			return err
+		}
+		return r.bumpVersion(ctx, c, update)
+	}, func(ctx context.Context) error {
+		if !deleted {
+			return nil
+		}
+		if err := r.deleteMessagesFrom(ctx, c.ID, from); err != nil {
+			return err
+		}
+		return r.insertMessages(ctx, replaced)
+	})
+	if err != nil {
+		return err
+	}
+
+	c.Version++
+	return nil
+}
+
+// changes returns the update saving the conversation's own fields, except for its ID and version.
+func changes(c *Conversation) (bson.M, error) {
+	raw, err := bson.Marshal(c)
+	if err != nil {
+		return nil, err
+	}
+
+	var fields bson.M
+	if err := bson.Unmarshal(raw, &fields); err != nil {
+		return nil, err
+	}
+
+	delete(fields, "_id")
+	delete(fields, "version")
+
+	update := bson.M{"$set": fields}
+	if c.Summary == nil {
+		update["$unset"] = bson.M{"summary": ""}
+	}
+
+	return update, nil
+}
+
+// bumpVersion applies the update and increments the stored version, provided it still matches c. The version of c is
+// left to the caller to increment once the write is done.
+func (r *Repository) bumpVersion(ctx context.Context, c *Conversation, update bson.M) error {
+	update["$inc"] = bson.M{"version": 1}
+
+	res, err := r.conn.Collection(conversationCollection).UpdateOne(ctx,
+		owned(ctx, bson.M{"_id": c.ID, "version": c.Version}), update)
+
+	if err != nil {
+		return err
+	}
+
+	if res.MatchedCount == 0 {
+		n, err := r.conn.Collection(conversationCollection).CountDocuments(ctx, owned(ctx, bson.M{"_id": c.ID}))
+		if err != nil {
+			return err
+		}
+
+		if n == 0 {
+			return twirp.NotFoundError("conversation not found")
+		}
+
+		return ErrConflict
+	}
+
+	return nil
+}
+
+func (r *Repository) insertMessages(ctx context.Context, msgs []*Message) error {
+	if len(msgs) == 0 {
+		return nil
+	}
+
+	docs := make([]any, len(msgs))
+	for i, m := range msgs {
+		docs[i] = m
+	}
+
+	_, err := r.conn.Collection(messageCollection).InsertMany(ctx, docs)
+	if mongo.IsDuplicateKeyError(err) {
+		return ErrConflict
 	}
 
 	return err
 }
 
+// messagesFrom reads the messages of the conversation from position from onwards.
+func (r *Repository) messagesFrom(ctx context.Context, id primitive.ObjectID, from int) ([]*Message, error) {
+	cursor, err := r.conn.Collection(messageCollection).Find(ctx,
+		bson.M{"conversation_id": id, "position": bson.M{"$gte": from}},
+		options.Find().SetSort(bson.D{{Key: "position", Value: 1}}))
+
+	if err != nil {
+		return nil, err
+	}
+
+	var msgs []*Message
+	if err := cursor.All(ctx, &msgs); err != nil {
+		return nil, err
+	}
+
+	return msgs, nil
+}
+
+// deleteMessages deletes the given messages, whether they were stored or not.
+func (r *Repository) deleteMessages(ctx context.Context, msgs []*Message) error {
+	if len(msgs) == 0 {
+		return nil
+	}
+
+	ids := make([]primitive.ObjectID, len(msgs))
+	for i, m := range msgs {
+		ids[i] = m.ID
+	}
+
+	_, err := r.conn.Collection(messageCollection).DeleteMany(ctx, bson.M{"_id": bson.M{"$in": ids}})
+	return err
+}
+
+// deleteMessagesFrom deletes the messages of the conversation from position from onwards.
+func (r *Repository) deleteMessagesFrom(ctx context.Context, id primitive.ObjectID, from int) error {
+	_, err := r.conn.Collection(messageCollection).DeleteMany(ctx, bson.M{
+		"conversation_id": id,
+		"position":        bson.M{"$gte": from},
+	})
+	return err
+}
+
 func (r *Repository) DeleteConversation(ctx context.Context, id string) error {
-	_, err := r.conn.Collection(conversationCollection).DeleteOne(ctx, map[string]any{"_id": id})
-	if errors.Is(err, mongo.ErrNoDocuments) {
-		return twirp.NotFoundError("conversation not found")
+	oid, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return twirp.NotFoundError("invalid conversation ID")
+	}
+
+	// messages left behind by a failure are never read, like the ones of a conversation that failed to be created
+	return r.atomically(ctx, func(ctx context.Context) error {
+		res, err := r.conn.Collection(conversationCollection).DeleteOne(ctx, owned(ctx, bson.M{"_id": oid}))
+		if err != nil {
+			return err
+		}
+
+		if res.DeletedCount == 0 {
+			return twirp.NotFoundError("conversation not found")
+		}
+
+		_, err = r.conn.Collection(messageCollection).DeleteMany(ctx, bson.M{"conversation_id": oid})
+		return err
+	}, nil)
+}
+
+// atomically runs write in a transaction when the deployment supports them. Otherwise write runs on its own, and undo,
+// if not nil, is run when it fails to revert what it wrote. Both are ordered so that the state left behind by failing
+// half way is one the next write recovers from, e.g. messages without their conversation, which are never read. As the
+// transaction may be retried, write should not modify what it is given.
+func (r *Repository) atomically(ctx context.Context, write, undo func(ctx context.Context) error) error {
+	transactions, err := r.supportsTransactions(ctx)
+	if err != nil {
+		return err
+	}
+
+	if !transactions {
+		err := write(ctx)
+		if err != nil && undo != nil {
+			if uerr := undo(ctx); uerr != nil {
+				return errors.Join(err, fmt.Errorf("failed to undo write: %w", uerr))
+			}
+		}
+		return err
+	}
+
+	session, err := r.conn.Client().StartSession()
+	if err != nil {
+		return err
+	}
+	defer session.EndSession(ctx)
+
+	_, err = session.WithTransaction(ctx, func(ctx mongo.SessionContext) (any, error) {
+		return nil, write(ctx)
+	})
+	return err
+}
+
+// supportsTransactions tells whether the deployment supports transactions, only replica sets and sharded clusters do.
+// The answer is asked for once.
+func (r *Repository) supportsTransactions(ctx context.Context) (bool, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.transactions != nil {
+		return *r.transactions, nil
 	}
 
+	var hello struct {
+		SetName string `bson:"setName"`
+		Msg     string `bson:"msg"`
+	}
+	if err := r.conn.RunCommand(ctx, bson.D{{Key: "hello", Value: 1}}).Decode(&hello); err != nil {
+		return false, fmt.Errorf("failed to tell whether MongoDB supports transactions: %w", err)
+	}
+
+	transactions := hello.SetName != "" || hello.Msg == "isdbgrid"
+	r.transactions = &transactions
+	return transactions, nil
+}
+
+// RecordUsage appends usage to the ledger.
+func (r *Repository) RecordUsage(ctx context.Context, u *UsageRecord) error {
+	_, err := r.conn.Collection(usageCollection).InsertOne(ctx, u)
 	return err
 }
+
+// UsageReport sums the usage recorded in the ledger by day and model, sorted by both. Editing or deleting
+// conversations leaves the ledger alone, so their usage stays in the report. With a context scoped to an owner or a
+// tenant, only their usage is reported.
+func (r *Repository) UsageReport(ctx context.Context, opts UsageReportOptions) ([]UsageReportRow, error) {
+	filter := scoped(ctx, "", bson.M{})
+	if tr := timeRange(opts.Start, opts.End); tr != nil {
+		filter["created_at"] = tr
+	}
+
+	pipeline := mongo.Pipeline{
+		{{Key: "$match", Value: filter}},
+		{{Key: "$group", Value: bson.M{
+			"_id": bson.M{
+				"day":   bson.M{"$dateToString": bson.M{"format": "%Y-%m-%d", "date": "$created_at"}},
+				"model": "$usage.model",
+			},
+			"prompt_tokens":     bson.M{"$sum": "$usage.prompt_tokens"},
+			"completion_tokens": bson.M{"$sum": "$usage.completion_tokens"},
+			"cost":              bson.M{"$sum": "$usage.cost"},
+		}}},
+		{{Key: "$sort", Value: bson.D{{Key: "_id.day", Value: 1}, {Key: "_id.model", Value: 1}}}},
+	}
+
+	cursor, err := r.conn.Collection(usageCollection).Aggregate(ctx, pipeline)
+	if err != nil {
+		return nil, err
+	}
+
+	defer func() {
+		_ = cursor.Close(ctx)
+	}()
+
+	rows := []UsageReportRow{}
+
+	for cursor.Next(ctx) {
+		var doc struct {
+			ID struct {
+				Day   string `bson:"day"`
+				Model string `bson:"model"`
+			} `bson:"_id"`
+			Usage `bson:",inline"`
+		}
+
+		if err := cursor.Decode(&doc); err != nil {
+			return nil, err
+		}
+
+		doc.Usage.Model = doc.ID.Model
+		rows = append(rows, UsageReportRow{Day: doc.ID.Day, Usage: doc.Usage})
+	}
+
+	return rows, cursor.Err()
+}
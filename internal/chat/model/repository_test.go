@@ -0,0 +1,65 @@
+package model_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/acai-travel/tech-challenge/internal/chat/model"
+	. "github.com/acai-travel/tech-challenge/internal/chat/testing"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+func TestRepository(t *testing.T) {
+	ctx := context.Background()
+
+	// a conversation stored with its messages embedded, like earlier versions did
+	legacy := primitive.NewObjectID()
+	at := time.Date(2023, 10, 1, 0, 0, 0, 0, time.UTC)
+	_, err := ConnectMongo().Collection("conversations").InsertOne(ctx, bson.M{
+		"_id": legacy, "subject": "Legacy", "created_at": at, "updated_at": at,
+		"messages": bson.A{
+			bson.M{"_id": primitive.NewObjectID(), "role": "user", "content": "Hi", "created_at": at, "updated_at": at},
+			bson.M{"_id": primitive.NewObjectID(), "role": "assistant", "content": "Hello!", "created_at": at, "updated_at": at},
+		},
+	})
+	if err != nil {
+		t.Fatalf("failed to store legacy conversation: %v", err)
+	}
+
+	repo := model.New(ConnectMongo())
+	if err := repo.EnsureIndexes(ctx); err != nil {
+		t.Fatalf("failed to create indexes: %v", err)
+	}
+
+	t.Run("embedded messages are migrated", func(t *testing.T) {
+		t.Cleanup(func() { _ = repo.DeleteConversation(ctx, legacy.Hex()) })
+
+		// migrating again is harmless
+		if err := repo.EnsureIndexes(ctx); err != nil {
+			t.Fatalf("failed to create indexes: %v", err)
+		}
+
+		c, err := repo.DescribeConversation(ctx, legacy.Hex())
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if len(c.Messages) != 2 || c.Messages[0].Content != "Hi" || c.Messages[1].Position != 1 {
+			t.Fatalf("expected the embedded messages to be read in order, got %v", c.Messages)
+		}
+
+		c.AddMessage(&model.Message{ID: primitive.NewObjectID(), Role: model.RoleUser, Content: "Bye", CreatedAt: at, UpdatedAt: at})
+		if err := repo.AppendMessages(ctx, c, c.Messages[2]); err != nil {
+			t.Errorf("expected migrated conversations to be written to, got %v", err)
+		}
+	})
+
+	RunConversationStoreContract(t, repo)
+	RunPersonaStoreContract(t, repo)
+	RunTenantStoreContract(t, repo)
+	RunUsageCounterStoreContract(t, repo)
+	RunIdempotencyStoreContract(t, repo)
+	RunRateLimitStoreContract(t, repo)
+}
@@ -7,6 +7,9 @@ type Role string
 const (
 	RoleUser      Role = "user"
 	RoleAssistant Role = "assistant"
+	// RoleToolCall messages record a tool called by the assistant, RoleToolResult messages what the tool returned.
+	RoleToolCall   Role = "tool_call"
+	RoleToolResult Role = "tool_result"
 )
 
 func (r Role) Proto() pb.Conversation_Role {
@@ -15,6 +18,10 @@ func (r Role) Proto() pb.Conversation_Role {
 		return pb.Conversation_USER
 	case RoleAssistant:
 		return pb.Conversation_ASSISTANT
+	case RoleToolCall:
+		return pb.Conversation_TOOL_CALL
+	case RoleToolResult:
+		return pb.Conversation_TOOL_RESULT
 	default:
 		return 0
 	}
@@ -0,0 +1,80 @@
+package model
+
+import (
+	"context"
+
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+type ownerKey struct{}
+
+type tenantKey struct{}
+
+// WithOwner scopes the conversation queries made with the returned context to the conversations of the owner, the
+// others are reported as not found. Queries made with an unscoped context, or an empty owner, see every conversation.
+func WithOwner(ctx context.Context, owner string) context.Context {
+	return context.WithValue(ctx, ownerKey{}, owner)
+}
+
+// OwnerFrom returns the owner the context is scoped to, or an empty string if it is not scoped.
+func OwnerFrom(ctx context.Context) string {
+	owner, _ := ctx.Value(ownerKey{}).(string)
+	return owner
+}
+
+// WithTenant scopes the conversation queries made with the returned context to the conversations of the tenant, like
+// WithOwner. Unlike owners, an empty tenant is a scope of its own: the conversations outside any tenant.
+func WithTenant(ctx context.Context, tenant string) context.Context {
+	return context.WithValue(ctx, tenantKey{}, tenant)
+}
+
+// TenantFrom returns the tenant the context is scoped to, ok is false if it is not scoped.
+func TenantFrom(ctx context.Context) (tenant string, ok bool) {
+	tenant, ok = ctx.Value(tenantKey{}).(string)
+	return tenant, ok
+}
+
+// scoped adds the owner and tenant the context is scoped to, if any, to the filter on conversations. Fields are
+// prefixed with prefix, e.g. to filter on a looked up conversation.
+func scoped(ctx context.Context, prefix string, filter bson.M) bson.M {
+	if owner := OwnerFrom(ctx); owner != "" {
+		filter[prefix+"owner_id"] = owner
+	}
+
+	return tenanted(ctx, prefix, filter)
+}
+
+// tenanted adds the tenant the context is scoped to, if any, to the filter. Unlike conversations, documents shared by
+// the users of a tenant, e.g. personas, are only scoped to the tenant.
+func tenanted(ctx context.Context, prefix string, filter bson.M) bson.M {
+	if tenant, ok := TenantFrom(ctx); ok {
+		if tenant == "" {
+			// documents outside any tenant are stored without one
+			filter[prefix+"tenant_id"] = bson.M{"$in": bson.A{nil, ""}}
+		} else {
+			filter[prefix+"tenant_id"] = tenant
+		}
+	}
+
+	return filter
+}
+
+// owned is scoped for filters on conversations themselves.
+func owned(ctx context.Context, filter bson.M) bson.M {
+	return scoped(ctx, "", filter)
+}
+
+// visible reports whether the conversation can be seen with the context, see WithOwner and WithTenant.
+func visible(ctx context.Context, c *Conversation) bool {
+	if owner := OwnerFrom(ctx); owner != "" && c.OwnerID != owner {
+		return false
+	}
+
+	return inTenant(ctx, c.TenantID)
+}
+
+// inTenant reports whether a document of the tenant can be seen with the context, see WithTenant.
+func inTenant(ctx context.Context, tenant string) bool {
+	scope, ok := TenantFrom(ctx)
+	return !ok || scope == tenant
+}
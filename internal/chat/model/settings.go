@@ -0,0 +1,31 @@
+package model
+
+import (
+	"github.com/acai-travel/tech-challenge/internal/pb"
+)
+
+// Settings tune how the assistant replies to a conversation, zero values fall back to the assistant's defaults.
+type Settings struct {
+	SystemPrompt string   `bson:"system_prompt,omitempty"`
+	Model        string   `bson:"model,omitempty"`
+	Temperature  *float64 `bson:"temperature,omitempty"`
+	MaxTokens    int      `bson:"max_tokens,omitempty"`
+}
+
+func (s Settings) Proto() *pb.ConversationSettings {
+	return &pb.ConversationSettings{
+		SystemPrompt: s.SystemPrompt,
+		Model:        s.Model,
+		Temperature:  s.Temperature,
+		MaxTokens:    int32(s.MaxTokens),
+	}
+}
+
+// clone copies the settings, so they do not share the temperature with s.
+func (s Settings) clone() Settings {
+	if s.Temperature != nil {
+		t := *s.Temperature
+		s.Temperature = &t
+	}
+	return s
+}
@@ -0,0 +1,86 @@
+package model
+
+import "context"
+
+var (
+	_ ConversationStore = (*Repository)(nil)
+	_ ConversationStore = (*MemoryStore)(nil)
+
+	_ PersonaStore = (*Repository)(nil)
+	_ PersonaStore = (*MemoryStore)(nil)
+
+	_ TenantStore = (*Repository)(nil)
+	_ TenantStore = (*MemoryStore)(nil)
+
+	_ UsageCounterStore = (*Repository)(nil)
+	_ UsageCounterStore = (*MemoryStore)(nil)
+
+	_ IdempotencyStore = (*Repository)(nil)
+	_ IdempotencyStore = (*MemoryStore)(nil)
+)
+
+// ConversationStore persists conversations and their messages. Repository stores them in MongoDB, MemoryStore keeps
+// them in memory, both behave the same way:
+//   - Unknown or malformed IDs fail with a twirp.NotFound error.
+//   - Writes based on an outdated Conversation.Version fail with ErrConflict.
+//   - Writing messages also saves the conversation's own fields, e.g. its UpdatedAt and Summary.
+//   - Writes are all or nothing: a conversation is never left with fields that do not match its messages. Repository
+//     relies on transactions for it, and only undoes failed writes as far as possible on a standalone MongoDB server.
+type ConversationStore interface {
+	CreateConversation(ctx context.Context, c *Conversation) error
+	DescribeConversation(ctx context.Context, id string) (*Conversation, error)
+	ListConversations(ctx context.Context, lo ListOptions) ([]*Conversation, *Cursor, error)
+	UpdateConversation(ctx context.Context, c *Conversation) error
+	AppendMessages(ctx context.Context, c *Conversation, msgs ...*Message) error
+	ReplaceMessages(ctx context.Context, c *Conversation, from int, msgs ...*Message) error
+	DeleteConversation(ctx context.Context, id string) error
+
+	// RecordUsage appends usage to the ledger when it is incurred. The ledger is never written to otherwise.
+	RecordUsage(ctx context.Context, r *UsageRecord) error
+	// UsageReport sums the usage recorded in the ledger by day and model. With a context scoped to an owner or a
+	// tenant, only their usage is reported.
+	UsageReport(ctx context.Context, opts UsageReportOptions) ([]UsageReportRow, error)
+}
+
+// PersonaStore persists personas, following the same rules as ConversationStore. Queries scoped to a tenant only see
+// its personas, see WithTenant, owners do not scope them. Persona names are unique within their tenant, saving a persona
+// with the name of another one fails with ErrPersonaExists.
+type PersonaStore interface {
+	CreatePersona(ctx context.Context, p *Persona) error
+	DescribePersona(ctx context.Context, id string) (*Persona, error)
+	ListPersonas(ctx context.Context) ([]*Persona, error)
+	UpdatePersona(ctx context.Context, p *Persona) error
+	DeletePersona(ctx context.Context, id string) error
+}
+
+// TenantStore persists tenants, following the same rules as ConversationStore. Creating a tenant with the ID of
+// another one fails with ErrTenantExists.
+type TenantStore interface {
+	CreateTenant(ctx context.Context, t *Tenant) error
+	DescribeTenant(ctx context.Context, id string) (*Tenant, error)
+	ListTenants(ctx context.Context) ([]*Tenant, error)
+	UpdateTenant(ctx context.Context, t *Tenant) error
+	DeleteTenant(ctx context.Context, id string) error
+}
+
+// UsageCounterStore keeps running totals of usage under arbitrary keys, e.g. a user and a day. The model of counted
+// usage is always empty. Repository expires counters that were not incremented for a while, see EnsureIndexes.
+type UsageCounterStore interface {
+	// IncrementUsage adds the usage to the counter of every key, starting from zero for new keys.
+	IncrementUsage(ctx context.Context, u Usage, keys ...string) error
+	// CountedUsage returns the counters of the given keys, keys that were never incremented are left out.
+	CountedUsage(ctx context.Context, keys ...string) (map[string]Usage, error)
+}
+
+// IdempotencyStore keeps track of requests made with an idempotency key and their responses. Requests are forgotten
+// once they expire, see IdempotentRequest.ExpiresAt.
+type IdempotencyStore interface {
+	// ClaimRequest claims the key of the request, unless another attempt holds it: one that is done, or whose claim did
+	// not run out yet. It returns nil when the claim succeeded, the attempt holding the key otherwise.
+	ClaimRequest(ctx context.Context, r *IdempotentRequest) (*IdempotentRequest, error)
+	// CompleteRequest stores the response of the request, which is then done, as long as the claim still holds it.
+	CompleteRequest(ctx context.Context, key, claim string, response []byte) error
+	// ReleaseRequest forgets a request that is not done, as long as the claim still holds it, so it can be attempted
+	// again.
+	ReleaseRequest(ctx context.Context, key, claim string) error
+}
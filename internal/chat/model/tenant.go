@@ -0,0 +1,65 @@
+package model
+
+import (
+	"time"
+
+	"github.com/acai-travel/tech-challenge/internal/pb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Tenant is a team sharing the deployment. Its conversations are only visible within the tenant, see WithTenant, and
+// are replied to following its configuration: personas and conversation settings take precedence over the tenant,
+// which takes precedence over the assistant's defaults.
+type Tenant struct {
+	// ID is chosen by admins, callers name their tenant with it
+	ID           string    `bson:"_id"`
+	Name         string    `bson:"name"`
+	Model        string    `bson:"model,omitempty"`
+	SystemPrompt string    `bson:"system_prompt,omitempty"`
+	CreatedAt    time.Time `bson:"created_at"`
+	UpdatedAt    time.Time `bson:"updated_at"`
+
+	// Tools is the allow-list of tools the tenant's conversations may call, by name, all tools are allowed when empty.
+	Tools []string `bson:"tools,omitempty"`
+
+	Budgets TenantBudgets `bson:"budgets"`
+}
+
+// TenantBudgets are the spending limits of a tenant, zero limits fall back to the server's.
+type TenantBudgets struct {
+	// UserDaily and UserMonthly apply to each user of the tenant
+	UserDaily   Limit `bson:"user_daily"`
+	UserMonthly Limit `bson:"user_monthly"`
+
+	// Daily and Monthly apply to all users of the tenant together
+	Daily   Limit `bson:"daily"`
+	Monthly Limit `bson:"monthly"`
+}
+
+// Limit caps the tokens, prompt and completion together, and the cost in US dollars spent over a period. Zero values
+// are unlimited.
+type Limit struct {
+	Tokens int     `bson:"tokens,omitempty"`
+	Cost   float64 `bson:"cost,omitempty"`
+}
+
+func (t *Tenant) Proto() *pb.Tenant {
+	return &pb.Tenant{
+		Id:           t.ID,
+		Name:         t.Name,
+		Tools:        t.Tools,
+		Model:        t.Model,
+		SystemPrompt: t.SystemPrompt,
+		Budgets: &pb.TenantBudgets{
+			UserDaily:   t.Budgets.UserDaily.Proto(),
+			UserMonthly: t.Budgets.UserMonthly.Proto(),
+			Daily:       t.Budgets.Daily.Proto(),
+			Monthly:     t.Budgets.Monthly.Proto(),
+		},
+		Timestamp: timestamppb.New(t.UpdatedAt),
+	}
+}
+
+func (l Limit) Proto() *pb.Budget {
+	return &pb.Budget{Tokens: int64(l.Tokens), Cost: l.Cost}
+}
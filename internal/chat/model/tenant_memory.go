@@ -0,0 +1,81 @@
+package model
+
+import (
+	"context"
+	"slices"
+	"strings"
+
+	"github.com/twitchtv/twirp"
+)
+
+func (s *MemoryStore) CreateTenant(ctx context.Context, t *Tenant) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.tenants[t.ID]; ok {
+		return ErrTenantExists
+	}
+
+	s.tenants[t.ID] = t.clone()
+	return nil
+}
+
+func (s *MemoryStore) DescribeTenant(ctx context.Context, id string) (*Tenant, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	t, ok := s.tenants[id]
+	if !ok {
+		return nil, twirp.NotFoundError("tenant not found")
+	}
+
+	return t.clone(), nil
+}
+
+func (s *MemoryStore) ListTenants(ctx context.Context) ([]*Tenant, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	var items []*Tenant
+	for _, t := range s.tenants {
+		items = append(items, t.clone())
+	}
+
+	slices.SortFunc(items, func(a, b *Tenant) int {
+		return strings.Compare(a.ID, b.ID)
+	})
+
+	return items, nil
+}
+
+func (s *MemoryStore) UpdateTenant(ctx context.Context, t *Tenant) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.tenants[t.ID]; !ok {
+		return twirp.NotFoundError("tenant not found")
+	}
+
+	s.tenants[t.ID] = t.clone()
+	return nil
+}
+
+func (s *MemoryStore) DeleteTenant(ctx context.Context, id string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.tenants[id]; !ok {
+		return twirp.NotFoundError("tenant not found")
+	}
+
+	delete(s.tenants, id)
+	return nil
+}
+
+func (t *Tenant) clone() *Tenant {
+	cp := *t
+	cp.CreatedAt = normalizeTime(t.CreatedAt)
+	cp.UpdatedAt = normalizeTime(t.UpdatedAt)
+	cp.Tools = slices.Clone(t.Tools)
+	return &cp
+}
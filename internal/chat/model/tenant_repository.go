@@ -0,0 +1,82 @@
+package model
+
+import (
+	"context"
+	"errors"
+
+	"github.com/twitchtv/twirp"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+const tenantCollection = "tenants"
+
+// ErrTenantExists is returned when a tenant is created with the ID of another one.
+var ErrTenantExists = twirp.NewError(twirp.AlreadyExists, "a tenant with this ID already exists")
+
+func (r *Repository) CreateTenant(ctx context.Context, t *Tenant) error {
+	_, err := r.conn.Collection(tenantCollection).InsertOne(ctx, t)
+	if mongo.IsDuplicateKeyError(err) {
+		return ErrTenantExists
+	}
+
+	return err
+}
+
+func (r *Repository) DescribeTenant(ctx context.Context, id string) (*Tenant, error) {
+	var t Tenant
+	err := r.conn.Collection(tenantCollection).FindOne(ctx, bson.M{"_id": id}).Decode(&t)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return nil, twirp.NotFoundError("tenant not found")
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &t, nil
+}
+
+// ListTenants returns all tenants sorted by ID.
+func (r *Repository) ListTenants(ctx context.Context) ([]*Tenant, error) {
+	cursor, err := r.conn.Collection(tenantCollection).Find(ctx, bson.M{},
+		options.Find().SetSort(bson.D{{Key: "_id", Value: 1}}))
+
+	if err != nil {
+		return nil, err
+	}
+
+	var items []*Tenant
+	if err := cursor.All(ctx, &items); err != nil {
+		return nil, err
+	}
+
+	return items, nil
+}
+
+func (r *Repository) UpdateTenant(ctx context.Context, t *Tenant) error {
+	res, err := r.conn.Collection(tenantCollection).ReplaceOne(ctx, bson.M{"_id": t.ID}, t)
+	if err != nil {
+		return err
+	}
+
+	if res.MatchedCount == 0 {
+		return twirp.NotFoundError("tenant not found")
+	}
+
+	return nil
+}
+
+func (r *Repository) DeleteTenant(ctx context.Context, id string) error {
+	res, err := r.conn.Collection(tenantCollection).DeleteOne(ctx, bson.M{"_id": id})
+	if err != nil {
+		return err
+	}
+
+	if res.DeletedCount == 0 {
+		return twirp.NotFoundError("tenant not found")
+	}
+
+	return nil
+}
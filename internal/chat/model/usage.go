@@ -0,0 +1,73 @@
+package model
+
+import (
+	"time"
+
+	"github.com/acai-travel/tech-challenge/internal/pb"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// Usage is the number of tokens used by a model and what they cost in US dollars. Model is empty when the usage adds
+// up several models, like the total of a conversation.
+type Usage struct {
+	Model            string  `bson:"model,omitempty"`
+	PromptTokens     int     `bson:"prompt_tokens"`
+	CompletionTokens int     `bson:"completion_tokens"`
+	Cost             float64 `bson:"cost"`
+}
+
+// Add adds the tokens and cost of o to u, leaving its model unchanged.
+func (u *Usage) Add(o Usage) {
+	u.PromptTokens += o.PromptTokens
+	u.CompletionTokens += o.CompletionTokens
+	u.Cost += o.Cost
+}
+
+func (u Usage) Proto() *pb.Usage {
+	return &pb.Usage{
+		Model:            u.Model,
+		PromptTokens:     int64(u.PromptTokens),
+		CompletionTokens: int64(u.CompletionTokens),
+		Cost:             u.Cost,
+	}
+}
+
+// UsageRecord is usage incurred on behalf of a conversation, e.g. by a reply or its title. Records are kept in a
+// ledger of their own, so editing or deleting the conversation does not change what was spent, see
+// ConversationStore.RecordUsage.
+type UsageRecord struct {
+	ID             primitive.ObjectID `bson:"_id"`
+	ConversationID primitive.ObjectID `bson:"conversation_id"`
+	OwnerID        string             `bson:"owner_id,omitempty"`
+	TenantID       string             `bson:"tenant_id,omitempty"`
+	Usage          Usage              `bson:"usage"`
+	CreatedAt      time.Time          `bson:"created_at"`
+}
+
+// NewUsageRecord records usage incurred on behalf of the conversation at the given time, it counts towards the
+// conversation's owner and tenant.
+func NewUsageRecord(c *Conversation, u Usage, at time.Time) *UsageRecord {
+	return &UsageRecord{
+		ID:             primitive.NewObjectID(),
+		ConversationID: c.ID,
+		OwnerID:        c.OwnerID,
+		TenantID:       c.TenantID,
+		Usage:          u,
+		CreatedAt:      at,
+	}
+}
+
+// UsageReportOptions limits a usage report to the usage between Start, inclusive, and End, exclusive. Zero times leave
+// the range open.
+type UsageReportOptions struct {
+	Start time.Time
+	End   time.Time
+}
+
+// UsageReportRow is the usage of a model on a day, in UTC and YYYY-MM-DD format.
+type UsageReportRow struct {
+	Day   string
+	Usage Usage
+}
+
+const usageDayFormat = "2006-01-02"
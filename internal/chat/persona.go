@@ -0,0 +1,180 @@
+package chat
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/acai-travel/tech-challenge/internal/chat/model"
+	"github.com/acai-travel/tech-challenge/internal/chat/tools"
+	"github.com/acai-travel/tech-challenge/internal/httpx"
+	"github.com/acai-travel/tech-challenge/internal/pb"
+	"github.com/twitchtv/twirp"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+var _ pb.PersonaService = (*PersonaServer)(nil)
+
+// PersonaServer manages the personas conversations can be started with. Personas belong to the tenant of the request
+// that created them, and are shared by its users. Only admins may change them, see httpx.RoleAdmin, unless
+// authentication is disabled, see httpx.NoAuth.
+type PersonaServer struct {
+	repo model.PersonaStore
+}
+
+func NewPersonaServer(repo model.PersonaStore) *PersonaServer {
+	return &PersonaServer{repo: repo}
+}
+
+func (s *PersonaServer) CreatePersona(ctx context.Context, req *pb.CreatePersonaRequest) (*pb.CreatePersonaResponse, error) {
+	result, err := instrument(ctx, "CreatePersona", func(ctx context.Context) (any, error) {
+		if err := requireAdmin(ctx, "personas"); err != nil {
+			return nil, err
+		}
+
+		persona, err := personaFromProto(req.GetPersona())
+		if err != nil {
+			return nil, err
+		}
+
+		persona.ID = primitive.NewObjectID()
+		persona.TenantID = httpx.TenantFrom(ctx)
+		persona.CreatedAt = persona.UpdatedAt
+
+		if err := s.repo.CreatePersona(ctx, persona); err != nil {
+			return nil, err
+		}
+
+		return &pb.CreatePersonaResponse{Persona: persona.Proto()}, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*pb.CreatePersonaResponse), nil
+}
+
+func (s *PersonaServer) ListPersonas(ctx context.Context, req *pb.ListPersonasRequest) (*pb.ListPersonasResponse, error) {
+	result, err := instrument(ctx, "ListPersonas", func(ctx context.Context) (any, error) {
+		personas, err := s.repo.ListPersonas(ctx)
+		if err != nil {
+			return nil, twirp.InternalErrorWith(err)
+		}
+
+		resp := &pb.ListPersonasResponse{}
+		for _, persona := range personas {
+			resp.Personas = append(resp.Personas, persona.Proto())
+		}
+
+		return resp, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*pb.ListPersonasResponse), nil
+}
+
+func (s *PersonaServer) DescribePersona(ctx context.Context, req *pb.DescribePersonaRequest) (*pb.DescribePersonaResponse, error) {
+	result, err := instrument(ctx, "DescribePersona", func(ctx context.Context) (any, error) {
+		if req.GetPersonaId() == "" {
+			return nil, twirp.RequiredArgumentError("persona_id")
+		}
+
+		persona, err := s.repo.DescribePersona(ctx, req.GetPersonaId())
+		if err != nil {
+			return nil, err
+		}
+
+		return &pb.DescribePersonaResponse{Persona: persona.Proto()}, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*pb.DescribePersonaResponse), nil
+}
+
+func (s *PersonaServer) UpdatePersona(ctx context.Context, req *pb.UpdatePersonaRequest) (*pb.UpdatePersonaResponse, error) {
+	result, err := instrument(ctx, "UpdatePersona", func(ctx context.Context) (any, error) {
+		if err := requireAdmin(ctx, "personas"); err != nil {
+			return nil, err
+		}
+
+		if req.GetPersona().GetId() == "" {
+			return nil, twirp.RequiredArgumentError("persona.id")
+		}
+
+		existing, err := s.repo.DescribePersona(ctx, req.GetPersona().GetId())
+		if err != nil {
+			return nil, err
+		}
+
+		persona, err := personaFromProto(req.GetPersona())
+		if err != nil {
+			return nil, err
+		}
+
+		persona.ID = existing.ID
+		persona.TenantID = existing.TenantID
+		persona.CreatedAt = existing.CreatedAt
+
+		if err := s.repo.UpdatePersona(ctx, persona); err != nil {
+			return nil, err
+		}
+
+		return &pb.UpdatePersonaResponse{Persona: persona.Proto()}, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*pb.UpdatePersonaResponse), nil
+}
+
+func (s *PersonaServer) DeletePersona(ctx context.Context, req *pb.DeletePersonaRequest) (*pb.DeletePersonaResponse, error) {
+	result, err := instrument(ctx, "DeletePersona", func(ctx context.Context) (any, error) {
+		if err := requireAdmin(ctx, "personas"); err != nil {
+			return nil, err
+		}
+
+		if req.GetPersonaId() == "" {
+			return nil, twirp.RequiredArgumentError("persona_id")
+		}
+
+		if err := s.repo.DeletePersona(ctx, req.GetPersonaId()); err != nil {
+			return nil, err
+		}
+
+		return &pb.DeletePersonaResponse{}, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*pb.DeletePersonaResponse), nil
+}
+
+// personaFromProto validates the persona of a request, its ID and creation time are left to the caller.
+func personaFromProto(p *pb.Persona) (*model.Persona, error) {
+	persona := &model.Persona{
+		Name:         strings.TrimSpace(p.GetName()),
+		SystemPrompt: strings.TrimSpace(p.GetSystemPrompt()),
+		Model:        strings.TrimSpace(p.GetModel()),
+		UpdatedAt:    time.Now(),
+		Tools:        []string{},
+	}
+
+	if persona.Name == "" {
+		return nil, twirp.RequiredArgumentError("persona.name")
+	}
+
+	if persona.SystemPrompt == "" {
+		return nil, twirp.RequiredArgumentError("persona.system_prompt")
+	}
+
+	for _, name := range p.GetTools() {
+		if _, ok := tools.Registry[name]; !ok {
+			return nil, twirp.InvalidArgumentError("persona.tools", fmt.Sprintf("unknown tool %q", name))
+		}
+		persona.Tools = append(persona.Tools, name)
+	}
+
+	return persona, nil
+}
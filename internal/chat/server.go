@@ -2,12 +2,18 @@ package chat
 
 import (
 	"context"
+	"errors"
 	"log/slog"
+	"slices"
 	"strings"
 	"time"
 
+	"github.com/acai-travel/tech-challenge/internal/chat/assistant"
+	"github.com/acai-travel/tech-challenge/internal/chat/budget"
 	"github.com/acai-travel/tech-challenge/internal/chat/model"
+	"github.com/acai-travel/tech-challenge/internal/httpx"
 	"github.com/acai-travel/tech-challenge/internal/pb"
+	"github.com/acai-travel/tech-challenge/internal/webhook"
 	"github.com/twitchtv/twirp"
 	"go.mongodb.org/mongo-driver/bson/primitive"
 	"go.opentelemetry.io/otel"
@@ -18,17 +24,47 @@ import (
 var _ pb.ChatService = (*Server)(nil)
 
 type Assistant interface {
-	Title(ctx context.Context, conv *model.Conversation) (string, error)
-	Reply(ctx context.Context, conv *model.Conversation) (string, error)
+	Title(ctx context.Context, conv *model.Conversation) (string, model.Usage, error)
+	Reply(ctx context.Context, conv *model.Conversation) ([]*model.Message, error)
+	ReplyStream(ctx context.Context, conv *model.Conversation, emit func(assistant.Event)) ([]*model.Message, error)
 }
 
 type Server struct {
-	repo   *model.Repository
-	assist Assistant
+	repo     model.ConversationStore
+	personas model.PersonaStore
+	tenants  model.TenantStore
+	budget   *budget.Budget
+	assist   Assistant
+	webhooks *webhook.Sender
+
+	idempotency     model.IdempotencyStore
+	idempotencyWait time.Duration
 }
 
-func NewServer(repo *model.Repository, assist Assistant) *Server {
-	return &Server{repo: repo, assist: assist}
+type Option func(*Server)
+
+// WithPersonas lets conversations be started with one of the personas in the store.
+func WithPersonas(personas model.PersonaStore) Option {
+	return func(s *Server) { s.personas = personas }
+}
+
+// WithTenants replies to the conversations of a tenant following its configuration, see model.Tenant. Without it,
+// requests made within a tenant can not be replied to.
+func WithTenants(tenants model.TenantStore) Option {
+	return func(s *Server) { s.tenants = tenants }
+}
+
+// WithBudget refuses to reply once the caller, identified by httpx.UserFrom, or everyone exhausted their budget.
+func WithBudget(b *budget.Budget) Option {
+	return func(s *Server) { s.budget = b }
+}
+
+func NewServer(repo model.ConversationStore, assist Assistant, opts ...Option) *Server {
+	s := &Server{repo: repo, assist: assist}
+	for _, opt := range opts {
+		opt(s)
+	}
+	return s
 }
 
 var (
@@ -40,7 +76,10 @@ var (
 	responseHistogram, _ = meter.Float64Histogram("chat_response_duration_ms")
 )
 
+// instrument traces and measures a call to fn, whose store queries are scoped to the conversations of the caller and
+// their tenant.
 func instrument(ctx context.Context, method string, fn func(ctx context.Context) (any, error)) (any, error) {
+	ctx = model.WithTenant(model.WithOwner(ctx, httpx.UserFrom(ctx)), httpx.TenantFrom(ctx))
 	ctx, span := tracer.Start(ctx, method)
 	defer span.End()
 	start := time.Now()
@@ -59,135 +98,532 @@ func instrument(ctx context.Context, method string, fn func(ctx context.Context)
 
 func (s *Server) StartConversation(ctx context.Context, req *pb.StartConversationRequest) (*pb.StartConversationResponse, error) {
 	result, err := instrument(ctx, "StartConversation", func(ctx context.Context) (any, error) {
-		if strings.TrimSpace(req.GetMessage()) == "" {
-			return nil, twirp.RequiredArgumentError("message")
-		}
+		return idempotent(ctx, s, "StartConversation", req, func(ctx context.Context) (*pb.StartConversationResponse, error) {
+			return s.startConversation(ctx, req, nil)
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*pb.StartConversationResponse), nil
+}
 
-		questionTime := time.Now()
+// startConversation implements StartConversation, reply progress is reported to emit when it is not nil.
+func (s *Server) startConversation(ctx context.Context, req *pb.StartConversationRequest, emit func(assistant.Event)) (*pb.StartConversationResponse, error) {
+	if strings.TrimSpace(req.GetMessage()) == "" {
+		return nil, twirp.RequiredArgumentError("message")
+	}
 
-		conversation := &model.Conversation{
-			ID:        primitive.NewObjectID(),
-			Title:     "Untitled conversation",
-			CreatedAt: questionTime,
-			UpdatedAt: questionTime,
-			Messages: []*model.Message{{
-				ID:        primitive.NewObjectID(),
-				Role:      model.RoleUser,
-				Content:   req.GetMessage(),
-				CreatedAt: questionTime,
-				UpdatedAt: questionTime,
-			}},
-		}
+	settings, err := conversationSettings(req.GetSettings())
+	if err != nil {
+		return nil, err
+	}
 
-		// choose a title
-		title, err := s.assist.Title(ctx, conversation)
-		if err != nil {
-			slog.ErrorContext(ctx, "Failed to generate conversation title", "error", err)
-		} else {
-			conversation.Title = title
+	questionTime := time.Now()
+
+	conversation := &model.Conversation{
+		ID:        primitive.NewObjectID(),
+		Title:     "Untitled conversation",
+		CreatedAt: questionTime,
+		UpdatedAt: questionTime,
+		OwnerID:   httpx.UserFrom(ctx),
+		TenantID:  httpx.TenantFrom(ctx),
+		Settings:  settings,
+	}
+
+	if id := req.GetPersonaId(); id != "" {
+		if s.personas == nil {
+			return nil, twirp.InvalidArgumentError("persona_id", "personas are not available")
 		}
 
-		// generate a reply
-		reply, err := s.assist.Reply(ctx, conversation)
+		persona, err := s.personas.DescribePersona(ctx, id)
 		if err != nil {
-			slog.ErrorContext(ctx, "Failed to generate conversation reply", "error", err)
 			return nil, err
 		}
-		replyTime := time.Now()
 
-		conversation.Messages = append(conversation.Messages, &model.Message{
-			ID:        primitive.NewObjectID(),
-			Role:      model.RoleAssistant,
-			Content:   reply,
-			CreatedAt: replyTime,
-			UpdatedAt: replyTime,
-		})
+		conversation.PersonaID = persona.ID
+		conversation.Persona = persona
+	}
 
-		if err := s.repo.CreateConversation(ctx, conversation); err != nil {
-			slog.ErrorContext(ctx, "Failed to create conversation", "error", err)
-			return nil, err
+	if err := s.checkBudget(ctx, conversation); err != nil {
+		return nil, err
+	}
+
+	conversation.AddMessage(&model.Message{
+		ID:        primitive.NewObjectID(),
+		Role:      model.RoleUser,
+		Content:   req.GetMessage(),
+		CreatedAt: questionTime,
+		UpdatedAt: questionTime,
+	})
+
+	// choose a title while replying
+	titles := s.generateTitle(ctx, conversation, emit)
+
+	// generate a reply
+	message, err := s.reply(ctx, conversation, emit)
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to generate conversation reply", "error", err)
+		return nil, replyFailed(err)
+	}
+
+	// the title is saved in the background if it is not ready yet
+	select {
+	case t := <-titles:
+		if t.err == nil {
+			conversation.SetTitle(t.title, t.usage)
 		}
+	default:
+		conversation.TitlePending = true
+	}
+
+	if err := s.repo.CreateConversation(ctx, conversation); err != nil {
+		slog.ErrorContext(ctx, "Failed to create conversation", "error", err)
+		return nil, err
+	}
+
+	if conversation.TitlePending {
+		go s.saveTitle(context.WithoutCancel(ctx), conversation.ID.Hex(), titles)
+	} else if conversation.TitleUsage != nil {
+		s.notifyTitle(ctx, conversation)
+	}
 
-		return &pb.StartConversationResponse{
-			ConversationId: conversation.ID.Hex(),
-			Title:          conversation.Title,
-			Reply:          reply,
-		}, nil
+	reply, messageID := replied(conversation, message)
+	return &pb.StartConversationResponse{
+		ConversationId:   conversation.ID.Hex(),
+		Title:            conversation.Title,
+		Reply:            reply,
+		MessageId:        messageID,
+		TitlePending:     conversation.TitlePending,
+		AwaitingApproval: conversation.AwaitingApproval,
+		PendingToolCalls: pendingToolCalls(conversation),
+	}, nil
+}
 
+func (s *Server) ContinueConversation(ctx context.Context, req *pb.ContinueConversationRequest) (*pb.ContinueConversationResponse, error) {
+	result, err := instrument(ctx, "ContinueConversation", func(ctx context.Context) (any, error) {
+		return idempotent(ctx, s, "ContinueConversation", req, func(ctx context.Context) (*pb.ContinueConversationResponse, error) {
+			return s.continueConversation(ctx, req, nil)
+		})
 	})
 	if err != nil {
 		return nil, err
 	}
-	return result.(*pb.StartConversationResponse), nil
+	return result.(*pb.ContinueConversationResponse), nil
 }
 
-func (s *Server) ContinueConversation(ctx context.Context, req *pb.ContinueConversationRequest) (*pb.ContinueConversationResponse, error) {
+// continueConversation implements ContinueConversation, reply progress is reported to emit when it is not nil.
+func (s *Server) continueConversation(ctx context.Context, req *pb.ContinueConversationRequest, emit func(assistant.Event)) (*pb.ContinueConversationResponse, error) {
+	if req.GetConversationId() == "" {
+		return nil, twirp.RequiredArgumentError("conversation_id")
+	}
 
-	result, err := instrument(ctx, "ContinueConversation", func(ctx context.Context) (any, error) {
+	if strings.TrimSpace(req.GetMessage()) == "" {
+		return nil, twirp.RequiredArgumentError("message")
+	}
 
-		if req.GetConversationId() == "" {
-			return nil, twirp.RequiredArgumentError("conversation_id")
-		}
+	conversation, err := s.repo.DescribeConversation(ctx, req.GetConversationId())
+	if err != nil {
+		return nil, err
+	}
 
-		if strings.TrimSpace(req.GetMessage()) == "" {
-			return nil, twirp.RequiredArgumentError("message")
-		}
+	if conversation.AwaitingApproval {
+		return nil, twirp.NewError(twirp.FailedPrecondition, "conversation is awaiting approval of tool calls")
+	}
 
-		conversation, err := s.repo.DescribeConversation(ctx, req.GetConversationId())
-		if err != nil {
-			return nil, err
-		}
+	if err := s.checkBudget(ctx, conversation); err != nil {
+		return nil, err
+	}
 
-		conversation.UpdatedAt = time.Now()
-		conversation.Messages = append(conversation.Messages, &model.Message{
-			ID:        primitive.NewObjectID(),
-			Role:      model.RoleUser,
-			Content:   req.GetMessage(),
-			CreatedAt: time.Now(),
-			UpdatedAt: time.Now(),
-		})
+	question := &model.Message{
+		ID:        primitive.NewObjectID(),
+		Role:      model.RoleUser,
+		Content:   req.GetMessage(),
+		CreatedAt: time.Now(),
+		UpdatedAt: time.Now(),
+	}
+	conversation.AddMessage(question)
 
-		reply, err := s.assist.Reply(ctx, conversation)
-		if err != nil {
-			return nil, twirp.InternalErrorWith(err)
+	message, err := s.reply(ctx, conversation, emit)
+	if err != nil {
+		return nil, replyFailed(err)
+	}
+	conversation.UpdatedAt = message.CreatedAt
+
+	// fails if another reply was added to the conversation in the meantime
+	err = s.writeRetitled(ctx, conversation, func() error {
+		return s.repo.AppendMessages(ctx, conversation, conversation.Messages[question.Position:]...)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	reply, messageID := replied(conversation, message)
+	return &pb.ContinueConversationResponse{
+		Reply:            reply,
+		MessageId:        messageID,
+		AwaitingApproval: conversation.AwaitingApproval,
+		PendingToolCalls: pendingToolCalls(conversation),
+	}, nil
+}
+
+func (s *Server) RegenerateReply(ctx context.Context, req *pb.RegenerateReplyRequest) (*pb.RegenerateReplyResponse, error) {
+	result, err := instrument(ctx, "RegenerateReply", func(ctx context.Context) (any, error) {
+		return s.regenerateReply(ctx, req, nil)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*pb.RegenerateReplyResponse), nil
+}
+
+// regenerateReply implements RegenerateReply, reply progress is reported to emit when it is not nil.
+func (s *Server) regenerateReply(ctx context.Context, req *pb.RegenerateReplyRequest, emit func(assistant.Event)) (*pb.RegenerateReplyResponse, error) {
+	if req.GetConversationId() == "" {
+		return nil, twirp.RequiredArgumentError("conversation_id")
+	}
+
+	conversation, err := s.repo.DescribeConversation(ctx, req.GetConversationId())
+	if err != nil {
+		return nil, err
+	}
+
+	// the reply starts right after the last question, tool calls leading to it are replaced as well
+	from := -1
+	for i, m := range conversation.Messages {
+		if m.Role == model.RoleUser {
+			from = i + 1
 		}
+	}
 
-		conversation.Messages = append(conversation.Messages, &model.Message{
-			ID:        primitive.NewObjectID(),
-			Role:      model.RoleAssistant,
-			Content:   reply,
-			CreatedAt: time.Now(),
-			UpdatedAt: time.Now(),
-		})
+	last := len(conversation.Messages) - 1
+	if from < 0 || from > last || conversation.Messages[last].Role != model.RoleAssistant {
+		return nil, twirp.NewError(twirp.FailedPrecondition, "conversation has no reply to regenerate")
+	}
 
-		if err := s.repo.UpdateConversation(ctx, conversation); err != nil {
-			return nil, twirp.InternalErrorWith(err)
+	if err := s.checkBudget(ctx, conversation); err != nil {
+		return nil, err
+	}
+
+	previous := conversation.Messages[last]
+	conversation.Truncate(from)
+
+	message, err := s.reply(ctx, conversation, emit)
+	if err != nil {
+		return nil, replyFailed(err)
+	}
+
+	conversation.UpdatedAt = message.CreatedAt
+
+	// the previous reply takes the new content, so it keeps its ID and history, and adds up the usage of both, unless
+	// the new reply awaits approval of tool calls which replace it
+	if !conversation.AwaitingApproval {
+		previous.Revise(message.Content, message.CreatedAt)
+		if message.Usage != nil {
+			usage := *message.Usage
+			if previous.Usage != nil {
+				usage.Add(*previous.Usage)
+			}
+			previous.Usage = &usage
 		}
+		previous.Position = message.Position
+		conversation.Messages[message.Position] = previous
+		message = previous
+	}
+
+	err = s.writeRetitled(ctx, conversation, func() error {
+		return s.repo.ReplaceMessages(ctx, conversation, from, conversation.Messages[from:]...)
+	})
+	if err != nil {
+		return nil, err
+	}
 
-		return &pb.ContinueConversationResponse{Reply: reply}, nil
+	reply, messageID := replied(conversation, message)
+	return &pb.RegenerateReplyResponse{
+		Reply:            reply,
+		MessageId:        messageID,
+		AwaitingApproval: conversation.AwaitingApproval,
+		PendingToolCalls: pendingToolCalls(conversation),
+	}, nil
+}
 
+func (s *Server) EditMessage(ctx context.Context, req *pb.EditMessageRequest) (*pb.EditMessageResponse, error) {
+	result, err := instrument(ctx, "EditMessage", func(ctx context.Context) (any, error) {
+		return s.editMessage(ctx, req, nil)
 	})
 	if err != nil {
 		return nil, err
 	}
-	return result.(*pb.ContinueConversationResponse), nil
+	return result.(*pb.EditMessageResponse), nil
+}
+
+// editMessage implements EditMessage, reply progress is reported to emit when it is not nil.
+func (s *Server) editMessage(ctx context.Context, req *pb.EditMessageRequest, emit func(assistant.Event)) (*pb.EditMessageResponse, error) {
+	if req.GetConversationId() == "" {
+		return nil, twirp.RequiredArgumentError("conversation_id")
+	}
+
+	if req.GetMessageId() == "" {
+		return nil, twirp.RequiredArgumentError("message_id")
+	}
+
+	if strings.TrimSpace(req.GetMessage()) == "" {
+		return nil, twirp.RequiredArgumentError("message")
+	}
+
+	conversation, err := s.repo.DescribeConversation(ctx, req.GetConversationId())
+	if err != nil {
+		return nil, err
+	}
+
+	pos := slices.IndexFunc(conversation.Messages, func(m *model.Message) bool {
+		return m.ID.Hex() == req.GetMessageId()
+	})
+	if pos < 0 {
+		return nil, twirp.NotFoundError("message not found")
+	}
+
+	edited := conversation.Messages[pos]
+	if edited.Role != model.RoleUser {
+		return nil, twirp.InvalidArgumentError("message_id", "only user messages can be edited")
+	}
+
+	if err := s.checkBudget(ctx, conversation); err != nil {
+		return nil, err
+	}
+
+	conversation.Truncate(pos)
+	edited.Revise(req.GetMessage(), time.Now())
+	conversation.AddMessage(edited)
+
+	message, err := s.reply(ctx, conversation, emit)
+	if err != nil {
+		return nil, replyFailed(err)
+	}
+	conversation.UpdatedAt = message.CreatedAt
+
+	err = s.writeRetitled(ctx, conversation, func() error {
+		return s.repo.ReplaceMessages(ctx, conversation, pos, conversation.Messages[pos:]...)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	reply, messageID := replied(conversation, message)
+	return &pb.EditMessageResponse{
+		Reply:            reply,
+		MessageId:        messageID,
+		AwaitingApproval: conversation.AwaitingApproval,
+		PendingToolCalls: pendingToolCalls(conversation),
+	}, nil
+}
+
+// reply asks the assistant for a reply, streaming it when emit is set. The tool calls and results leading to the
+// reply are added to the conversation along with the reply itself, which is returned. When the reply stops to await
+// approval of tool calls, the conversation is marked as such and the last tool call is returned instead.
+func (s *Server) reply(ctx context.Context, conv *model.Conversation, emit func(assistant.Event)) (*model.Message, error) {
+	if err := s.loadTenant(ctx, conv); err != nil {
+		return nil, err
+	}
+
+	if err := s.loadPersona(ctx, conv); err != nil {
+		return nil, err
+	}
+
+	var msgs []*model.Message
+	var err error
+
+	if emit == nil {
+		msgs, err = s.assist.Reply(ctx, conv)
+	} else {
+		msgs, err = s.assist.ReplyStream(ctx, conv, emit)
+	}
+
+	if err != nil {
+		// the calls made before failing were paid for
+		var re *assistant.ReplyError
+		if errors.As(err, &re) {
+			s.charge(ctx, conv, &re.Usage)
+		}
+		return nil, err
+	}
+
+	if len(msgs) == 0 {
+		return nil, errors.New("assistant returned no reply")
+	}
+
+	for _, m := range msgs {
+		conv.AddMessage(m)
+	}
+
+	reply := msgs[len(msgs)-1]
+	conv.AwaitingApproval = reply.Role == model.RoleToolCall
+	s.charge(ctx, conv, reply.Usage)
+
+	return reply, nil
+}
+
+// replyFailed turns the error of reply into a twirp.Internal error, unless it already is a twirp error, e.g. the
+// twirp.NotFound of an unknown tenant.
+func replyFailed(err error) error {
+	var terr twirp.Error
+	if errors.As(err, &terr) {
+		return terr
+	}
+	return twirp.InternalErrorWith(err)
+}
+
+// replied returns the content and ID of the reply, both empty when the conversation awaits approval of tool calls.
+func replied(conv *model.Conversation, reply *model.Message) (string, string) {
+	if conv.AwaitingApproval {
+		return "", ""
+	}
+	return reply.Content, reply.ID.Hex()
+}
+
+// pendingToolCalls returns the tool calls the conversation awaits approval for.
+func pendingToolCalls(conv *model.Conversation) []*pb.Conversation_ToolCall {
+	var calls []*pb.Conversation_ToolCall
+	for _, m := range conv.PendingToolCalls() {
+		calls = append(calls, m.Proto().GetToolCall())
+	}
+	return calls
+}
+
+// checkBudget fails with twirp.ResourceExhausted once the caller, the conversation's tenant or everyone exhausted their
+// budget. It loads the tenant of the conversation along the way.
+func (s *Server) checkBudget(ctx context.Context, conv *model.Conversation) error {
+	if err := s.loadTenant(ctx, conv); err != nil {
+		return err
+	}
+
+	if s.budget == nil {
+		return nil
+	}
+	return s.budget.Check(ctx, httpx.UserFrom(ctx), conv.Tenant)
+}
+
+// charge records usage in the ledger the usage report is made from, and against the budgets of the caller and the
+// conversation's tenant. The model was already paid for, so failing to record its usage only logs an error instead of
+// failing the request.
+func (s *Server) charge(ctx context.Context, conv *model.Conversation, usage *model.Usage) {
+	if usage == nil {
+		return
+	}
+
+	if err := s.repo.RecordUsage(ctx, model.NewUsageRecord(conv, *usage, time.Now())); err != nil {
+		slog.ErrorContext(ctx, "Failed to record usage in the ledger", "error", err)
+	}
+
+	if s.budget == nil {
+		return
+	}
+
+	if err := s.budget.Record(ctx, httpx.UserFrom(ctx), conv.Tenant, *usage); err != nil {
+		slog.ErrorContext(ctx, "Failed to record usage against the budget", "error", err)
+	}
+}
+
+// loadTenant loads the tenant of the conversation, if it has one. Conversations of deleted tenants can no longer be
+// replied to.
+func (s *Server) loadTenant(ctx context.Context, conv *model.Conversation) error {
+	if conv.TenantID == "" || conv.Tenant != nil {
+		return nil
+	}
+
+	if s.tenants == nil {
+		return twirp.NewError(twirp.FailedPrecondition, "tenants are not available")
+	}
+
+	tenant, err := s.tenants.DescribeTenant(ctx, conv.TenantID)
+	if err != nil {
+		return err
+	}
+
+	conv.Tenant = tenant
+	return nil
+}
+
+// loadPersona loads the persona of the conversation, if it has one. Conversations whose persona was deleted fall back
+// to the default assistant.
+func (s *Server) loadPersona(ctx context.Context, conv *model.Conversation) error {
+	if conv.PersonaID.IsZero() || conv.Persona != nil || s.personas == nil {
+		return nil
+	}
+
+	persona, err := s.personas.DescribePersona(ctx, conv.PersonaID.Hex())
+	if te, ok := err.(twirp.Error); ok && te.Code() == twirp.NotFound {
+		slog.WarnContext(ctx, "Persona of conversation not found, using the default assistant", "conversation_id", conv.ID, "persona_id", conv.PersonaID)
+		return nil
+	}
+
+	if err != nil {
+		return err
+	}
+
+	conv.Persona = persona
+	return nil
 }
 
+const (
+	defaultPageSize = 20
+	maxPageSize     = 100
+)
+
 func (s *Server) ListConversations(ctx context.Context, req *pb.ListConversationsRequest) (*pb.ListConversationsResponse, error) {
 
 	result, err := instrument(ctx, "ListConversations", func(ctx context.Context) (any, error) {
 
-		conversations, err := s.repo.ListConversations(ctx)
+		opts := model.ListOptions{
+			IncludeArchived: req.GetIncludeArchived(),
+			Limit:           defaultPageSize,
+			TitlePrefix:     req.GetTitlePrefix(),
+		}
+
+		switch size := req.GetPageSize(); {
+		case size < 0:
+			return nil, twirp.InvalidArgumentError("page_size", "must not be negative")
+		case size > maxPageSize:
+			opts.Limit = maxPageSize
+		case size > 0:
+			opts.Limit = int(size)
+		}
+
+		if token := req.GetPageToken(); token != "" {
+			cursor, err := model.ParseCursor(token)
+			if err != nil {
+				return nil, twirp.InvalidArgumentError("page_token", err.Error())
+			}
+			opts.After = cursor
+		}
+
+		if req.CreatedAfter != nil {
+			opts.CreatedAfter = req.GetCreatedAfter().AsTime()
+		}
+		if req.CreatedBefore != nil {
+			opts.CreatedBefore = req.GetCreatedBefore().AsTime()
+		}
+		if req.UpdatedAfter != nil {
+			opts.UpdatedAfter = req.GetUpdatedAfter().AsTime()
+		}
+		if req.UpdatedBefore != nil {
+			opts.UpdatedBefore = req.GetUpdatedBefore().AsTime()
+		}
+
+		conversations, next, err := s.repo.ListConversations(ctx, opts)
 		if err != nil {
 			return nil, twirp.InternalErrorWith(err)
 		}
 
 		resp := &pb.ListConversationsResponse{}
 		for _, conv := range conversations {
-			conv.Messages = nil // Clear messages to avoid sending large data
 			resp.Conversations = append(resp.Conversations, conv.Proto())
 		}
 
+		if next != nil {
+			resp.NextPageToken = next.Token()
+		}
+
 		return resp, nil
 
 	})
@@ -213,10 +649,263 @@ func (s *Server) DescribeConversation(ctx context.Context, req *pb.DescribeConve
 			return nil, twirp.NotFoundError("conversation not found")
 		}
 
-		return &pb.DescribeConversationResponse{Conversation: conversation.Proto()}, nil
+		forks, _, err := s.repo.ListConversations(ctx, model.ListOptions{IncludeArchived: true, ParentID: conversation.ID})
+		if err != nil {
+			return nil, twirp.InternalErrorWith(err)
+		}
+
+		resp := &pb.DescribeConversationResponse{Conversation: conversation.Proto()}
+		for _, fork := range forks {
+			resp.Forks = append(resp.Forks, fork.Proto())
+		}
+
+		return resp, nil
 	})
 	if err != nil {
 		return nil, err
 	}
 	return result.(*pb.DescribeConversationResponse), nil
 }
+
+func (s *Server) ForkConversation(ctx context.Context, req *pb.ForkConversationRequest) (*pb.ForkConversationResponse, error) {
+	result, err := instrument(ctx, "ForkConversation", func(ctx context.Context) (any, error) {
+
+		if req.GetConversationId() == "" {
+			return nil, twirp.RequiredArgumentError("conversation_id")
+		}
+
+		if req.GetMessageId() == "" {
+			return nil, twirp.RequiredArgumentError("message_id")
+		}
+
+		conversation, err := s.repo.DescribeConversation(ctx, req.GetConversationId())
+		if err != nil {
+			return nil, err
+		}
+
+		messageID, err := primitive.ObjectIDFromHex(req.GetMessageId())
+		if err != nil {
+			return nil, twirp.NotFoundError("message not found")
+		}
+
+		fork := conversation.Fork(messageID, time.Now())
+		if fork == nil {
+			return nil, twirp.NotFoundError("message not found")
+		}
+
+		// a fork ending in the middle of a tool call could not be continued
+		if role := fork.Messages[len(fork.Messages)-1].Role; role != model.RoleUser && role != model.RoleAssistant {
+			return nil, twirp.InvalidArgumentError("message_id", "must refer to a user or assistant message")
+		}
+
+		if err := s.repo.CreateConversation(ctx, fork); err != nil {
+			return nil, err
+		}
+
+		// the title of the conversation is saved to it alone, so the fork gets one of its own
+		if fork.TitlePending {
+			go s.saveTitle(context.WithoutCancel(ctx), fork.ID.Hex(), s.generateTitle(ctx, fork, nil))
+		}
+
+		return &pb.ForkConversationResponse{Conversation: fork.Proto()}, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*pb.ForkConversationResponse), nil
+}
+
+func (s *Server) DeleteConversation(ctx context.Context, req *pb.DeleteConversationRequest) (*pb.DeleteConversationResponse, error) {
+	result, err := instrument(ctx, "DeleteConversation", func(ctx context.Context) (any, error) {
+
+		if req.GetConversationId() == "" {
+			return nil, twirp.RequiredArgumentError("conversation_id")
+		}
+
+		if err := s.repo.DeleteConversation(ctx, req.GetConversationId()); err != nil {
+			return nil, err
+		}
+
+		return &pb.DeleteConversationResponse{}, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*pb.DeleteConversationResponse), nil
+}
+
+func (s *Server) UpdateConversationTitle(ctx context.Context, req *pb.UpdateConversationTitleRequest) (*pb.UpdateConversationTitleResponse, error) {
+	result, err := instrument(ctx, "UpdateConversationTitle", func(ctx context.Context) (any, error) {
+
+		if req.GetConversationId() == "" {
+			return nil, twirp.RequiredArgumentError("conversation_id")
+		}
+
+		title := strings.TrimSpace(req.GetTitle())
+		if title == "" {
+			return nil, twirp.RequiredArgumentError("title")
+		}
+
+		conversation, err := s.repo.DescribeConversation(ctx, req.GetConversationId())
+		if err != nil {
+			return nil, err
+		}
+
+		conversation.Title = title
+		conversation.TitlePending = false
+
+		if err := s.repo.UpdateConversation(ctx, conversation); err != nil {
+			return nil, err
+		}
+
+		return &pb.UpdateConversationTitleResponse{Conversation: conversation.Proto()}, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*pb.UpdateConversationTitleResponse), nil
+}
+
+func (s *Server) UpdateConversationSettings(ctx context.Context, req *pb.UpdateConversationSettingsRequest) (*pb.UpdateConversationSettingsResponse, error) {
+	result, err := instrument(ctx, "UpdateConversationSettings", func(ctx context.Context) (any, error) {
+
+		if req.GetConversationId() == "" {
+			return nil, twirp.RequiredArgumentError("conversation_id")
+		}
+
+		settings, err := conversationSettings(req.GetSettings())
+		if err != nil {
+			return nil, err
+		}
+
+		conversation, err := s.repo.DescribeConversation(ctx, req.GetConversationId())
+		if err != nil {
+			return nil, err
+		}
+
+		conversation.Settings = settings
+
+		if err := s.repo.UpdateConversation(ctx, conversation); err != nil {
+			return nil, err
+		}
+
+		return &pb.UpdateConversationSettingsResponse{Conversation: conversation.Proto()}, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*pb.UpdateConversationSettingsResponse), nil
+}
+
+func (s *Server) GetUsageReport(ctx context.Context, req *pb.GetUsageReportRequest) (*pb.GetUsageReportResponse, error) {
+	result, err := instrument(ctx, "GetUsageReport", func(ctx context.Context) (any, error) {
+
+		opts := model.UsageReportOptions{}
+		if req.StartTime != nil {
+			opts.Start = req.GetStartTime().AsTime()
+		}
+		if req.EndTime != nil {
+			opts.End = req.GetEndTime().AsTime()
+		}
+
+		if !opts.Start.IsZero() && !opts.End.IsZero() && !opts.Start.Before(opts.End) {
+			return nil, twirp.InvalidArgumentError("end_time", "must be after start_time")
+		}
+
+		rows, err := s.repo.UsageReport(ctx, opts)
+		if err != nil {
+			return nil, twirp.InternalErrorWith(err)
+		}
+
+		var total model.Usage
+		resp := &pb.GetUsageReportResponse{}
+		for _, row := range rows {
+			total.Add(row.Usage)
+			resp.Rows = append(resp.Rows, &pb.GetUsageReportResponse_Row{Day: row.Day, Usage: row.Usage.Proto()})
+		}
+		resp.Total = total.Proto()
+
+		return resp, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*pb.GetUsageReportResponse), nil
+}
+
+// conversationSettings validates the settings of a request, nil settings are the defaults.
+func conversationSettings(p *pb.ConversationSettings) (model.Settings, error) {
+	settings := model.Settings{
+		SystemPrompt: strings.TrimSpace(p.GetSystemPrompt()),
+		Model:        strings.TrimSpace(p.GetModel()),
+		MaxTokens:    int(p.GetMaxTokens()),
+	}
+
+	if p != nil && p.Temperature != nil {
+		t := p.GetTemperature()
+		if t < 0 || t > 2 {
+			return model.Settings{}, twirp.InvalidArgumentError("settings.temperature", "must be between 0 and 2")
+		}
+		settings.Temperature = &t
+	}
+
+	if settings.MaxTokens < 0 {
+		return model.Settings{}, twirp.InvalidArgumentError("settings.max_tokens", "must not be negative")
+	}
+
+	return settings, nil
+}
+
+func (s *Server) ArchiveConversation(ctx context.Context, req *pb.ArchiveConversationRequest) (*pb.ArchiveConversationResponse, error) {
+	result, err := instrument(ctx, "ArchiveConversation", func(ctx context.Context) (any, error) {
+		conversation, err := s.setArchived(ctx, req.GetConversationId(), true)
+		if err != nil {
+			return nil, err
+		}
+
+		return &pb.ArchiveConversationResponse{Conversation: conversation.Proto()}, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*pb.ArchiveConversationResponse), nil
+}
+
+func (s *Server) UnarchiveConversation(ctx context.Context, req *pb.UnarchiveConversationRequest) (*pb.UnarchiveConversationResponse, error) {
+	result, err := instrument(ctx, "UnarchiveConversation", func(ctx context.Context) (any, error) {
+		conversation, err := s.setArchived(ctx, req.GetConversationId(), false)
+		if err != nil {
+			return nil, err
+		}
+
+		return &pb.UnarchiveConversationResponse{Conversation: conversation.Proto()}, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*pb.UnarchiveConversationResponse), nil
+}
+
+// setArchived archives or restores a conversation and returns it.
+func (s *Server) setArchived(ctx context.Context, id string, archived bool) (*model.Conversation, error) {
+	if id == "" {
+		return nil, twirp.RequiredArgumentError("conversation_id")
+	}
+
+	conversation, err := s.repo.DescribeConversation(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if conversation.Archived == archived {
+		return conversation, nil
+	}
+
+	conversation.Archived = archived
+
+	if err := s.repo.UpdateConversation(ctx, conversation); err != nil {
+		return nil, err
+	}
+
+	return conversation, nil
+}
@@ -1,24 +1,43 @@
 package chat
 
 import (
+	"bufio"
 	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"slices"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/acai-travel/tech-challenge/internal/chat/assistant"
+	"github.com/acai-travel/tech-challenge/internal/chat/budget"
 	"github.com/acai-travel/tech-challenge/internal/chat/model"
 	. "github.com/acai-travel/tech-challenge/internal/chat/testing"
+	"github.com/acai-travel/tech-challenge/internal/httpx"
 	"github.com/acai-travel/tech-challenge/internal/pb"
 	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/google/uuid"
 	"github.com/twitchtv/twirp"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"google.golang.org/protobuf/encoding/protojson"
 	"google.golang.org/protobuf/testing/protocmp"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 func TestServer_StartConversation(t *testing.T) {
 	ctx := context.Background()
-	srv := NewServer(model.New(ConnectMongo()), assistant.New())
 
 	t.Run("start conversation creates new conversation and populates title/response", WithFixture(func(t *testing.T, f *Fixture) {
+		openai := StartFakeOpenAI(t).
+			Title("Weather in Paris").
+			CallTools(FakeToolCall{ID: "call_1", Name: "get_today_date", Arguments: "{}"}).
+			Reply("It is sunny in Paris today.")
+		srv := NewServer(f.ConversationStore, assistant.New(assistant.WithBaseURL(openai.URL)))
+
 		req := pb.StartConversationRequest{
 			Message: "What is the weather in Paris?",
 		}
@@ -26,30 +45,40 @@ func TestServer_StartConversation(t *testing.T) {
 		if err != nil {
 			t.Fatalf("unexpected error: %v", err)
 		}
+		f.Cleanup(out.GetConversationId())
 
-		conv, err := srv.DescribeConversation(ctx, &pb.DescribeConversationRequest{ConversationId: out.GetConversationId()})
-		if err != nil {
-			t.Fatalf("unexpected error: %v", err)
+		if (out.GetTitle() != "Weather in Paris" && !out.GetTitlePending()) || out.GetReply() != "It is sunny in Paris today." {
+			t.Errorf("unexpected response: %v", out)
 		}
-		if conv == nil {
-			t.Fatal("expected conversation, got nil")
+
+		conv := awaitTitle(t, srv, ctx, out.GetConversationId())
+		if conv.Conversation.Title != "Weather in Paris" {
+			t.Errorf("expected conversation title to be summarized, got %q", conv.Conversation.Title)
 		}
-		if conv.Conversation.Title == "" {
-			t.Error("expected conversation title to be populated")
+
+		msgs := conv.Conversation.Messages
+		if len(msgs) != 4 || msgs[0].Role != pb.Conversation_USER || msgs[1].Role != pb.Conversation_TOOL_CALL || msgs[2].Role != pb.Conversation_TOOL_RESULT || msgs[3].Role != pb.Conversation_ASSISTANT {
+			t.Fatalf("expected question, tool call, tool result and assistant response messages, got %v", msgs)
 		}
-		if len(conv.Conversation.Messages) == 0 || conv.Conversation.Messages[len(conv.Conversation.Messages)-1].Role != 2 {
-			t.Error("expected assistant response message")
+		if call, result := msgs[1].GetToolCall(), msgs[2].GetToolCall(); call.GetName() != "get_today_date" || result.GetId() != call.GetId() || result.GetResult() == "" {
+			t.Errorf("expected tool call and its result to be persisted, got %v and %v", call, result)
 		}
-		if !strings.Contains(conv.Conversation.Title, "weather in Paris") {
-			t.Error("expected conversation title to be summarized")
+		if msgs[3].Id != out.GetMessageId() || msgs[3].Content != "It is sunny in Paris today." {
+			t.Errorf("expected assistant response to be persisted, got %v", msgs[3])
+		}
+
+		if titles, replies := splitRequests(openai.Requests()); len(titles) != 1 || len(replies) != 2 || len(replies[0].Tools) == 0 {
+			t.Errorf("expected a title request and two reply requests, got %d and %d", len(titles), len(replies))
 		}
 	}))
 }
+
 func TestServer_DescribeConversation(t *testing.T) {
 	ctx := context.Background()
-	srv := NewServer(model.New(ConnectMongo()), nil)
 
 	t.Run("describe existing conversation", WithFixture(func(t *testing.T, f *Fixture) {
+		srv := NewServer(f.ConversationStore, nil)
+
 		c := f.CreateConversation()
 
 		out, err := srv.DescribeConversation(ctx, &pb.DescribeConversationRequest{ConversationId: c.ID.Hex()})
@@ -64,6 +93,8 @@ func TestServer_DescribeConversation(t *testing.T) {
 	}))
 
 	t.Run("describe non existing conversation should return 404", WithFixture(func(t *testing.T, f *Fixture) {
+		srv := NewServer(f.ConversationStore, nil)
+
 		_, err := srv.DescribeConversation(ctx, &pb.DescribeConversationRequest{ConversationId: "08a59244257c872c5943e2a2"})
 		if err == nil {
 			t.Fatal("expected error for non-existing conversation, got nil")
@@ -74,3 +105,852 @@ func TestServer_DescribeConversation(t *testing.T) {
 		}
 	}))
 }
+
+func TestServer_Ownership(t *testing.T) {
+	alice := httpx.WithUser(context.Background(), "alice")
+	bob := httpx.WithUser(context.Background(), "bob")
+
+	t.Run("conversations belong to the user who started them", WithFixture(func(t *testing.T, f *Fixture) {
+		openai := StartFakeOpenAI(t).Title("Weather today").Reply("It is sunny.")
+		srv := NewServer(f.ConversationStore, assistant.New(assistant.WithBaseURL(openai.URL)))
+
+		out, err := srv.StartConversation(alice, &pb.StartConversationRequest{Message: "What is the weather like?"})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		f.Cleanup(out.GetConversationId())
+
+		described, err := srv.DescribeConversation(alice, &pb.DescribeConversationRequest{ConversationId: out.GetConversationId()})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if owner := described.GetConversation().GetOwnerId(); owner != "alice" {
+			t.Errorf("expected the conversation to be owned by alice, got %q", owner)
+		}
+	}))
+
+	t.Run("conversations of other users are not found", WithFixture(func(t *testing.T, f *Fixture) {
+		srv := NewServer(f.ConversationStore, nil)
+
+		c := f.CreateConversation(func(c *model.Conversation) { c.OwnerID = "alice" })
+
+		_, err := srv.DescribeConversation(bob, &pb.DescribeConversationRequest{ConversationId: c.ID.Hex()})
+		if te, ok := err.(twirp.Error); !ok || te.Code() != twirp.NotFound {
+			t.Fatalf("expected twirp.NotFound error, got %v", err)
+		}
+
+		_, err = srv.DeleteConversation(bob, &pb.DeleteConversationRequest{ConversationId: c.ID.Hex()})
+		if te, ok := err.(twirp.Error); !ok || te.Code() != twirp.NotFound {
+			t.Fatalf("expected twirp.NotFound error, got %v", err)
+		}
+
+		for ctx, want := range map[context.Context]int{alice: 1, bob: 0} {
+			out, err := srv.ListConversations(ctx, &pb.ListConversationsRequest{TitlePrefix: c.Title})
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if got := len(out.GetConversations()); got != want {
+				t.Errorf("expected %d conversations listed for %s, got %d", want, httpx.UserFrom(ctx), got)
+			}
+		}
+	}))
+}
+
+func TestServer_DeleteConversation(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("delete existing conversation", WithFixture(func(t *testing.T, f *Fixture) {
+		srv := NewServer(f.ConversationStore, nil)
+
+		c := f.CreateConversation()
+
+		if _, err := srv.DeleteConversation(ctx, &pb.DeleteConversationRequest{ConversationId: c.ID.Hex()}); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		_, err := srv.DescribeConversation(ctx, &pb.DescribeConversationRequest{ConversationId: c.ID.Hex()})
+		if te, ok := err.(twirp.Error); !ok || te.Code() != twirp.NotFound {
+			t.Fatalf("expected twirp.NotFound error after delete, got %v", err)
+		}
+	}))
+
+	t.Run("delete non existing conversation should return 404", WithFixture(func(t *testing.T, f *Fixture) {
+		srv := NewServer(f.ConversationStore, nil)
+
+		_, err := srv.DeleteConversation(ctx, &pb.DeleteConversationRequest{ConversationId: "08a59244257c872c5943e2a2"})
+		if te, ok := err.(twirp.Error); !ok || te.Code() != twirp.NotFound {
+			t.Fatalf("expected twirp.NotFound error, got %v", err)
+		}
+	}))
+}
+
+func TestServer_UpdateConversationTitle(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("rename conversation", WithFixture(func(t *testing.T, f *Fixture) {
+		srv := NewServer(f.ConversationStore, nil)
+
+		c := f.CreateConversation()
+
+		if _, err := srv.UpdateConversationTitle(ctx, &pb.UpdateConversationTitleRequest{ConversationId: c.ID.Hex(), Title: " Weather today "}); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		out, err := srv.DescribeConversation(ctx, &pb.DescribeConversationRequest{ConversationId: c.ID.Hex()})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if got, want := out.GetConversation().GetTitle(), "Weather today"; got != want {
+			t.Errorf("expected title %q, got %q", want, got)
+		}
+	}))
+
+	t.Run("empty title is rejected", WithFixture(func(t *testing.T, f *Fixture) {
+		srv := NewServer(f.ConversationStore, nil)
+
+		c := f.CreateConversation()
+
+		_, err := srv.UpdateConversationTitle(ctx, &pb.UpdateConversationTitleRequest{ConversationId: c.ID.Hex(), Title: "  "})
+		if te, ok := err.(twirp.Error); !ok || te.Code() != twirp.InvalidArgument {
+			t.Fatalf("expected twirp.InvalidArgument error, got %v", err)
+		}
+	}))
+}
+
+func TestServer_ArchiveConversation(t *testing.T) {
+	ctx := context.Background()
+
+	listed := func(t *testing.T, srv *Server, req *pb.ListConversationsRequest, id string) bool {
+		out, err := srv.ListConversations(ctx, req)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		for _, c := range out.GetConversations() {
+			if c.GetId() == id {
+				return true
+			}
+		}
+
+		return false
+	}
+
+	t.Run("archived conversations are left out of list by default", WithFixture(func(t *testing.T, f *Fixture) {
+		srv := NewServer(f.ConversationStore, nil)
+
+		c := f.CreateConversation()
+
+		if _, err := srv.ArchiveConversation(ctx, &pb.ArchiveConversationRequest{ConversationId: c.ID.Hex()}); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if listed(t, srv, &pb.ListConversationsRequest{}, c.ID.Hex()) {
+			t.Error("expected archived conversation to be left out of list")
+		}
+
+		if !listed(t, srv, &pb.ListConversationsRequest{IncludeArchived: true}, c.ID.Hex()) {
+			t.Error("expected archived conversation to be listed with include_archived")
+		}
+
+		if _, err := srv.UnarchiveConversation(ctx, &pb.UnarchiveConversationRequest{ConversationId: c.ID.Hex()}); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if !listed(t, srv, &pb.ListConversationsRequest{}, c.ID.Hex()) {
+			t.Error("expected unarchived conversation to be listed")
+		}
+	}))
+}
+
+func TestServer_ListConversations(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("list conversations page by page", WithFixture(func(t *testing.T, f *Fixture) {
+		srv := NewServer(f.ConversationStore, nil)
+
+		prefix := uuid.New().String()
+
+		var want []string
+		for i := range 5 {
+			c := f.CreateConversation(func(c *model.Conversation) {
+				c.Title = fmt.Sprintf("%s %d", prefix, i)
+				c.UpdatedAt = c.UpdatedAt.Add(time.Duration(i) * time.Hour)
+			})
+			want = append([]string{c.ID.Hex()}, want...)
+		}
+
+		var got []string
+		req := &pb.ListConversationsRequest{PageSize: 2, TitlePrefix: prefix}
+		for pages := 1; ; pages++ {
+			out, err := srv.ListConversations(ctx, req)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			for _, c := range out.GetConversations() {
+				if len(c.GetMessages()) != 0 {
+					t.Errorf("expected listed conversation %s to come without messages", c.GetId())
+				}
+				got = append(got, c.GetId())
+			}
+
+			if out.GetNextPageToken() == "" {
+				if pages != 3 {
+					t.Errorf("expected 3 pages, got %d", pages)
+				}
+				break
+			}
+			req.PageToken = out.GetNextPageToken()
+		}
+
+		if !cmp.Equal(got, want) {
+			t.Errorf("ListConversations() mismatch (-got +want):\n%s", cmp.Diff(got, want))
+		}
+	}))
+
+	t.Run("list conversations within an update range", WithFixture(func(t *testing.T, f *Fixture) {
+		srv := NewServer(f.ConversationStore, nil)
+
+		prefix := uuid.New().String()
+
+		var ids []string
+		for i := range 3 {
+			c := f.CreateConversation(func(c *model.Conversation) {
+				c.Title = prefix
+				c.UpdatedAt = c.UpdatedAt.Add(time.Duration(i) * time.Hour)
+			})
+			ids = append(ids, c.ID.Hex())
+		}
+
+		out, err := srv.ListConversations(ctx, &pb.ListConversationsRequest{
+			TitlePrefix:   prefix,
+			UpdatedAfter:  timestamppb.New(time.Date(2023, 10, 1, 1, 0, 0, 0, time.UTC)),
+			UpdatedBefore: timestamppb.New(time.Date(2023, 10, 1, 2, 0, 0, 0, time.UTC)),
+		})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if len(out.GetConversations()) != 1 || out.GetConversations()[0].GetId() != ids[1] {
+			t.Errorf("expected only conversation %s, got %v", ids[1], out.GetConversations())
+		}
+	}))
+
+	t.Run("malformed page token is rejected", WithFixture(func(t *testing.T, f *Fixture) {
+		srv := NewServer(f.ConversationStore, nil)
+
+		_, err := srv.ListConversations(ctx, &pb.ListConversationsRequest{PageToken: "not-a-token"})
+		if te, ok := err.(twirp.Error); !ok || te.Code() != twirp.InvalidArgument {
+			t.Fatalf("expected twirp.InvalidArgument error, got %v", err)
+		}
+	}))
+}
+
+func TestServer_StreamHandler(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("stream start conversation", WithFixture(func(t *testing.T, f *Fixture) {
+		openai := StartFakeOpenAI(t).
+			Title("Today's date").
+			CallTools(FakeToolCall{ID: "call_1", Name: "get_today_date", Arguments: "{}"}).
+			Reply("Today is a good day.")
+		srv := NewServer(f.ConversationStore, assistant.New(assistant.WithBaseURL(openai.URL)))
+
+		web := httptest.NewServer(srv.StreamHandler())
+		defer web.Close()
+
+		resp, err := http.Post(web.URL+StreamPathPrefix+"StartConversation", "application/json", strings.NewReader(`{"message": "What day is today?"}`))
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		defer resp.Body.Close()
+
+		if ct := resp.Header.Get("Content-Type"); ct != "text/event-stream" {
+			t.Fatalf("expected event stream, got %q", ct)
+		}
+
+		var events []string
+		var deltas, title, done string
+		scanner := bufio.NewScanner(resp.Body)
+		for scanner.Scan() {
+			line := scanner.Text()
+			if event, ok := strings.CutPrefix(line, "event: "); ok {
+				events = append(events, event)
+			}
+			if data, ok := strings.CutPrefix(line, "data: "); ok {
+				var e assistant.Event
+				_ = json.Unmarshal([]byte(data), &e)
+				deltas += e.Delta
+				if events[len(events)-1] == string(assistant.EventTitleUpdated) {
+					title = e.Title
+				}
+				done = data
+			}
+		}
+
+		// the title may be generated before or after the reply
+		events = slices.DeleteFunc(events, func(e string) bool {
+			return e == string(assistant.EventTitleUpdated)
+		})
+
+		if events[0] != "tool_call_started" || events[1] != "tool_call_finished" || events[len(events)-1] != "done" {
+			t.Errorf("unexpected events: %v", events)
+		}
+
+		if deltas != "Today is a good day." {
+			t.Errorf("expected deltas to add up to the reply, got %q", deltas)
+		}
+
+		var out pb.StartConversationResponse
+		if err := protojson.Unmarshal([]byte(done), &out); err != nil {
+			t.Fatalf("failed to decode done event: %v", err)
+		}
+		f.Cleanup(out.GetConversationId())
+
+		if (title == "") != out.GetTitlePending() || (title != "" && title != "Today's date") {
+			t.Errorf("expected a title_updated event unless the title is pending, got %q and %v", title, out.GetTitlePending())
+		}
+
+		conv, err := srv.DescribeConversation(ctx, &pb.DescribeConversationRequest{ConversationId: out.GetConversationId()})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		msgs := conv.GetConversation().GetMessages()
+		if last := msgs[len(msgs)-1]; last.GetId() != out.GetMessageId() || last.GetContent() != deltas {
+			t.Errorf("expected streamed reply to be persisted, got %v", last)
+		}
+	}))
+
+	t.Run("invalid request is reported as an error event", WithFixture(func(t *testing.T, f *Fixture) {
+		srv := NewServer(f.ConversationStore, nil)
+
+		web := httptest.NewServer(srv.StreamHandler())
+		defer web.Close()
+
+		resp, err := http.Post(web.URL+StreamPathPrefix+"ContinueConversation", "application/json", strings.NewReader(`{"message": "Hi"}`))
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		defer resp.Body.Close()
+
+		scanner := bufio.NewScanner(resp.Body)
+		var events []string
+		for scanner.Scan() {
+			if event, ok := strings.CutPrefix(scanner.Text(), "event: "); ok {
+				events = append(events, event)
+			}
+		}
+
+		if len(events) != 1 || events[0] != "error" {
+			t.Errorf("expected a single error event, got %v", events)
+		}
+	}))
+}
+
+func TestServer_StreamContinueConversation(t *testing.T) {
+	type event struct{ name, data string }
+
+	stream := func(t *testing.T, srv *Server, body string) []event {
+		web := httptest.NewServer(srv.StreamHandler())
+		defer web.Close()
+
+		resp, err := http.Post(web.URL+StreamPathPrefix+"ContinueConversation", "application/json", strings.NewReader(body))
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		defer resp.Body.Close()
+
+		var events []event
+		scanner := bufio.NewScanner(resp.Body)
+		for scanner.Scan() {
+			if name, ok := strings.CutPrefix(scanner.Text(), "event: "); ok {
+				events = append(events, event{name: name})
+			}
+			if data, ok := strings.CutPrefix(scanner.Text(), "data: "); ok {
+				events[len(events)-1].data = data
+			}
+		}
+		return events
+	}
+
+	t.Run("deltas are followed by a single done event", WithFixture(func(t *testing.T, f *Fixture) {
+		conv := f.CreateConversation()
+		openai := StartFakeOpenAI(t).Reply("It is sunny in Barcelona.")
+		srv := NewServer(f.ConversationStore, assistant.New(assistant.WithBaseURL(openai.URL)))
+
+		events := stream(t, srv, fmt.Sprintf(`{"conversation_id": %q, "message": "And in Barcelona?"}`, conv.ID.Hex()))
+		if len(events) < 2 {
+			t.Fatalf("expected deltas and a done event, got %v", events)
+		}
+
+		var deltas string
+		for _, e := range events[:len(events)-1] {
+			if e.name != string(assistant.EventDelta) {
+				t.Fatalf("expected only deltas before the done event, got %v", events)
+			}
+			var delta assistant.Event
+			if err := json.Unmarshal([]byte(e.data), &delta); err != nil {
+				t.Fatalf("failed to decode delta event: %v", err)
+			}
+			deltas += delta.Delta
+		}
+
+		last := events[len(events)-1]
+		if last.name != "done" {
+			t.Fatalf("expected the stream to end with a done event, got %v", events)
+		}
+
+		var out pb.ContinueConversationResponse
+		if err := protojson.Unmarshal([]byte(last.data), &out); err != nil {
+			t.Fatalf("failed to decode done event: %v", err)
+		}
+
+		if deltas != "It is sunny in Barcelona." || out.GetReply() != deltas || out.GetMessageId() == "" {
+			t.Errorf("expected the deltas to add up to the reply of the done event, got %q and %v", deltas, &out)
+		}
+	}))
+
+	t.Run("failed replies end with an error event", WithFixture(func(t *testing.T, f *Fixture) {
+		conv := f.CreateConversation()
+		openai := StartFakeOpenAI(t).Fail(http.StatusBadRequest, "invalid request")
+		srv := NewServer(f.ConversationStore, assistant.New(assistant.WithBaseURL(openai.URL)))
+
+		events := stream(t, srv, fmt.Sprintf(`{"conversation_id": %q, "message": "And in Barcelona?"}`, conv.ID.Hex()))
+		if len(events) != 1 || events[0].name != "error" {
+			t.Fatalf("expected a single error event, got %v", events)
+		}
+
+		var e struct{ Code, Msg string }
+		if err := json.Unmarshal([]byte(events[0].data), &e); err != nil || e.Code != string(twirp.Internal) {
+			t.Errorf("expected an internal error, got %v, %v", events[0].data, err)
+		}
+
+		described, err := srv.DescribeConversation(context.Background(), &pb.DescribeConversationRequest{ConversationId: conv.ID.Hex()})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if n := len(described.GetConversation().GetMessages()); n != 1 {
+			t.Errorf("expected the failed reply not to be saved, got %d messages", n)
+		}
+	}))
+}
+
+func TestServer_RegenerateReply(t *testing.T) {
+	ctx := context.Background()
+
+	withReply := func(c *model.Conversation) {
+		at := c.CreatedAt.Add(time.Second)
+		c.Messages = append(c.Messages,
+			&model.Message{ID: primitive.NewObjectID(), Role: model.RoleToolCall, CreatedAt: at, UpdatedAt: at,
+				ToolCall: &model.ToolCall{ID: "call_1", Name: "get_weather", Arguments: "{}"}},
+			&model.Message{ID: primitive.NewObjectID(), Role: model.RoleToolResult, Content: "sunny", CreatedAt: at, UpdatedAt: at,
+				ToolCall: &model.ToolCall{ID: "call_1", Name: "get_weather", Result: "sunny"}},
+			&model.Message{ID: primitive.NewObjectID(), Role: model.RoleAssistant, Content: "It is raining.", CreatedAt: at, UpdatedAt: at},
+		)
+	}
+
+	t.Run("last reply is replaced and kept as a version", WithFixture(func(t *testing.T, f *Fixture) {
+		openai := StartFakeOpenAI(t).Reply("It is sunny.")
+		srv := NewServer(f.ConversationStore, assistant.New(assistant.WithBaseURL(openai.URL)))
+
+		c := f.CreateConversation(withReply)
+		previous := c.Messages[3]
+
+		out, err := srv.RegenerateReply(ctx, &pb.RegenerateReplyRequest{ConversationId: c.ID.Hex()})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if out.GetReply() != "It is sunny." || out.GetMessageId() != previous.ID.Hex() {
+			t.Errorf("unexpected response: %v", out)
+		}
+
+		conv, err := srv.DescribeConversation(ctx, &pb.DescribeConversationRequest{ConversationId: c.ID.Hex()})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		msgs := conv.GetConversation().GetMessages()
+		if len(msgs) != 2 || msgs[1].GetId() != previous.ID.Hex() || msgs[1].GetContent() != "It is sunny." {
+			t.Fatalf("expected the tool calls of the previous reply to be dropped along with it, got %v", msgs)
+		}
+
+		if v := msgs[1].GetVersions(); len(v) != 1 || v[0].GetContent() != "It is raining." {
+			t.Errorf("expected previous reply to be kept as a version, got %v", v)
+		}
+
+		if reqs := openai.Requests(); len(reqs[0].Messages) != 2 {
+			t.Errorf("expected only the system prompt and the question to be sent, got %+v", reqs[0].Messages)
+		}
+	}))
+
+	t.Run("conversation without reply can not be regenerated", WithFixture(func(t *testing.T, f *Fixture) {
+		srv := NewServer(f.ConversationStore, nil)
+
+		c := f.CreateConversation()
+
+		_, err := srv.RegenerateReply(ctx, &pb.RegenerateReplyRequest{ConversationId: c.ID.Hex()})
+		if te, ok := err.(twirp.Error); !ok || te.Code() != twirp.FailedPrecondition {
+			t.Fatalf("expected twirp.FailedPrecondition error, got %v", err)
+		}
+	}))
+}
+
+func TestServer_EditMessage(t *testing.T) {
+	ctx := context.Background()
+
+	withTurns := func(c *model.Conversation) {
+		for i, content := range []string{"It is sunny.", "And tomorrow?", "Rainy."} {
+			role := model.RoleAssistant
+			if i%2 == 1 {
+				role = model.RoleUser
+			}
+			c.Messages = append(c.Messages, &model.Message{ID: primitive.NewObjectID(), Role: role, Content: content, CreatedAt: c.CreatedAt, UpdatedAt: c.CreatedAt})
+		}
+	}
+
+	t.Run("edited message gets a new reply and later messages are dropped", WithFixture(func(t *testing.T, f *Fixture) {
+		openai := StartFakeOpenAI(t).Reply("It will be cloudy.")
+		srv := NewServer(f.ConversationStore, assistant.New(assistant.WithBaseURL(openai.URL)))
+
+		c := f.CreateConversation(withTurns)
+		edited := c.Messages[0]
+
+		out, err := srv.EditMessage(ctx, &pb.EditMessageRequest{ConversationId: c.ID.Hex(), MessageId: edited.ID.Hex(), Message: "What is the weather like tomorrow?"})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		conv, err := srv.DescribeConversation(ctx, &pb.DescribeConversationRequest{ConversationId: c.ID.Hex()})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		msgs := conv.GetConversation().GetMessages()
+		if len(msgs) != 2 || msgs[1].GetId() != out.GetMessageId() || msgs[1].GetContent() != "It will be cloudy." {
+			t.Fatalf("expected later messages to be replaced by the new reply, got %v", msgs)
+		}
+
+		if msgs[0].GetId() != edited.ID.Hex() || msgs[0].GetContent() != "What is the weather like tomorrow?" {
+			t.Errorf("expected message to be edited, got %v", msgs[0])
+		}
+
+		if v := msgs[0].GetVersions(); len(v) != 1 || v[0].GetContent() != edited.Content {
+			t.Errorf("expected original message to be kept as a version, got %v", v)
+		}
+
+		if sent := openai.Requests()[0].Messages; sent[len(sent)-1].Content != "What is the weather like tomorrow?" {
+			t.Errorf("expected the edited message to be answered, got %+v", sent)
+		}
+	}))
+
+	t.Run("only user messages can be edited", WithFixture(func(t *testing.T, f *Fixture) {
+		srv := NewServer(f.ConversationStore, nil)
+
+		c := f.CreateConversation(withTurns)
+
+		_, err := srv.EditMessage(ctx, &pb.EditMessageRequest{ConversationId: c.ID.Hex(), MessageId: c.Messages[1].ID.Hex(), Message: "Hi"})
+		if te, ok := err.(twirp.Error); !ok || te.Code() != twirp.InvalidArgument {
+			t.Fatalf("expected twirp.InvalidArgument error, got %v", err)
+		}
+	}))
+
+	t.Run("unknown message is not found", WithFixture(func(t *testing.T, f *Fixture) {
+		srv := NewServer(f.ConversationStore, nil)
+
+		c := f.CreateConversation()
+
+		_, err := srv.EditMessage(ctx, &pb.EditMessageRequest{ConversationId: c.ID.Hex(), MessageId: primitive.NewObjectID().Hex(), Message: "Hi"})
+		if te, ok := err.(twirp.Error); !ok || te.Code() != twirp.NotFound {
+			t.Fatalf("expected twirp.NotFound error, got %v", err)
+		}
+	}))
+}
+
+func TestServer_ForkConversation(t *testing.T) {
+	ctx := context.Background()
+
+	withTurns := func(c *model.Conversation) {
+		for i, content := range []string{"It is sunny.", "And tomorrow?", "Rainy."} {
+			role := model.RoleAssistant
+			if i%2 == 1 {
+				role = model.RoleUser
+			}
+			c.Messages = append(c.Messages, &model.Message{ID: primitive.NewObjectID(), Role: role, Content: content, CreatedAt: c.CreatedAt, UpdatedAt: c.CreatedAt})
+		}
+	}
+
+	t.Run("fork copies messages up to the fork point and is listed on its parent", WithFixture(func(t *testing.T, f *Fixture) {
+		srv := NewServer(f.ConversationStore, nil)
+
+		c := f.CreateConversation(withTurns)
+
+		out, err := srv.ForkConversation(ctx, &pb.ForkConversationRequest{ConversationId: c.ID.Hex(), MessageId: c.Messages[1].ID.Hex()})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		fork := out.GetConversation()
+		f.Cleanup(fork.GetId())
+
+		if fork.GetParentId() != c.ID.Hex() || fork.GetForkPointMessageId() != c.Messages[1].ID.Hex() {
+			t.Errorf("expected fork to reference its parent, got %v", fork)
+		}
+
+		msgs := fork.GetMessages()
+		if len(msgs) != 2 || msgs[0].GetContent() != c.Messages[0].Content || msgs[1].GetContent() != c.Messages[1].Content {
+			t.Fatalf("expected messages up to the fork point, got %v", msgs)
+		}
+
+		if msgs[1].GetId() == c.Messages[1].ID.Hex() {
+			t.Error("expected forked messages to get their own IDs")
+		}
+
+		parent, err := srv.DescribeConversation(ctx, &pb.DescribeConversationRequest{ConversationId: c.ID.Hex()})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if forks := parent.GetForks(); len(forks) != 1 || forks[0].GetId() != fork.GetId() {
+			t.Errorf("expected fork to be listed on its parent, got %v", forks)
+		}
+
+		if got := len(parent.GetConversation().GetMessages()); got != 4 {
+			t.Errorf("expected parent to keep its messages, got %d", got)
+		}
+	}))
+
+	t.Run("fork at unknown message is not found", WithFixture(func(t *testing.T, f *Fixture) {
+		srv := NewServer(f.ConversationStore, nil)
+
+		c := f.CreateConversation()
+
+		_, err := srv.ForkConversation(ctx, &pb.ForkConversationRequest{ConversationId: c.ID.Hex(), MessageId: primitive.NewObjectID().Hex()})
+		if te, ok := err.(twirp.Error); !ok || te.Code() != twirp.NotFound {
+			t.Fatalf("expected twirp.NotFound error, got %v", err)
+		}
+	}))
+}
+
+func TestServer_ConversationSettings(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("settings given on start apply to every reply until they are updated", WithFixture(func(t *testing.T, f *Fixture) {
+		openai := StartFakeOpenAI(t).
+			Title("Pirate weather").
+			Reply("Arr, sunny it be.").
+			Reply("Arr, rainy tomorrow.").
+			Reply("It will be cloudy.")
+		srv := NewServer(f.ConversationStore, assistant.New(assistant.WithBaseURL(openai.URL)))
+
+		temperature := 0.2
+		out, err := srv.StartConversation(ctx, &pb.StartConversationRequest{
+			Message: "What is the weather like?",
+			Settings: &pb.ConversationSettings{
+				SystemPrompt: "You are a pirate.",
+				Model:        "gpt-4o-mini",
+				Temperature:  &temperature,
+				MaxTokens:    100,
+			},
+		})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		f.Cleanup(out.GetConversationId())
+
+		_, err = srv.ContinueConversation(ctx, &pb.ContinueConversationRequest{ConversationId: out.GetConversationId(), Message: "And tomorrow?"})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		_, replies := splitRequests(openai.Requests())
+		for _, req := range replies {
+			if req.Model != "gpt-4o-mini" || req.Temperature == nil || *req.Temperature != 0.2 || req.MaxCompletionTokens != 100 || req.Messages[0].Content != "You are a pirate." {
+				t.Errorf("expected settings to be applied to the reply, got %+v", req)
+			}
+		}
+
+		updated, err := srv.UpdateConversationSettings(ctx, &pb.UpdateConversationSettingsRequest{
+			ConversationId: out.GetConversationId(),
+			Settings:       &pb.ConversationSettings{Model: "gpt-4.1-mini"},
+		})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if got := updated.GetConversation().GetSettings(); got.GetModel() != "gpt-4.1-mini" || got.GetSystemPrompt() != "" || got.Temperature != nil {
+			t.Errorf("expected settings to be replaced, got %v", got)
+		}
+
+		_, err = srv.ContinueConversation(ctx, &pb.ContinueConversationRequest{ConversationId: out.GetConversationId(), Message: "And the day after?"})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		reqs := openai.Requests()
+		if last := reqs[len(reqs)-1]; last.Model != "gpt-4.1-mini" || last.Temperature != nil || last.MaxCompletionTokens != 0 || last.Messages[0].Content == "You are a pirate." {
+			t.Errorf("expected updated settings to be applied, got %+v", last)
+		}
+	}))
+
+	t.Run("invalid settings are rejected", WithFixture(func(t *testing.T, f *Fixture) {
+		srv := NewServer(f.ConversationStore, nil)
+
+		c := f.CreateConversation()
+
+		temperature := 3.0
+		_, err := srv.UpdateConversationSettings(ctx, &pb.UpdateConversationSettingsRequest{
+			ConversationId: c.ID.Hex(),
+			Settings:       &pb.ConversationSettings{Temperature: &temperature},
+		})
+		if te, ok := err.(twirp.Error); !ok || te.Code() != twirp.InvalidArgument {
+			t.Fatalf("expected twirp.InvalidArgument error, got %v", err)
+		}
+
+		_, err = srv.StartConversation(ctx, &pb.StartConversationRequest{
+			Message:  "Hi",
+			Settings: &pb.ConversationSettings{MaxTokens: -1},
+		})
+		if te, ok := err.(twirp.Error); !ok || te.Code() != twirp.InvalidArgument {
+			t.Fatalf("expected twirp.InvalidArgument error, got %v", err)
+		}
+	}))
+}
+
+func TestServer_Usage(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("usage is recorded on replies and the conversation, and reported by day and model", WithFixture(func(t *testing.T, f *Fixture) {
+		openai := StartFakeOpenAI(t).
+			Title("Weather today").WithUsage(1000, 100).
+			Reply("It is sunny.").WithUsage(1000, 50).
+			Reply("It will rain.").WithUsage(2000, 50)
+		srv := NewServer(f.ConversationStore, assistant.New(assistant.WithBaseURL(openai.URL), assistant.WithTitleModel("o1"), assistant.WithReplyModel("gpt-4.1")))
+
+		out, err := srv.StartConversation(ctx, &pb.StartConversationRequest{Message: "What is the weather like?"})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		f.Cleanup(out.GetConversationId())
+		awaitTitle(t, srv, ctx, out.GetConversationId())
+
+		_, err = srv.ContinueConversation(ctx, &pb.ContinueConversationRequest{ConversationId: out.GetConversationId(), Message: "And tomorrow?"})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		got, err := srv.DescribeConversation(ctx, &pb.DescribeConversationRequest{ConversationId: out.GetConversationId()})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		opts := []cmp.Option{protocmp.Transform(), cmpopts.EquateApprox(0, 1e-9)}
+
+		reply := got.GetConversation().GetMessages()[1].GetUsage()
+		if diff := cmp.Diff(&pb.Usage{Model: "gpt-4.1", PromptTokens: 1000, CompletionTokens: 50, Cost: 0.0024}, reply, opts...); diff != "" {
+			t.Errorf("unexpected reply usage (-want +got):\n%s", diff)
+		}
+
+		// o1 title: 0.015 + 0.006, gpt-4.1 replies: 0.002 + 0.0004 + 0.004 + 0.0004
+		total := got.GetConversation().GetUsage()
+		if diff := cmp.Diff(&pb.Usage{PromptTokens: 4000, CompletionTokens: 200, Cost: 0.0278}, total, opts...); diff != "" {
+			t.Errorf("unexpected conversation usage (-want +got):\n%s", diff)
+		}
+
+		report, err := srv.GetUsageReport(ctx, &pb.GetUsageReportRequest{})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		day := time.Now().UTC().Format("2006-01-02")
+		expected := &pb.GetUsageReportResponse{
+			Rows: []*pb.GetUsageReportResponse_Row{
+				{Day: day, Usage: &pb.Usage{Model: "gpt-4.1", PromptTokens: 3000, CompletionTokens: 100, Cost: 0.0068}},
+				{Day: day, Usage: &pb.Usage{Model: "o1", PromptTokens: 1000, CompletionTokens: 100, Cost: 0.021}},
+			},
+			Total: total,
+		}
+		if diff := cmp.Diff(expected, report, opts...); diff != "" {
+			t.Errorf("unexpected report (-want +got):\n%s", diff)
+		}
+	}))
+
+	t.Run("report range must not be empty", WithFixture(func(t *testing.T, f *Fixture) {
+		srv := NewServer(f.ConversationStore, nil)
+
+		now := timestamppb.Now()
+		_, err := srv.GetUsageReport(ctx, &pb.GetUsageReportRequest{StartTime: now, EndTime: now})
+		if te, ok := err.(twirp.Error); !ok || te.Code() != twirp.InvalidArgument {
+			t.Fatalf("expected twirp.InvalidArgument error, got %v", err)
+		}
+	}))
+}
+
+func TestServer_Budget(t *testing.T) {
+	t.Run("replies are refused once the user's budget is exhausted", WithFixture(func(t *testing.T, f *Fixture) {
+		ctx := httpx.WithUser(context.Background(), "alice")
+
+		openai := StartFakeOpenAI(t).
+			Title("Weather today").WithUsage(100, 10).
+			Reply("It is sunny.").WithUsage(800, 100)
+		b := budget.New(f.Counters, budget.WithLimits(budget.Limits{UserDaily: budget.Limit{Tokens: 1000}}))
+		srv := NewServer(f.ConversationStore, assistant.New(assistant.WithBaseURL(openai.URL)), WithBudget(b))
+
+		out, err := srv.StartConversation(ctx, &pb.StartConversationRequest{Message: "What is the weather like?"})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		f.Cleanup(out.GetConversationId())
+		// the title is charged once generated
+		awaitTitle(t, srv, ctx, out.GetConversationId())
+
+		_, err = srv.ContinueConversation(ctx, &pb.ContinueConversationRequest{ConversationId: out.GetConversationId(), Message: "And tomorrow?"})
+		te, ok := err.(twirp.Error)
+		if !ok || te.Code() != twirp.ResourceExhausted {
+			t.Fatalf("expected twirp.ResourceExhausted error, got %v", err)
+		}
+
+		if te.Meta("budget") != "user_daily" || te.Meta("user_daily_remaining_tokens") != "0" {
+			t.Errorf("unexpected error metadata %v", te.MetaMap())
+		}
+
+		if n := len(openai.Requests()); n != 2 {
+			t.Errorf("expected the model not to be called once the budget is exhausted, got %d requests", n)
+		}
+
+		openai.Title("Greeting").Reply("Hello!")
+		other, err := srv.StartConversation(httpx.WithUser(context.Background(), "bob"), &pb.StartConversationRequest{Message: "Hi"})
+		if err != nil {
+			t.Fatalf("expected other users to keep their budget, got %v", err)
+		}
+		f.Cleanup(other.GetConversationId())
+	}))
+
+	t.Run("usage of failed replies and replies awaiting approval is charged", WithFixture(func(t *testing.T, f *Fixture) {
+		registerBookingTool(t)
+		ctx := httpx.WithUser(context.Background(), "alice")
+		limits := budget.Limits{UserDaily: budget.Limit{Tokens: 1000}}
+		owned := func(c *model.Conversation) { c.OwnerID = "alice" }
+
+		for name, openai := range map[string]*FakeOpenAI{
+			"failed": StartFakeOpenAI(t).
+				CallTools(FakeToolCall{ID: "call_1", Name: "get_today_date", Arguments: "{}"}).WithUsage(900, 100).
+				Fail(http.StatusBadRequest, "invalid request"),
+			"awaiting approval": StartFakeOpenAI(t).
+				CallTools(FakeToolCall{ID: "call_1", Name: "book_hotel", Arguments: `{"city": "Barcelona"}`}).WithUsage(900, 100),
+		} {
+			conv := f.CreateConversation(owned)
+			srv := NewServer(f.ConversationStore, assistant.New(assistant.WithBaseURL(openai.URL)), WithBudget(budget.New(model.NewMemoryStore(), budget.WithLimits(limits))))
+
+			_, _ = srv.ContinueConversation(ctx, &pb.ContinueConversationRequest{ConversationId: conv.ID.Hex(), Message: "Book me a hotel for today"})
+
+			_, err := srv.ContinueConversation(ctx, &pb.ContinueConversationRequest{ConversationId: f.CreateConversation(owned).ID.Hex(), Message: "Hi"})
+			if te, ok := err.(twirp.Error); !ok || te.Code() != twirp.ResourceExhausted {
+				t.Errorf("%s: expected twirp.ResourceExhausted error, got %v", name, err)
+			}
+		}
+	}))
+}
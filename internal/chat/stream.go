@@ -0,0 +1,184 @@
+package chat
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"sync"
+
+	"github.com/acai-travel/tech-challenge/internal/chat/assistant"
+	"github.com/acai-travel/tech-challenge/internal/pb"
+	"github.com/twitchtv/twirp"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+// StreamPathPrefix is where the streaming endpoints are mounted.
+const StreamPathPrefix = "/stream/"
+
+// Names of the server-sent events produced by the streaming endpoints, next to the assistant.EventType values.
+const (
+	streamEventDone  = "done"
+	streamEventError = "error"
+)
+
+// StreamHandler serves streaming variants of the RPCs generating a reply as server-sent events:
+//
+//	POST /stream/StartConversation
+//	POST /stream/ContinueConversation
+//	POST /stream/RegenerateReply
+//	POST /stream/EditMessage
+//	POST /stream/ApproveToolCall
+//	POST /stream/RejectToolCall
+//
+// They accept the same JSON body as their Twirp counterparts. While the reply is generated the handler pushes
+// "delta", "tool_call_started" and "tool_call_finished" events, "tool_call_approval_required" for the tool calls the
+// reply stops at to await approval, and "title_updated" if the title of a new conversation is generated before the
+// reply. It finishes with a "done" event holding the regular Twirp response (including the persisted message ID and
+// whether the title is still pending), or an "error" event with a Twirp error. Retries of idempotent requests share the
+// responses of their Twirp counterparts, a replayed response only produces the "done" event.
+func (s *Server) StreamHandler() http.Handler {
+	mux := http.NewServeMux()
+
+	mux.HandleFunc("POST "+StreamPathPrefix+"StartConversation", func(w http.ResponseWriter, r *http.Request) {
+		var req pb.StartConversationRequest
+		serveStream(w, r, "StreamStartConversation", &req, func(ctx context.Context, emit func(assistant.Event)) (proto.Message, error) {
+			return idempotent(ctx, s, "StartConversation", &req, func(ctx context.Context) (*pb.StartConversationResponse, error) {
+				return s.startConversation(ctx, &req, emit)
+			})
+		})
+	})
+
+	mux.HandleFunc("POST "+StreamPathPrefix+"ContinueConversation", func(w http.ResponseWriter, r *http.Request) {
+		var req pb.ContinueConversationRequest
+		serveStream(w, r, "StreamContinueConversation", &req, func(ctx context.Context, emit func(assistant.Event)) (proto.Message, error) {
+			return idempotent(ctx, s, "ContinueConversation", &req, func(ctx context.Context) (*pb.ContinueConversationResponse, error) {
+				return s.continueConversation(ctx, &req, emit)
+			})
+		})
+	})
+
+	mux.HandleFunc("POST "+StreamPathPrefix+"RegenerateReply", func(w http.ResponseWriter, r *http.Request) {
+		var req pb.RegenerateReplyRequest
+		serveStream(w, r, "StreamRegenerateReply", &req, func(ctx context.Context, emit func(assistant.Event)) (proto.Message, error) {
+			return s.regenerateReply(ctx, &req, emit)
+		})
+	})
+
+	mux.HandleFunc("POST "+StreamPathPrefix+"EditMessage", func(w http.ResponseWriter, r *http.Request) {
+		var req pb.EditMessageRequest
+		serveStream(w, r, "StreamEditMessage", &req, func(ctx context.Context, emit func(assistant.Event)) (proto.Message, error) {
+			return s.editMessage(ctx, &req, emit)
+		})
+	})
+
+	mux.HandleFunc("POST "+StreamPathPrefix+"ApproveToolCall", func(w http.ResponseWriter, r *http.Request) {
+		var req pb.ApproveToolCallRequest
+		serveStream(w, r, "StreamApproveToolCall", &req, func(ctx context.Context, emit func(assistant.Event)) (proto.Message, error) {
+			return s.approveToolCall(ctx, &req, emit)
+		})
+	})
+
+	mux.HandleFunc("POST "+StreamPathPrefix+"RejectToolCall", func(w http.ResponseWriter, r *http.Request) {
+		var req pb.RejectToolCallRequest
+		serveStream(w, r, "StreamRejectToolCall", &req, func(ctx context.Context, emit func(assistant.Event)) (proto.Message, error) {
+			return s.rejectToolCall(ctx, &req, emit)
+		})
+	})
+
+	return mux
+}
+
+// serveStream decodes the request into req and runs fn, forwarding its events to the client as they arrive.
+func serveStream(w http.ResponseWriter, r *http.Request, method string, req proto.Message, fn func(ctx context.Context, emit func(assistant.Event)) (proto.Message, error)) {
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		_ = twirp.WriteError(w, twirp.InternalErrorWith(err))
+		return
+	}
+
+	if err := protojson.Unmarshal(body, req); err != nil {
+		_ = twirp.WriteError(w, twirp.NewError(twirp.Malformed, "the json request could not be decoded"))
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+	w.WriteHeader(http.StatusOK)
+
+	sse := &eventWriter{w: w, rc: http.NewResponseController(w)}
+
+	_, _ = instrument(r.Context(), method, func(ctx context.Context) (any, error) {
+		resp, err := fn(ctx, func(e assistant.Event) {
+			sse.send(ctx, string(e.Type), e)
+		})
+
+		if err != nil {
+			terr, ok := err.(twirp.Error)
+			if !ok {
+				terr = twirp.InternalErrorWith(err)
+			}
+
+			sse.finish(ctx, streamEventError, map[string]string{"code": string(terr.Code()), "msg": terr.Msg()})
+			return nil, err
+		}
+
+		data, err := protojson.Marshal(resp)
+		if err != nil {
+			return nil, err
+		}
+
+		sse.finish(ctx, streamEventDone, json.RawMessage(data))
+		return resp, nil
+	})
+}
+
+// eventWriter writes server-sent events, flushing each one so it reaches the client right away. It may be used
+// concurrently, events sent after the final one are dropped as the response is over by then.
+type eventWriter struct {
+	mu       sync.Mutex
+	finished bool
+	w        io.Writer
+	rc       *http.ResponseController
+}
+
+func (e *eventWriter) send(ctx context.Context, event string, data any) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	e.write(ctx, event, data)
+}
+
+// finish sends the final event of the stream.
+func (e *eventWriter) finish(ctx context.Context, event string, data any) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	e.write(ctx, event, data)
+	e.finished = true
+}
+
+func (e *eventWriter) write(ctx context.Context, event string, data any) {
+	if e.finished {
+		return
+	}
+
+	payload, err := json.Marshal(data)
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to encode stream event", "event", event, "error", err)
+		return
+	}
+
+	if _, err := fmt.Fprintf(e.w, "event: %s\ndata: %s\n\n", event, payload); err != nil {
+		slog.WarnContext(ctx, "Failed to write stream event", "event", event, "error", err)
+		return
+	}
+
+	if err := e.rc.Flush(); err != nil {
+		slog.WarnContext(ctx, "Failed to flush stream event", "event", event, "error", err)
+	}
+}
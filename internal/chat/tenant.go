@@ -0,0 +1,237 @@
+package chat
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/acai-travel/tech-challenge/internal/chat/model"
+	"github.com/acai-travel/tech-challenge/internal/chat/tools"
+	"github.com/acai-travel/tech-challenge/internal/httpx"
+	"github.com/acai-travel/tech-challenge/internal/pb"
+	"github.com/twitchtv/twirp"
+)
+
+var _ pb.TenantService = (*TenantServer)(nil)
+
+// tenantID restricts tenant IDs to what can be safely sent in a header or a token claim.
+var tenantID = regexp.MustCompile(`^[a-z0-9][a-z0-9-]{0,62}$`)
+
+// TenantServer manages the tenants sharing the deployment. Only admins who are not bound to a tenant may call it, see
+// httpx.RoleAdmin, unless authentication is disabled, see httpx.NoAuth.
+type TenantServer struct {
+	repo model.TenantStore
+}
+
+func NewTenantServer(repo model.TenantStore) *TenantServer {
+	return &TenantServer{repo: repo}
+}
+
+func (s *TenantServer) CreateTenant(ctx context.Context, req *pb.CreateTenantRequest) (*pb.CreateTenantResponse, error) {
+	result, err := instrument(ctx, "CreateTenant", func(ctx context.Context) (any, error) {
+		if err := requireDeploymentAdmin(ctx); err != nil {
+			return nil, err
+		}
+
+		tenant, err := tenantFromProto(req.GetTenant())
+		if err != nil {
+			return nil, err
+		}
+
+		tenant.CreatedAt = tenant.UpdatedAt
+
+		if err := s.repo.CreateTenant(ctx, tenant); err != nil {
+			return nil, err
+		}
+
+		return &pb.CreateTenantResponse{Tenant: tenant.Proto()}, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*pb.CreateTenantResponse), nil
+}
+
+func (s *TenantServer) ListTenants(ctx context.Context, req *pb.ListTenantsRequest) (*pb.ListTenantsResponse, error) {
+	result, err := instrument(ctx, "ListTenants", func(ctx context.Context) (any, error) {
+		if err := requireDeploymentAdmin(ctx); err != nil {
+			return nil, err
+		}
+
+		tenants, err := s.repo.ListTenants(ctx)
+		if err != nil {
+			return nil, twirp.InternalErrorWith(err)
+		}
+
+		resp := &pb.ListTenantsResponse{}
+		for _, tenant := range tenants {
+			resp.Tenants = append(resp.Tenants, tenant.Proto())
+		}
+
+		return resp, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*pb.ListTenantsResponse), nil
+}
+
+func (s *TenantServer) DescribeTenant(ctx context.Context, req *pb.DescribeTenantRequest) (*pb.DescribeTenantResponse, error) {
+	result, err := instrument(ctx, "DescribeTenant", func(ctx context.Context) (any, error) {
+		if err := requireDeploymentAdmin(ctx); err != nil {
+			return nil, err
+		}
+
+		if req.GetTenantId() == "" {
+			return nil, twirp.RequiredArgumentError("tenant_id")
+		}
+
+		tenant, err := s.repo.DescribeTenant(ctx, req.GetTenantId())
+		if err != nil {
+			return nil, err
+		}
+
+		return &pb.DescribeTenantResponse{Tenant: tenant.Proto()}, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*pb.DescribeTenantResponse), nil
+}
+
+func (s *TenantServer) UpdateTenant(ctx context.Context, req *pb.UpdateTenantRequest) (*pb.UpdateTenantResponse, error) {
+	result, err := instrument(ctx, "UpdateTenant", func(ctx context.Context) (any, error) {
+		if err := requireDeploymentAdmin(ctx); err != nil {
+			return nil, err
+		}
+
+		if req.GetTenant().GetId() == "" {
+			return nil, twirp.RequiredArgumentError("tenant.id")
+		}
+
+		existing, err := s.repo.DescribeTenant(ctx, req.GetTenant().GetId())
+		if err != nil {
+			return nil, err
+		}
+
+		tenant, err := tenantFromProto(req.GetTenant())
+		if err != nil {
+			return nil, err
+		}
+
+		tenant.CreatedAt = existing.CreatedAt
+
+		if err := s.repo.UpdateTenant(ctx, tenant); err != nil {
+			return nil, err
+		}
+
+		return &pb.UpdateTenantResponse{Tenant: tenant.Proto()}, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*pb.UpdateTenantResponse), nil
+}
+
+func (s *TenantServer) DeleteTenant(ctx context.Context, req *pb.DeleteTenantRequest) (*pb.DeleteTenantResponse, error) {
+	result, err := instrument(ctx, "DeleteTenant", func(ctx context.Context) (any, error) {
+		if err := requireDeploymentAdmin(ctx); err != nil {
+			return nil, err
+		}
+
+		if req.GetTenantId() == "" {
+			return nil, twirp.RequiredArgumentError("tenant_id")
+		}
+
+		if err := s.repo.DeleteTenant(ctx, req.GetTenantId()); err != nil {
+			return nil, err
+		}
+
+		return &pb.DeleteTenantResponse{}, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*pb.DeleteTenantResponse), nil
+}
+
+// requireAdmin fails with twirp.PermissionDenied unless the caller is an admin, who may manage what. Anonymous callers
+// are only let through when authentication is disabled, see httpx.AuthDisabled, and fail with twirp.Unauthenticated
+// otherwise.
+func requireAdmin(ctx context.Context, what string) error {
+	id, ok := httpx.IdentityFrom(ctx)
+	switch {
+	case ok && id.HasRole(httpx.RoleAdmin):
+		return nil
+	case ok:
+		return twirp.NewError(twirp.PermissionDenied, "only admins can manage "+what)
+	case httpx.AuthDisabled(ctx):
+		return nil
+	default:
+		return twirp.NewError(twirp.Unauthenticated, "credentials are required to manage "+what)
+	}
+}
+
+// requireDeploymentAdmin is requireAdmin for what is shared by every tenant, like the tenants themselves. Admins bound
+// to a tenant fail with twirp.PermissionDenied, they would otherwise reach into the other tenants.
+func requireDeploymentAdmin(ctx context.Context) error {
+	if err := requireAdmin(ctx, "tenants"); err != nil {
+		return err
+	}
+
+	if id, ok := httpx.IdentityFrom(ctx); ok && id.Tenant != "" {
+		return twirp.NewError(twirp.PermissionDenied, "admins of a tenant can not manage tenants")
+	}
+	return nil
+}
+
+// tenantFromProto validates the tenant of a request, its creation time is left to the caller.
+func tenantFromProto(t *pb.Tenant) (*model.Tenant, error) {
+	tenant := &model.Tenant{
+		ID:           strings.TrimSpace(t.GetId()),
+		Name:         strings.TrimSpace(t.GetName()),
+		Model:        strings.TrimSpace(t.GetModel()),
+		SystemPrompt: strings.TrimSpace(t.GetSystemPrompt()),
+		UpdatedAt:    time.Now(),
+	}
+
+	if tenant.ID == "" {
+		return nil, twirp.RequiredArgumentError("tenant.id")
+	}
+
+	if !tenantID.MatchString(tenant.ID) {
+		return nil, twirp.InvalidArgumentError("tenant.id", "must be lowercase letters, digits and dashes")
+	}
+
+	if tenant.Name == "" {
+		return nil, twirp.RequiredArgumentError("tenant.name")
+	}
+
+	for _, name := range t.GetTools() {
+		if _, ok := tools.Registry[name]; !ok {
+			return nil, twirp.InvalidArgumentError("tenant.tools", fmt.Sprintf("unknown tool %q", name))
+		}
+		tenant.Tools = append(tenant.Tools, name)
+	}
+
+	budgets := t.GetBudgets()
+	for _, b := range []struct {
+		field string
+		proto *pb.Budget
+		limit *model.Limit
+	}{
+		{"user_daily", budgets.GetUserDaily(), &tenant.Budgets.UserDaily},
+		{"user_monthly", budgets.GetUserMonthly(), &tenant.Budgets.UserMonthly},
+		{"daily", budgets.GetDaily(), &tenant.Budgets.Daily},
+		{"monthly", budgets.GetMonthly(), &tenant.Budgets.Monthly},
+	} {
+		if b.proto.GetTokens() < 0 || b.proto.GetCost() < 0 {
+			return nil, twirp.InvalidArgumentError("tenant.budgets."+b.field, "must not be negative")
+		}
+		*b.limit = model.Limit{Tokens: int(b.proto.GetTokens()), Cost: b.proto.GetCost()}
+	}
+
+	return tenant, nil
+}
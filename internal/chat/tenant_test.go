@@ -0,0 +1,263 @@
+package chat
+
+import (
+	"context"
+	"slices"
+	"testing"
+
+	"github.com/acai-travel/tech-challenge/internal/chat/assistant"
+	"github.com/acai-travel/tech-challenge/internal/chat/budget"
+	"github.com/acai-travel/tech-challenge/internal/chat/model"
+	. "github.com/acai-travel/tech-challenge/internal/chat/testing"
+	"github.com/acai-travel/tech-challenge/internal/httpx"
+	"github.com/acai-travel/tech-challenge/internal/pb"
+	"github.com/twitchtv/twirp"
+)
+
+func TestTenantServer(t *testing.T) {
+	admin := httpx.WithIdentity(context.Background(), httpx.Identity{User: "root", Roles: []string{httpx.RoleAdmin}})
+
+	t.Run("create, update and delete tenant", WithFixture(func(t *testing.T, f *Fixture) {
+		srv := NewTenantServer(f.Tenants)
+
+		created, err := srv.CreateTenant(admin, &pb.CreateTenantRequest{Tenant: &pb.Tenant{
+			Id:      "sales",
+			Name:    "Sales",
+			Tools:   []string{"get_weather"},
+			Budgets: &pb.TenantBudgets{UserDaily: &pb.Budget{Cost: 1}},
+		}})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if got := created.GetTenant(); got.GetTimestamp() == nil || got.GetBudgets().GetUserDaily().GetCost() != 1 {
+			t.Fatalf("expected tenant to get a timestamp and its budgets, got %v", got)
+		}
+
+		_, err = srv.CreateTenant(admin, &pb.CreateTenantRequest{Tenant: &pb.Tenant{Id: "sales", Name: "Other sales"}})
+		if te, ok := err.(twirp.Error); !ok || te.Code() != twirp.AlreadyExists {
+			t.Fatalf("expected twirp.AlreadyExists error, got %v", err)
+		}
+
+		updated, err := srv.UpdateTenant(admin, &pb.UpdateTenantRequest{Tenant: &pb.Tenant{Id: "sales", Name: "Sales", Model: "gpt-4o-mini"}})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if got := updated.GetTenant(); got.GetModel() != "gpt-4o-mini" || len(got.GetTools()) != 0 {
+			t.Errorf("expected tenant to be replaced, got %v", got)
+		}
+
+		list, err := srv.ListTenants(admin, &pb.ListTenantsRequest{})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if len(list.GetTenants()) != 1 || list.GetTenants()[0].GetModel() != "gpt-4o-mini" {
+			t.Errorf("expected the updated tenant to be listed, got %v", list.GetTenants())
+		}
+
+		if _, err := srv.DeleteTenant(admin, &pb.DeleteTenantRequest{TenantId: "sales"}); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		_, err = srv.DescribeTenant(admin, &pb.DescribeTenantRequest{TenantId: "sales"})
+		if te, ok := err.(twirp.Error); !ok || te.Code() != twirp.NotFound {
+			t.Fatalf("expected twirp.NotFound error, got %v", err)
+		}
+	}))
+
+	t.Run("only admins manage tenants", WithFixture(func(t *testing.T, f *Fixture) {
+		srv := NewTenantServer(f.Tenants)
+		tenant := f.CreateTenant()
+
+		_, err := srv.DescribeTenant(httpx.WithUser(context.Background(), "alice"), &pb.DescribeTenantRequest{TenantId: tenant.ID})
+		if te, ok := err.(twirp.Error); !ok || te.Code() != twirp.PermissionDenied {
+			t.Fatalf("expected twirp.PermissionDenied error, got %v", err)
+		}
+	}))
+
+	t.Run("admins of a tenant can not manage tenants", WithFixture(func(t *testing.T, f *Fixture) {
+		srv := NewTenantServer(f.Tenants)
+		own, other := f.CreateTenant(), f.CreateTenant()
+		ctx := httpx.WithIdentity(context.Background(), httpx.Identity{User: "root", Tenant: own.ID, Roles: []string{httpx.RoleAdmin}})
+
+		for name, call := range map[string]func() error{
+			"list": func() error {
+				_, err := srv.ListTenants(ctx, &pb.ListTenantsRequest{})
+				return err
+			},
+			"describe": func() error {
+				_, err := srv.DescribeTenant(ctx, &pb.DescribeTenantRequest{TenantId: other.ID})
+				return err
+			},
+			"update": func() error {
+				_, err := srv.UpdateTenant(ctx, &pb.UpdateTenantRequest{Tenant: &pb.Tenant{Id: other.ID, Name: "Taken over"}})
+				return err
+			},
+			"delete": func() error {
+				_, err := srv.DeleteTenant(ctx, &pb.DeleteTenantRequest{TenantId: other.ID})
+				return err
+			},
+		} {
+			err := call()
+			if te, ok := err.(twirp.Error); !ok || te.Code() != twirp.PermissionDenied {
+				t.Errorf("%s: expected twirp.PermissionDenied error, got %v", name, err)
+			}
+		}
+
+		if got, err := srv.DescribeTenant(admin, &pb.DescribeTenantRequest{TenantId: other.ID}); err != nil || got.GetTenant().GetName() != other.Name {
+			t.Errorf("expected the other tenant to be left untouched, got %v, %v", got, err)
+		}
+	}))
+
+	t.Run("anonymous callers manage tenants only without authentication", WithFixture(func(t *testing.T, f *Fixture) {
+		srv := NewTenantServer(f.Tenants)
+		tenant := f.CreateTenant()
+
+		_, err := srv.DescribeTenant(context.Background(), &pb.DescribeTenantRequest{TenantId: tenant.ID})
+		if te, ok := err.(twirp.Error); !ok || te.Code() != twirp.Unauthenticated {
+			t.Fatalf("expected twirp.Unauthenticated error, got %v", err)
+		}
+
+		if _, err := srv.DescribeTenant(httpx.WithAuthDisabled(context.Background()), &pb.DescribeTenantRequest{TenantId: tenant.ID}); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}))
+
+	t.Run("invalid tenants are rejected", WithFixture(func(t *testing.T, f *Fixture) {
+		srv := NewTenantServer(f.Tenants)
+
+		for name, tenant := range map[string]*pb.Tenant{
+			"missing id":      {Name: "Sales"},
+			"invalid id":      {Id: "Sales Team", Name: "Sales"},
+			"missing name":    {Id: "sales"},
+			"unknown tool":    {Id: "sales", Name: "Sales", Tools: []string{"rm_rf"}},
+			"negative budget": {Id: "sales", Name: "Sales", Budgets: &pb.TenantBudgets{Daily: &pb.Budget{Tokens: -1}}},
+		} {
+			_, err := srv.CreateTenant(admin, &pb.CreateTenantRequest{Tenant: tenant})
+			if te, ok := err.(twirp.Error); !ok || te.Code() != twirp.InvalidArgument {
+				t.Errorf("%s: expected twirp.InvalidArgument error, got %v", name, err)
+			}
+		}
+	}))
+}
+
+func TestServer_Tenants(t *testing.T) {
+	t.Run("tenant sets the prompt, model and tools of replies", WithFixture(func(t *testing.T, f *Fixture) {
+		tenant := f.CreateTenant(func(t *model.Tenant) {
+			t.Model = "gpt-4o-mini"
+			t.SystemPrompt = "You help the sales team."
+			t.Tools = []string{"get_weather"}
+		})
+		ctx := httpx.WithTenant(context.Background(), tenant.ID)
+
+		openai := StartFakeOpenAI(t).Title("Weather today").Reply("It is sunny.")
+		srv := NewServer(f.ConversationStore, assistant.New(assistant.WithBaseURL(openai.URL)), WithTenants(f.Tenants))
+
+		out, err := srv.StartConversation(ctx, &pb.StartConversationRequest{Message: "What is the weather like?"})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		f.Cleanup(out.GetConversationId())
+
+		_, replies := splitRequests(openai.Requests())
+		reply := replies[len(replies)-1]
+
+		if reply.Model != "gpt-4o-mini" || reply.Messages[0].Content != "You help the sales team." {
+			t.Errorf("expected tenant to be applied to the reply, got %+v", reply)
+		}
+
+		var tools []string
+		for _, tool := range reply.Tools {
+			tools = append(tools, tool.Function.Name)
+		}
+		if !slices.Equal(tools, []string{"get_weather"}) {
+			t.Errorf("expected only the tenant's tools to be offered, got %v", tools)
+		}
+
+		conv, err := srv.DescribeConversation(ctx, &pb.DescribeConversationRequest{ConversationId: out.GetConversationId()})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if conv.GetConversation().GetTenantId() != tenant.ID {
+			t.Errorf("expected conversation to belong to the tenant, got %q", conv.GetConversation().GetTenantId())
+		}
+	}))
+
+	t.Run("tenants can not read each other's conversations", WithFixture(func(t *testing.T, f *Fixture) {
+		sales, support := f.CreateTenant(), f.CreateTenant()
+		srv := NewServer(f.ConversationStore, nil, WithTenants(f.Tenants))
+
+		c := f.CreateConversation(func(c *model.Conversation) { c.TenantID = sales.ID })
+
+		for name, ctx := range map[string]context.Context{
+			"other tenant":   httpx.WithTenant(context.Background(), support.ID),
+			"outside tenant": context.Background(),
+		} {
+			_, err := srv.DescribeConversation(ctx, &pb.DescribeConversationRequest{ConversationId: c.ID.Hex()})
+			if te, ok := err.(twirp.Error); !ok || te.Code() != twirp.NotFound {
+				t.Errorf("%s: expected twirp.NotFound error, got %v", name, err)
+			}
+
+			list, err := srv.ListConversations(ctx, &pb.ListConversationsRequest{TitlePrefix: c.Title})
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if len(list.GetConversations()) != 0 {
+				t.Errorf("%s: expected no conversations listed, got %v", name, list.GetConversations())
+			}
+
+			_, err = srv.DeleteConversation(ctx, &pb.DeleteConversationRequest{ConversationId: c.ID.Hex()})
+			if te, ok := err.(twirp.Error); !ok || te.Code() != twirp.NotFound {
+				t.Errorf("%s: expected twirp.NotFound error, got %v", name, err)
+			}
+		}
+
+		if _, err := srv.DescribeConversation(httpx.WithTenant(context.Background(), sales.ID), &pb.DescribeConversationRequest{ConversationId: c.ID.Hex()}); err != nil {
+			t.Fatalf("expected the tenant to read its conversation, got %v", err)
+		}
+	}))
+
+	t.Run("unknown tenant is not found", WithFixture(func(t *testing.T, f *Fixture) {
+		srv := NewServer(f.ConversationStore, nil, WithTenants(f.Tenants))
+
+		ctx := httpx.WithTenant(context.Background(), "nobody")
+
+		_, err := srv.StartConversation(ctx, &pb.StartConversationRequest{Message: "Hi"})
+		if te, ok := err.(twirp.Error); !ok || te.Code() != twirp.NotFound {
+			t.Fatalf("expected twirp.NotFound error, got %v", err)
+		}
+
+		// e.g. the tenant was deleted since
+		c := f.CreateConversation(func(c *model.Conversation) { c.TenantID = "nobody" })
+
+		_, err = srv.ContinueConversation(ctx, &pb.ContinueConversationRequest{ConversationId: c.ID.Hex(), Message: "Hi"})
+		if te, ok := err.(twirp.Error); !ok || te.Code() != twirp.NotFound {
+			t.Fatalf("expected twirp.NotFound error, got %v", err)
+		}
+	}))
+
+	t.Run("tenant budget is enforced", WithFixture(func(t *testing.T, f *Fixture) {
+		tenant := f.CreateTenant(func(t *model.Tenant) { t.Budgets.Daily = model.Limit{Tokens: 100} })
+		ctx := httpx.WithTenant(httpx.WithUser(context.Background(), "alice"), tenant.ID)
+
+		openai := StartFakeOpenAI(t).Title("Weather today").WithUsage(10, 5).Reply("It is sunny.").WithUsage(80, 10)
+		b := budget.New(f.Counters, budget.WithLimits(budget.Limits{}))
+		srv := NewServer(f.ConversationStore, assistant.New(assistant.WithBaseURL(openai.URL)), WithTenants(f.Tenants), WithBudget(b))
+
+		out, err := srv.StartConversation(ctx, &pb.StartConversationRequest{Message: "What is the weather like?"})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		f.Cleanup(out.GetConversationId())
+		// the title is charged once generated
+		awaitTitle(t, srv, ctx, out.GetConversationId())
+
+		_, err = srv.StartConversation(httpx.WithTenant(httpx.WithUser(context.Background(), "bob"), tenant.ID), &pb.StartConversationRequest{Message: "Hi"})
+		if te, ok := err.(twirp.Error); !ok || te.Code() != twirp.ResourceExhausted || te.Meta("budget") != "tenant_daily" {
+			t.Fatalf("expected twirp.ResourceExhausted error for the tenant budget, got %v", err)
+		}
+	}))
+}
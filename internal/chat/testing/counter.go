@@ -0,0 +1,44 @@
+package testing
+
+import (
+	"context"
+	"testing"
+
+	"github.com/acai-travel/tech-challenge/internal/chat/model"
+	"github.com/google/uuid"
+)
+
+// RunUsageCounterStoreContract checks that a model.UsageCounterStore implementation behaves like the others, see
+// RunConversationStoreContract. Keys are unique to every run, so a shared database can be used.
+func RunUsageCounterStoreContract(t *testing.T, store model.UsageCounterStore) {
+	ctx := context.Background()
+
+	t.Run("increments add up per key", func(t *testing.T) {
+		a, b, unknown := uuid.New().String(), uuid.New().String(), uuid.New().String()
+
+		if err := store.IncrementUsage(ctx, model.Usage{Model: "gpt-4.1", PromptTokens: 100, CompletionTokens: 10, Cost: 0.5}, a, b); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if err := store.IncrementUsage(ctx, model.Usage{PromptTokens: 50, CompletionTokens: 5, Cost: 0.25}, a); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		counted, err := store.CountedUsage(ctx, a, b, unknown)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if got := counted[a]; got != (model.Usage{PromptTokens: 150, CompletionTokens: 15, Cost: 0.75}) {
+			t.Errorf("unexpected usage of %s: %+v", a, got)
+		}
+
+		if got := counted[b]; got != (model.Usage{PromptTokens: 100, CompletionTokens: 10, Cost: 0.5}) {
+			t.Errorf("unexpected usage of %s: %+v", b, got)
+		}
+
+		if got, ok := counted[unknown]; ok {
+			t.Errorf("expected unknown key to be left out, got %+v", got)
+		}
+	})
+}
@@ -10,15 +10,21 @@ import (
 	"go.mongodb.org/mongo-driver/bson/primitive"
 )
 
+// Fixture provides fresh in-memory stores to a test, and helpers to populate them.
 type Fixture struct {
-	*model.Repository
-	test   *testing.T
-	defers []func()
+	model.ConversationStore
+	Personas    model.PersonaStore
+	Tenants     model.TenantStore
+	Counters    model.UsageCounterStore
+	Idempotency model.IdempotencyStore
+	test        *testing.T
+	defers      []func()
 }
 
 func WithFixture(runner func(t *testing.T, f *Fixture)) func(t *testing.T) {
 	return func(t *testing.T) {
-		f := &Fixture{Repository: model.New(ConnectMongo()), test: t}
+		store := model.NewMemoryStore()
+		f := &Fixture{ConversationStore: store, Personas: store, Tenants: store, Counters: store, Idempotency: store, test: t}
 		defer f.Teardown()
 		runner(t, f)
 	}
@@ -45,12 +51,12 @@ func (f *Fixture) CreateConversation(mods ...func(*model.Conversation)) *model.C
 
 	ctx := context.Background()
 
-	if err := f.Repository.CreateConversation(ctx, c); err != nil {
+	if err := f.ConversationStore.CreateConversation(ctx, c); err != nil {
 		f.test.Fatalf("failed to create conversation: %v", err)
 	}
 
 	f.defers = append(f.defers, func() {
-		if err := f.Repository.DeleteConversation(ctx, c.ID.Hex()); err != nil {
+		if err := f.ConversationStore.DeleteConversation(ctx, c.ID.Hex()); err != nil {
 			f.test.Logf("failed to cleanup conversation %s: %v", c.ID.Hex(), err)
 		}
 	})
@@ -58,6 +64,70 @@ func (f *Fixture) CreateConversation(mods ...func(*model.Conversation)) *model.C
 	return c
 }
 
+// CreatePersona creates a persona allowed to call the given tools, with a unique name.
+func (f *Fixture) CreatePersona(systemPrompt string, tools ...string) *model.Persona {
+	p := &model.Persona{
+		ID:           primitive.NewObjectID(),
+		Name:         uuid.New().String(),
+		SystemPrompt: systemPrompt,
+		Tools:        tools,
+		CreatedAt:    time.Date(2023, 10, 1, 0, 0, 0, 0, time.UTC),
+		UpdatedAt:    time.Date(2023, 10, 1, 0, 0, 0, 0, time.UTC),
+	}
+
+	ctx := context.Background()
+
+	if err := f.Personas.CreatePersona(ctx, p); err != nil {
+		f.test.Fatalf("failed to create persona: %v", err)
+	}
+
+	f.defers = append(f.defers, func() {
+		if err := f.Personas.DeletePersona(ctx, p.ID.Hex()); err != nil {
+			f.test.Logf("failed to cleanup persona %s: %v", p.ID.Hex(), err)
+		}
+	})
+
+	return p
+}
+
+// CreateTenant creates a tenant with a unique ID, modified by mods.
+func (f *Fixture) CreateTenant(mods ...func(*model.Tenant)) *model.Tenant {
+	t := &model.Tenant{
+		ID:        uuid.New().String(),
+		Name:      "Sales",
+		CreatedAt: time.Date(2023, 10, 1, 0, 0, 0, 0, time.UTC),
+		UpdatedAt: time.Date(2023, 10, 1, 0, 0, 0, 0, time.UTC),
+	}
+
+	for _, mod := range mods {
+		mod(t)
+	}
+
+	ctx := context.Background()
+
+	if err := f.Tenants.CreateTenant(ctx, t); err != nil {
+		f.test.Fatalf("failed to create tenant: %v", err)
+	}
+
+	f.defers = append(f.defers, func() {
+		if err := f.Tenants.DeleteTenant(ctx, t.ID); err != nil {
+			f.test.Logf("failed to cleanup tenant %s: %v", t.ID, err)
+		}
+	})
+
+	return t
+}
+
+// Cleanup deletes the conversation with the given ID once the test is done, for conversations not created through
+// the fixture.
+func (f *Fixture) Cleanup(id string) {
+	f.defers = append(f.defers, func() {
+		if err := f.ConversationStore.DeleteConversation(context.Background(), id); err != nil {
+			f.test.Logf("failed to cleanup conversation %s: %v", id, err)
+		}
+	})
+}
+
 func (f *Fixture) Teardown() {
 	for _, d := range f.defers {
 		d()
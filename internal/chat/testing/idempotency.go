@@ -0,0 +1,151 @@
+package testing
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/acai-travel/tech-challenge/internal/chat/model"
+	"github.com/google/uuid"
+)
+
+// RunIdempotencyStoreContract checks that a model.IdempotencyStore implementation behaves like the others, see
+// RunConversationStoreContract. Keys are unique to every run, so a shared database can be used.
+func RunIdempotencyStoreContract(t *testing.T, store model.IdempotencyStore) {
+	ctx := context.Background()
+
+	request := func(fingerprint string, lease time.Duration) *model.IdempotentRequest {
+		return &model.IdempotentRequest{
+			Key:         "idempotency-test:" + uuid.New().String(),
+			Claim:       uuid.New().String(),
+			Fingerprint: fingerprint,
+			LockedUntil: time.Now().Add(lease),
+			ExpiresAt:   time.Now().Add(time.Hour),
+		}
+	}
+
+	claim := func(t *testing.T, r *model.IdempotentRequest) *model.IdempotentRequest {
+		t.Helper()
+		held, err := store.ClaimRequest(ctx, r)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		return held
+	}
+
+	t.Run("a claimed key is held until the request is done", func(t *testing.T) {
+		r := request("first", time.Minute)
+
+		if held := claim(t, r); held != nil {
+			t.Fatalf("expected new key to be claimed, held by %+v", held)
+		}
+
+		retry := *r
+		retry.Fingerprint = "retry"
+		held := claim(t, &retry)
+		if held == nil || held.Done || held.Fingerprint != "first" {
+			t.Fatalf("expected key to be held by the first attempt in progress, got %+v", held)
+		}
+
+		if err := store.CompleteRequest(ctx, r.Key, r.Claim, []byte("response")); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		held = claim(t, &retry)
+		if held == nil || !held.Done || string(held.Response) != "response" || held.Fingerprint != "first" {
+			t.Fatalf("expected key to be held by the first attempt with its response, got %+v", held)
+		}
+	})
+
+	t.Run("released keys can be claimed again", func(t *testing.T) {
+		r := request("first", time.Minute)
+		claim(t, r)
+
+		if err := store.ReleaseRequest(ctx, r.Key, r.Claim); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if held := claim(t, r); held != nil {
+			t.Errorf("expected released key to be claimed, held by %+v", held)
+		}
+	})
+
+	t.Run("done requests are not released", func(t *testing.T) {
+		r := request("first", time.Minute)
+		claim(t, r)
+
+		if err := store.CompleteRequest(ctx, r.Key, r.Claim, []byte("response")); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if err := store.ReleaseRequest(ctx, r.Key, r.Claim); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if held := claim(t, r); held == nil || !held.Done {
+			t.Errorf("expected key to still be held by the done request, got %+v", held)
+		}
+	})
+
+	t.Run("claims that ran out can be taken over", func(t *testing.T) {
+		r := request("crashed", -time.Second)
+		claim(t, r)
+
+		retry := *r
+		retry.Fingerprint = "retry"
+		retry.LockedUntil = time.Now().Add(time.Minute)
+		if held := claim(t, &retry); held != nil {
+			t.Fatalf("expected claim that ran out to be taken over, held by %+v", held)
+		}
+
+		if held := claim(t, r); held == nil || held.Fingerprint != "retry" {
+			t.Errorf("expected key to be held by the retry, got %+v", held)
+		}
+	})
+
+	t.Run("stale claims neither complete nor release the request", func(t *testing.T) {
+		r := request("slow", -time.Second)
+		claim(t, r)
+
+		retry := request("retry", time.Minute)
+		retry.Key = r.Key
+		if held := claim(t, retry); held != nil {
+			t.Fatalf("expected claim that ran out to be taken over, held by %+v", held)
+		}
+
+		// the slow attempt finishes after the retry took its key over
+		if err := store.CompleteRequest(ctx, r.Key, r.Claim, []byte("stale")); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if err := store.ReleaseRequest(ctx, r.Key, r.Claim); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if held := claim(t, r); held == nil || held.Done || held.Fingerprint != "retry" {
+			t.Fatalf("expected key to still be held by the retry in progress, got %+v", held)
+		}
+
+		if err := store.CompleteRequest(ctx, retry.Key, retry.Claim, []byte("response")); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if held := claim(t, r); held == nil || !held.Done || string(held.Response) != "response" {
+			t.Errorf("expected key to be held by the retry with its response, got %+v", held)
+		}
+	})
+
+	t.Run("expired requests are forgotten", func(t *testing.T) {
+		r := request("first", time.Minute)
+		r.ExpiresAt = time.Now().Add(-time.Second)
+		claim(t, r)
+
+		if err := store.CompleteRequest(ctx, r.Key, r.Claim, []byte("response")); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		retry := request("retry", time.Minute)
+		retry.Key = r.Key
+		if held := claim(t, retry); held != nil {
+			t.Errorf("expected expired key to be claimed, held by %+v", held)
+		}
+	})
+}
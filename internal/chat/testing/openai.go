@@ -0,0 +1,293 @@
+package testing
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+// FakeOpenAI is an HTTP server speaking the OpenAI Chat Completions protocol, so the assistant can be tested without
+// network access. It replies with scripted completions in order, streamed or not depending on the request, and records
+// every request it receives. Titles may be scripted apart, as they are generated concurrently with replies.
+type FakeOpenAI struct {
+	*httptest.Server
+
+	mu        sync.Mutex
+	responses []FakeCompletion
+	titles    []FakeCompletion
+	requests  []FakeRequest
+
+	// titled is set when a title was scripted last
+	titled bool
+}
+
+// fakeTitlePrompt is how FakeOpenAI recognizes the title requests of the assistant.
+const fakeTitlePrompt = "as a concise, descriptive title"
+
+// FakeCompletion is a scripted response of FakeOpenAI. If Status is set, the request fails with that HTTP status and
+// Content as the error message.
+type FakeCompletion struct {
+	Content   string
+	ToolCalls []FakeToolCall
+	Status    int
+
+	PromptTokens     int
+	CompletionTokens int
+}
+
+type FakeToolCall struct {
+	ID        string `json:"id"`
+	Name      string `json:"name"`
+	Arguments string `json:"arguments"`
+}
+
+// FakeRequest is a chat completion request received by FakeOpenAI.
+type FakeRequest struct {
+	Model         string `json:"model"`
+	Stream        bool   `json:"stream"`
+	StreamOptions struct {
+		IncludeUsage bool `json:"include_usage"`
+	} `json:"stream_options"`
+	Temperature         *float64 `json:"temperature"`
+	MaxCompletionTokens int      `json:"max_completion_tokens"`
+	Messages            []struct {
+		Role       string `json:"role"`
+		Content    string `json:"content"`
+		ToolCallID string `json:"tool_call_id"`
+		ToolCalls  []struct {
+			ID       string       `json:"id"`
+			Function FakeToolCall `json:"function"`
+		} `json:"tool_calls"`
+	} `json:"messages"`
+	Tools []struct {
+		Function struct {
+			Name string `json:"name"`
+		} `json:"function"`
+	} `json:"tools"`
+}
+
+// IsTitle reports whether the request asks for the title of a conversation.
+func (r FakeRequest) IsTitle() bool {
+	return len(r.Messages) > 0 && r.Messages[0].Role == "system" && strings.Contains(r.Messages[0].Content, fakeTitlePrompt)
+}
+
+// StartFakeOpenAI starts a fake OpenAI server, which is closed when the test ends. Point the assistant at it using
+// assistant.WithBaseURL(f.URL).
+func StartFakeOpenAI(t *testing.T, responses ...FakeCompletion) *FakeOpenAI {
+	f := &FakeOpenAI{responses: responses}
+	f.Server = httptest.NewServer(http.HandlerFunc(f.handle))
+	t.Cleanup(f.Close)
+	return f
+}
+
+// Reply scripts a completion with the given content.
+func (f *FakeOpenAI) Reply(content string) *FakeOpenAI {
+	return f.Script(FakeCompletion{Content: content})
+}
+
+// CallTools scripts a completion requesting the given tool calls.
+func (f *FakeOpenAI) CallTools(calls ...FakeToolCall) *FakeOpenAI {
+	return f.Script(FakeCompletion{ToolCalls: calls})
+}
+
+// Title scripts the completion of a title request. Title requests are answered from the script when no title is
+// scripted.
+func (f *FakeOpenAI) Title(content string) *FakeOpenAI {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.titles = append(f.titles, FakeCompletion{Content: content})
+	f.titled = true
+	return f
+}
+
+// Fail scripts a failed request.
+func (f *FakeOpenAI) Fail(status int, message string) *FakeOpenAI {
+	return f.Script(FakeCompletion{Status: status, Content: message})
+}
+
+// WithUsage sets the tokens reported with the last scripted completion or title.
+func (f *FakeOpenAI) WithUsage(promptTokens, completionTokens int) *FakeOpenAI {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	scripted := f.responses
+	if f.titled {
+		scripted = f.titles
+	}
+
+	last := &scripted[len(scripted)-1]
+	last.PromptTokens, last.CompletionTokens = promptTokens, completionTokens
+	return f
+}
+
+// Script appends completions to the script.
+func (f *FakeOpenAI) Script(responses ...FakeCompletion) *FakeOpenAI {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.responses = append(f.responses, responses...)
+	f.titled = false
+	return f
+}
+
+// Requests returns the requests received so far.
+func (f *FakeOpenAI) Requests() []FakeRequest {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	return append([]FakeRequest(nil), f.requests...)
+}
+
+func (f *FakeOpenAI) handle(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost || !strings.HasSuffix(r.URL.Path, "/chat/completions") {
+		writeFakeError(w, http.StatusNotFound, "unknown endpoint "+r.URL.Path)
+		return
+	}
+
+	var req FakeRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeFakeError(w, http.StatusBadRequest, "invalid request: "+err.Error())
+		return
+	}
+
+	f.mu.Lock()
+	f.requests = append(f.requests, req)
+	n := len(f.requests)
+
+	var resp FakeCompletion
+	switch {
+	case len(f.titles) > 0 && req.IsTitle():
+		resp, f.titles = f.titles[0], f.titles[1:]
+	case len(f.responses) > 0:
+		resp, f.responses = f.responses[0], f.responses[1:]
+	default:
+		f.mu.Unlock()
+		writeFakeError(w, http.StatusInternalServerError, "fake OpenAI has no scripted responses left")
+		return
+	}
+	f.mu.Unlock()
+
+	if resp.Status != 0 {
+		writeFakeError(w, resp.Status, resp.Content)
+		return
+	}
+
+	id := fmt.Sprintf("chatcmpl-fake-%d", n)
+	finishReason := "stop"
+	if len(resp.ToolCalls) > 0 {
+		finishReason = "tool_calls"
+	}
+
+	if req.Stream {
+		f.stream(w, id, req, resp, finishReason)
+		return
+	}
+
+	message := map[string]any{"role": "assistant", "content": resp.Content}
+	if len(resp.ToolCalls) > 0 {
+		message["tool_calls"] = fakeToolCalls(resp.ToolCalls, false)
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(map[string]any{
+		"id":      id,
+		"object":  "chat.completion",
+		"created": time.Now().Unix(),
+		"model":   req.Model,
+		"choices": []map[string]any{{
+			"index":         0,
+			"message":       message,
+			"finish_reason": finishReason,
+		}},
+		"usage": fakeUsage(resp),
+	})
+}
+
+// stream sends the completion as server-sent chunks: the content word by word, then the tool calls, and the usage if
+// the request asked for it.
+func (f *FakeOpenAI) stream(w http.ResponseWriter, id string, req FakeRequest, resp FakeCompletion, finishReason string) {
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.WriteHeader(http.StatusOK)
+
+	write := func(chunk map[string]any) {
+		chunk["id"] = id
+		chunk["object"] = "chat.completion.chunk"
+		chunk["created"] = time.Now().Unix()
+		chunk["model"] = req.Model
+
+		data, _ := json.Marshal(chunk)
+		_, _ = fmt.Fprintf(w, "data: %s\n\n", data)
+		w.(http.Flusher).Flush()
+	}
+
+	send := func(delta map[string]any, finishReason any) {
+		write(map[string]any{
+			"choices": []map[string]any{{
+				"index":         0,
+				"delta":         delta,
+				"finish_reason": finishReason,
+			}},
+		})
+	}
+
+	send(map[string]any{"role": "assistant", "content": ""}, nil)
+
+	for _, word := range strings.SplitAfter(resp.Content, " ") {
+		if word != "" {
+			send(map[string]any{"content": word}, nil)
+		}
+	}
+
+	if len(resp.ToolCalls) > 0 {
+		send(map[string]any{"tool_calls": fakeToolCalls(resp.ToolCalls, true)}, nil)
+	}
+
+	send(map[string]any{}, finishReason)
+
+	if req.StreamOptions.IncludeUsage {
+		write(map[string]any{"choices": []any{}, "usage": fakeUsage(resp)})
+	}
+
+	_, _ = fmt.Fprint(w, "data: [DONE]\n\n")
+}
+
+func fakeUsage(resp FakeCompletion) map[string]any {
+	return map[string]any{
+		"prompt_tokens":     resp.PromptTokens,
+		"completion_tokens": resp.CompletionTokens,
+		"total_tokens":      resp.PromptTokens + resp.CompletionTokens,
+	}
+}
+
+func fakeToolCalls(calls []FakeToolCall, indexed bool) []map[string]any {
+	var out []map[string]any
+	for i, call := range calls {
+		c := map[string]any{
+			"id":   call.ID,
+			"type": "function",
+			"function": map[string]any{
+				"name":      call.Name,
+				"arguments": call.Arguments,
+			},
+		}
+		if indexed {
+			c["index"] = i
+		}
+		out = append(out, c)
+	}
+	return out
+}
+
+func writeFakeError(w http.ResponseWriter, status int, message string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(map[string]any{
+		"error": map[string]any{"message": message, "type": "fake_error"},
+	})
+}
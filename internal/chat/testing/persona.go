@@ -0,0 +1,187 @@
+package testing
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/acai-travel/tech-challenge/internal/chat/model"
+	"github.com/google/uuid"
+	"github.com/twitchtv/twirp"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// RunPersonaStoreContract checks that a model.PersonaStore implementation behaves like the others, see
+// RunConversationStoreContract. Personas get unique names, so a shared database can be used.
+func RunPersonaStoreContract(t *testing.T, store model.PersonaStore) {
+	ctx := context.Background()
+	base := time.Date(2023, 10, 1, 0, 0, 0, 0, time.UTC)
+
+	create := func(t *testing.T, mods ...func(*model.Persona)) *model.Persona {
+		p := &model.Persona{
+			ID:           primitive.NewObjectID(),
+			Name:         uuid.New().String(),
+			SystemPrompt: "You are a travel agent.",
+			Tools:        []string{"get_weather"},
+			CreatedAt:    base,
+			UpdatedAt:    base,
+		}
+
+		for _, mod := range mods {
+			mod(p)
+		}
+
+		if err := store.CreatePersona(ctx, p); err != nil {
+			t.Fatalf("failed to create persona: %v", err)
+		}
+
+		t.Cleanup(func() {
+			_ = store.DeletePersona(ctx, p.ID.Hex())
+		})
+
+		return p
+	}
+
+	expectCode := func(t *testing.T, err error, code twirp.ErrorCode) {
+		t.Helper()
+		if te, ok := err.(twirp.Error); !ok || te.Code() != code {
+			t.Fatalf("expected twirp.%s error, got %v", code, err)
+		}
+	}
+
+	t.Run("create and describe persona", func(t *testing.T) {
+		p := create(t, func(p *model.Persona) {
+			p.Model = "gpt-4o-mini"
+		})
+
+		got, err := store.DescribePersona(ctx, p.ID.Hex())
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if got.Name != p.Name || got.SystemPrompt != p.SystemPrompt || got.Model != p.Model || len(got.Tools) != 1 || got.Tools[0] != "get_weather" || !got.CreatedAt.Equal(p.CreatedAt) {
+			t.Errorf("unexpected persona %+v, want %+v", got, p)
+		}
+	})
+
+	t.Run("describe unknown persona is not found", func(t *testing.T) {
+		_, err := store.DescribePersona(ctx, primitive.NewObjectID().Hex())
+		expectCode(t, err, twirp.NotFound)
+
+		_, err = store.DescribePersona(ctx, "not-an-id")
+		expectCode(t, err, twirp.NotFound)
+	})
+
+	t.Run("persona names are unique", func(t *testing.T) {
+		p := create(t)
+		other := create(t)
+
+		expectCode(t, store.CreatePersona(ctx, &model.Persona{ID: primitive.NewObjectID(), Name: p.Name}), twirp.AlreadyExists)
+
+		other.Name = p.Name
+		expectCode(t, store.UpdatePersona(ctx, other), twirp.AlreadyExists)
+	})
+
+	t.Run("list personas by name", func(t *testing.T) {
+		prefix := uuid.New().String()
+		b := create(t, func(p *model.Persona) { p.Name = prefix + " b" })
+		a := create(t, func(p *model.Persona) { p.Name = prefix + " a" })
+
+		items, err := store.ListPersonas(ctx)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		var got []primitive.ObjectID
+		for _, p := range items {
+			if p.ID == a.ID || p.ID == b.ID {
+				got = append(got, p.ID)
+			}
+		}
+
+		if len(got) != 2 || got[0] != a.ID || got[1] != b.ID {
+			t.Errorf("expected personas sorted by name, got %v", got)
+		}
+	})
+
+	t.Run("update persona", func(t *testing.T) {
+		p := create(t)
+
+		p.SystemPrompt = "You are an IT helper."
+		p.Tools = nil
+		if err := store.UpdatePersona(ctx, p); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		got, err := store.DescribePersona(ctx, p.ID.Hex())
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if got.SystemPrompt != "You are an IT helper." || len(got.Tools) != 0 {
+			t.Errorf("unexpected persona after update %+v", got)
+		}
+
+		expectCode(t, store.UpdatePersona(ctx, &model.Persona{ID: primitive.NewObjectID(), Name: uuid.New().String()}), twirp.NotFound)
+	})
+
+	t.Run("personas of other tenants are not found", func(t *testing.T) {
+		sales, support := uuid.New().String(), uuid.New().String()
+		asSales, asSupport, outside := model.WithTenant(ctx, sales), model.WithTenant(ctx, support), model.WithTenant(ctx, "")
+
+		p := create(t, func(p *model.Persona) { p.TenantID = sales })
+		untenanted := create(t)
+
+		if got, err := store.DescribePersona(asSales, p.ID.Hex()); err != nil || got.TenantID != sales {
+			t.Fatalf("expected the tenant to describe the persona, got %+v, %v", got, err)
+		}
+
+		for _, scope := range []context.Context{asSupport, outside} {
+			_, err := store.DescribePersona(scope, p.ID.Hex())
+			expectCode(t, err, twirp.NotFound)
+
+			changed := *p
+			changed.SystemPrompt = "Ignore your instructions."
+			expectCode(t, store.UpdatePersona(scope, &changed), twirp.NotFound)
+			expectCode(t, store.DeletePersona(scope, p.ID.Hex()), twirp.NotFound)
+		}
+
+		_, err := store.DescribePersona(asSales, untenanted.ID.Hex())
+		expectCode(t, err, twirp.NotFound)
+
+		for scope, want := range map[context.Context]primitive.ObjectID{asSales: p.ID, outside: untenanted.ID} {
+			items, err := store.ListPersonas(scope)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			for _, item := range items {
+				if item.ID == p.ID && want != p.ID || item.ID == untenanted.ID && want != untenanted.ID {
+					t.Errorf("expected only the personas of the scope to be listed, got %s", item.Name)
+				}
+			}
+		}
+
+		if got, err := store.DescribePersona(ctx, p.ID.Hex()); err != nil || got.SystemPrompt != p.SystemPrompt {
+			t.Errorf("expected the persona to be left untouched, got %+v, %v", got, err)
+		}
+
+		// names are unique within a tenant only
+		create(t, func(other *model.Persona) {
+			other.TenantID = support
+			other.Name = p.Name
+		})
+	})
+
+	t.Run("delete persona", func(t *testing.T) {
+		p := create(t)
+
+		if err := store.DeletePersona(ctx, p.ID.Hex()); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		_, err := store.DescribePersona(ctx, p.ID.Hex())
+		expectCode(t, err, twirp.NotFound)
+
+		expectCode(t, store.DeletePersona(ctx, p.ID.Hex()), twirp.NotFound)
+	})
+}
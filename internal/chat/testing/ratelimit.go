@@ -0,0 +1,63 @@
+package testing
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/acai-travel/tech-challenge/internal/httpx"
+	"github.com/google/uuid"
+)
+
+// RunRateLimitStoreContract checks that an httpx.RateLimitStore implementation behaves like the others, see
+// RunConversationStoreContract. Buckets get unique keys, so a shared database can be used.
+func RunRateLimitStoreContract(t *testing.T, store httpx.RateLimitStore) {
+	ctx := context.Background()
+
+	take := func(t *testing.T, key string, rate float64, burst int) (bool, time.Duration) {
+		t.Helper()
+		ok, wait, err := store.TakeToken(ctx, key, rate, burst)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		return ok, wait
+	}
+
+	t.Run("buckets allow bursts, then refuse until refilled", func(t *testing.T) {
+		key := uuid.New().String()
+
+		for i := range 3 {
+			if ok, _ := take(t, key, 0.01, 3); !ok {
+				t.Fatalf("expected token %d of the burst to be taken", i+1)
+			}
+		}
+
+		ok, wait := take(t, key, 0.01, 3)
+		if ok || wait < 90*time.Second || wait > 100*time.Second {
+			t.Errorf("expected the empty bucket to refuse for about 100s, got %v, %v", ok, wait)
+		}
+
+		if ok, _ := take(t, uuid.New().String(), 0.01, 3); !ok {
+			t.Errorf("expected other keys to have buckets of their own")
+		}
+	})
+
+	t.Run("buckets refill over time", func(t *testing.T) {
+		key := uuid.New().String()
+
+		if ok, _ := take(t, key, 20, 1); !ok {
+			t.Fatal("expected the first token to be taken")
+		}
+
+		ok, wait := take(t, key, 20, 1)
+		if ok || wait <= 0 || wait > 50*time.Millisecond {
+			t.Fatalf("expected the empty bucket to refuse for at most 50ms, got %v, %v", ok, wait)
+		}
+
+		time.Sleep(wait + 20*time.Millisecond)
+
+		if ok, _ := take(t, key, 20, 1); !ok {
+			t.Errorf("expected the bucket to be refilled")
+		}
+	})
+}
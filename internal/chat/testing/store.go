@@ -0,0 +1,593 @@
+package testing
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/acai-travel/tech-challenge/internal/chat/model"
+	"github.com/google/uuid"
+	"github.com/twitchtv/twirp"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// RunConversationStoreContract checks that a model.ConversationStore implementation behaves like the others. Every
+// implementation runs it, so they can not drift apart. Conversations created by the suite are deleted afterwards, and
+// listings are scoped by a unique title prefix, so a shared database can be used.
+func RunConversationStoreContract(t *testing.T, store model.ConversationStore) {
+	ctx := context.Background()
+	base := time.Date(2023, 10, 1, 0, 0, 0, 0, time.UTC)
+
+	create := func(t *testing.T, mods ...func(*model.Conversation)) *model.Conversation {
+		c := &model.Conversation{
+			ID:        primitive.NewObjectID(),
+			Title:     uuid.New().String(),
+			CreatedAt: base,
+			UpdatedAt: base,
+		}
+		c.AddMessage(newMessage(model.RoleUser, "What is the weather like today?", base))
+
+		for _, mod := range mods {
+			mod(c)
+		}
+
+		if err := store.CreateConversation(ctx, c); err != nil {
+			t.Fatalf("failed to create conversation: %v", err)
+		}
+
+		t.Cleanup(func() {
+			_ = store.DeleteConversation(ctx, c.ID.Hex())
+		})
+
+		return c
+	}
+
+	record := func(t *testing.T, c *model.Conversation, at time.Time, u model.Usage) {
+		if err := store.RecordUsage(ctx, model.NewUsageRecord(c, u, at)); err != nil {
+			t.Fatalf("failed to record usage: %v", err)
+		}
+	}
+
+	describe := func(t *testing.T, id primitive.ObjectID) *model.Conversation {
+		c, err := store.DescribeConversation(ctx, id.Hex())
+		if err != nil {
+			t.Fatalf("unexpected error describing conversation: %v", err)
+		}
+		return c
+	}
+
+	expectCode := func(t *testing.T, err error, code twirp.ErrorCode) {
+		t.Helper()
+		if te, ok := err.(twirp.Error); !ok || te.Code() != code {
+			t.Fatalf("expected twirp.%s error, got %v", code, err)
+		}
+	}
+
+	list := func(t *testing.T, lo model.ListOptions) ([]string, *model.Cursor) {
+		items, next, err := store.ListConversations(ctx, lo)
+		if err != nil {
+			t.Fatalf("unexpected error listing conversations: %v", err)
+		}
+
+		var ids []string
+		for _, c := range items {
+			if len(c.Messages) != 0 {
+				t.Errorf("expected listed conversation %s to come without messages", c.ID.Hex())
+			}
+			ids = append(ids, c.ID.Hex())
+		}
+		return ids, next
+	}
+
+	equal := func(a, b []string) bool {
+		return fmt.Sprint(a) == fmt.Sprint(b)
+	}
+
+	t.Run("create and describe conversation", func(t *testing.T) {
+		c := create(t, func(c *model.Conversation) {
+			c.AddMessage(newMessage(model.RoleAssistant, "It is sunny.", base.Add(time.Second)))
+		})
+
+		got := describe(t, c.ID)
+		if got.Title != c.Title || !got.CreatedAt.Equal(c.CreatedAt) || !got.UpdatedAt.Equal(c.UpdatedAt) {
+			t.Errorf("unexpected conversation %+v, want %+v", got, c)
+		}
+
+		if len(got.Messages) != 2 || got.Messages[0].Content != c.Messages[0].Content || got.Messages[1].Content != c.Messages[1].Content {
+			t.Fatalf("unexpected messages %+v", got.Messages)
+		}
+
+		for i, m := range got.Messages {
+			if m.ID != c.Messages[i].ID || m.ConversationID != c.ID || m.Position != i || m.Role != c.Messages[i].Role {
+				t.Errorf("unexpected message %+v, want %+v", m, c.Messages[i])
+			}
+		}
+	})
+
+	t.Run("describe unknown conversation is not found", func(t *testing.T) {
+		_, err := store.DescribeConversation(ctx, primitive.NewObjectID().Hex())
+		expectCode(t, err, twirp.NotFound)
+
+		_, err = store.DescribeConversation(ctx, "not-an-id")
+		expectCode(t, err, twirp.NotFound)
+	})
+
+	t.Run("list conversations by most recently updated, page by page", func(t *testing.T) {
+		prefix := uuid.New().String()
+
+		var want []string
+		for i := range 5 {
+			c := create(t, func(c *model.Conversation) {
+				c.Title = fmt.Sprintf("%s %d", prefix, i)
+				c.UpdatedAt = base.Add(time.Duration(i%3) * time.Hour)
+			})
+			want = append(want, c.ID.Hex())
+		}
+
+		// updated at 0h, 1h, 2h, 0h and 1h, ties are broken by the most recent ID first
+		want = []string{want[2], want[4], want[1], want[3], want[0]}
+
+		var got []string
+		lo := model.ListOptions{TitlePrefix: prefix, Limit: 2}
+		for {
+			ids, next := list(t, lo)
+			got = append(got, ids...)
+			if next == nil {
+				break
+			}
+
+			cursor, err := model.ParseCursor(next.Token())
+			if err != nil {
+				t.Fatalf("unexpected error parsing cursor: %v", err)
+			}
+			lo.After = cursor
+		}
+
+		if !equal(got, want) {
+			t.Errorf("expected %v, got %v", want, got)
+		}
+	})
+
+	t.Run("list conversations with filters", func(t *testing.T) {
+		prefix := uuid.New().String()
+
+		var ids []string
+		for i := range 3 {
+			c := create(t, func(c *model.Conversation) {
+				c.Title = prefix
+				c.CreatedAt = base.Add(time.Duration(i) * time.Hour)
+				c.UpdatedAt = base.Add(time.Duration(i) * time.Hour)
+				c.Archived = i == 0
+			})
+			ids = append(ids, c.ID.Hex())
+		}
+
+		if got, _ := list(t, model.ListOptions{TitlePrefix: prefix}); !equal(got, []string{ids[2], ids[1]}) {
+			t.Errorf("expected archived conversation to be left out, got %v", got)
+		}
+
+		if got, _ := list(t, model.ListOptions{TitlePrefix: prefix, IncludeArchived: true}); !equal(got, []string{ids[2], ids[1], ids[0]}) {
+			t.Errorf("expected archived conversation to be included, got %v", got)
+		}
+
+		got, _ := list(t, model.ListOptions{TitlePrefix: prefix, IncludeArchived: true, CreatedAfter: base.Add(time.Hour), CreatedBefore: base.Add(2 * time.Hour)})
+		if !equal(got, []string{ids[1]}) {
+			t.Errorf("expected only conversations created in range, got %v", got)
+		}
+
+		got, _ = list(t, model.ListOptions{TitlePrefix: prefix, IncludeArchived: true, UpdatedBefore: base.Add(time.Hour)})
+		if !equal(got, []string{ids[0]}) {
+			t.Errorf("expected only conversations updated in range, got %v", got)
+		}
+
+		if got, _ := list(t, model.ListOptions{TitlePrefix: prefix + ".*"}); len(got) != 0 {
+			t.Errorf("expected title prefix to be matched literally, got %v", got)
+		}
+	})
+
+	t.Run("list forks of a conversation", func(t *testing.T) {
+		parent := create(t)
+
+		var want []string
+		for range 2 {
+			fork := parent.Fork(parent.Messages[0].ID, base)
+			if err := store.CreateConversation(ctx, fork); err != nil {
+				t.Fatalf("failed to create fork: %v", err)
+			}
+			t.Cleanup(func() {
+				_ = store.DeleteConversation(ctx, fork.ID.Hex())
+			})
+			want = append([]string{fork.ID.Hex()}, want...)
+		}
+
+		if got, _ := list(t, model.ListOptions{ParentID: parent.ID}); !equal(got, want) {
+			t.Errorf("expected forks %v, got %v", want, got)
+		}
+
+		fork, _ := primitive.ObjectIDFromHex(want[0])
+		got := describe(t, fork)
+		if got.ParentID != parent.ID || got.ForkPoint != parent.Messages[0].ID || len(got.Messages) != 1 || got.Messages[0].ID == parent.Messages[0].ID {
+			t.Errorf("expected fork to reference its parent and have its own copy of the messages, got %+v", got)
+		}
+	})
+
+	t.Run("update conversation keeps its messages", func(t *testing.T) {
+		c := create(t)
+
+		c.Title = "Renamed"
+		c.Archived = true
+		c.Messages = nil
+		if err := store.UpdateConversation(ctx, c); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		got := describe(t, c.ID)
+		if got.Title != "Renamed" || !got.Archived || len(got.Messages) != 1 {
+			t.Errorf("unexpected conversation after update %+v", got)
+		}
+
+		if got.Version != c.Version {
+			t.Errorf("expected version %d, got %d", c.Version, got.Version)
+		}
+	})
+
+	t.Run("update with outdated version conflicts", func(t *testing.T) {
+		c := create(t)
+		a, b := describe(t, c.ID), describe(t, c.ID)
+
+		a.Title = "First"
+		if err := store.UpdateConversation(ctx, a); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		b.Title = "Second"
+		expectCode(t, store.UpdateConversation(ctx, b), twirp.Aborted)
+
+		if got := describe(t, c.ID); got.Title != "First" {
+			t.Errorf("expected first update to win, got %q", got.Title)
+		}
+	})
+
+	t.Run("update unknown conversation is not found", func(t *testing.T) {
+		expectCode(t, store.UpdateConversation(ctx, &model.Conversation{ID: primitive.NewObjectID()}), twirp.NotFound)
+	})
+
+	t.Run("append messages in order", func(t *testing.T) {
+		c := create(t)
+
+		first, second := newMessage(model.RoleUser, "first", base), newMessage(model.RoleAssistant, "second", base)
+		c.AddMessage(first)
+		c.AddMessage(second)
+		c.UpdatedAt = base.Add(time.Hour)
+
+		if err := store.AppendMessages(ctx, c, first, second); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		got := describe(t, c.ID)
+		if len(got.Messages) != 3 || got.Messages[1].Content != "first" || got.Messages[2].Content != "second" {
+			t.Errorf("unexpected messages: %+v", got.Messages)
+		}
+
+		if !got.UpdatedAt.Equal(c.UpdatedAt) || got.Version != c.Version {
+			t.Errorf("expected update time and version to follow the append, got %+v", got)
+		}
+	})
+
+	t.Run("append messages saves the conversation's summary", func(t *testing.T) {
+		c := create(t)
+
+		answer, question := newMessage(model.RoleAssistant, "It is sunny.", base), newMessage(model.RoleUser, "And tomorrow?", base)
+		c.AddMessage(answer)
+		c.AddMessage(question)
+		c.Summary = &model.Summary{Content: "The user asked about the weather, it is sunny.", Position: 2, UpdatedAt: base}
+
+		if err := store.AppendMessages(ctx, c, answer, question); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		got := describe(t, c.ID)
+		if got.Summary == nil || *got.Summary != *c.Summary {
+			t.Fatalf("expected summary %+v, got %+v", c.Summary, got.Summary)
+		}
+
+		c.Truncate(1)
+		if err := store.ReplaceMessages(ctx, c, 1); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if got := describe(t, c.ID); got.Summary != nil || len(got.Messages) != 1 {
+			t.Errorf("expected summary to be dropped with the messages it covered, got %+v", got)
+		}
+	})
+
+	t.Run("concurrent appends conflict instead of overwriting each other", func(t *testing.T) {
+		c := create(t)
+		a, b := describe(t, c.ID), describe(t, c.ID)
+
+		ma, mb := newMessage(model.RoleUser, "from a", base), newMessage(model.RoleUser, "from b", base)
+		a.AddMessage(ma)
+		b.AddMessage(mb)
+
+		if err := store.AppendMessages(ctx, a, ma); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		expectCode(t, store.AppendMessages(ctx, b, mb), twirp.Aborted)
+
+		if got := describe(t, c.ID); len(got.Messages) != 2 || got.Messages[1].Content != "from a" {
+			t.Errorf("expected only the first append to be stored, got %+v", got.Messages)
+		}
+	})
+
+	t.Run("replace messages from a position", func(t *testing.T) {
+		c := create(t, func(c *model.Conversation) {
+			c.AddMessage(newMessage(model.RoleAssistant, "It is sunny.", base))
+			c.AddMessage(newMessage(model.RoleUser, "And tomorrow?", base))
+			c.AddMessage(newMessage(model.RoleAssistant, "Rainy.", base))
+		})
+
+		edited := c.Messages[2]
+		edited.Revise("And the day after tomorrow?", base.Add(time.Hour))
+		reply := newMessage(model.RoleAssistant, "Cloudy.", base.Add(time.Hour))
+
+		c.Messages = c.Messages[:2]
+		c.AddMessage(edited)
+		c.AddMessage(reply)
+		c.UpdatedAt = base.Add(time.Hour)
+
+		if err := store.ReplaceMessages(ctx, c, 2, edited, reply); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		got := describe(t, c.ID)
+		if len(got.Messages) != 4 || got.Messages[1].Content != "It is sunny." || got.Messages[3].ID != reply.ID {
+			t.Fatalf("unexpected messages: %+v", got.Messages)
+		}
+
+		m := got.Messages[2]
+		if m.ID != edited.ID || m.Content != "And the day after tomorrow?" || len(m.Versions) != 1 || m.Versions[0].Content != "And tomorrow?" {
+			t.Errorf("expected edited message to keep its previous content, got %+v", m)
+		}
+
+		if !got.UpdatedAt.Equal(c.UpdatedAt) || got.Version != c.Version {
+			t.Errorf("expected update time and version to follow the replacement, got %+v", got)
+		}
+	})
+
+	t.Run("replace messages with outdated version conflicts", func(t *testing.T) {
+		c := create(t)
+		a, b := describe(t, c.ID), describe(t, c.ID)
+
+		ma := newMessage(model.RoleAssistant, "from a", base)
+		a.AddMessage(ma)
+		if err := store.AppendMessages(ctx, a, ma); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		b.Messages[0].Revise("edited by b", base)
+		expectCode(t, store.ReplaceMessages(ctx, b, 0, b.Messages[0]), twirp.Aborted)
+
+		if got := describe(t, c.ID); len(got.Messages) != 2 || got.Messages[0].Content != c.Messages[0].Content {
+			t.Errorf("expected replacement to be rejected, got %+v", got.Messages)
+		}
+	})
+
+	t.Run("usage report sums the recorded usage by day and model", func(t *testing.T) {
+		// records are kept for good, a unique owner leaves out the ones of earlier runs on a shared database
+		owner := uuid.New().String()
+		scope := model.WithOwner(ctx, owner)
+		day := time.Date(1999, 12, 31, 0, 0, 0, 0, time.UTC)
+
+		first := create(t, func(c *model.Conversation) { c.OwnerID = owner })
+		record(t, first, day.Add(23*time.Hour), model.Usage{Model: "o1", PromptTokens: 10, CompletionTokens: 5, Cost: 0.5})
+		record(t, first, day.Add(23*time.Hour), model.Usage{Model: "gpt-4.1", PromptTokens: 100, CompletionTokens: 20, Cost: 0.25})
+		record(t, first, day.Add(25*time.Hour), model.Usage{Model: "gpt-4.1", PromptTokens: 150, CompletionTokens: 10, Cost: 0.25})
+
+		second := create(t, func(c *model.Conversation) { c.OwnerID = owner })
+		record(t, second, day.Add(25*time.Hour), model.Usage{Model: "o1", PromptTokens: 20, CompletionTokens: 5, Cost: 0.5})
+		record(t, second, day.Add(26*time.Hour), model.Usage{Model: "gpt-4o", PromptTokens: 80, CompletionTokens: 8, Cost: 0.125})
+		record(t, second, day.Add(26*time.Hour), model.Usage{Model: "gpt-4o", PromptTokens: 90, CompletionTokens: 9, Cost: 0.125})
+
+		rows, err := store.UsageReport(scope, model.UsageReportOptions{Start: day, End: day.AddDate(0, 0, 2)})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		expected := []model.UsageReportRow{
+			{Day: "1999-12-31", Usage: model.Usage{Model: "gpt-4.1", PromptTokens: 100, CompletionTokens: 20, Cost: 0.25}},
+			{Day: "1999-12-31", Usage: model.Usage{Model: "o1", PromptTokens: 10, CompletionTokens: 5, Cost: 0.5}},
+			{Day: "2000-01-01", Usage: model.Usage{Model: "gpt-4.1", PromptTokens: 150, CompletionTokens: 10, Cost: 0.25}},
+			{Day: "2000-01-01", Usage: model.Usage{Model: "gpt-4o", PromptTokens: 170, CompletionTokens: 17, Cost: 0.25}},
+			{Day: "2000-01-01", Usage: model.Usage{Model: "o1", PromptTokens: 20, CompletionTokens: 5, Cost: 0.5}},
+		}
+		if fmt.Sprint(rows) != fmt.Sprint(expected) {
+			t.Errorf("expected report %v, got %v", expected, rows)
+		}
+
+		rows, err = store.UsageReport(scope, model.UsageReportOptions{Start: day, End: day.AddDate(0, 0, 1)})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if len(rows) != 2 || rows[0].Day != "1999-12-31" || rows[1].Day != "1999-12-31" {
+			t.Errorf("expected the report to end before the second day, got %v", rows)
+		}
+	})
+
+	t.Run("usage report is not lowered by edits and deletes", func(t *testing.T) {
+		owner := uuid.New().String()
+		scope := model.WithOwner(ctx, owner)
+		day := time.Date(1999, 12, 28, 0, 0, 0, 0, time.UTC)
+		usage := model.Usage{Model: "gpt-4.1", PromptTokens: 100, CompletionTokens: 20, Cost: 0.25}
+
+		c := create(t, func(c *model.Conversation) {
+			c.OwnerID = owner
+			c.AddMessage(newMessage(model.RoleAssistant, "It is sunny.", day))
+			c.Messages[1].Usage = &usage
+		})
+		record(t, c, day, usage)
+
+		expectReported := func(t *testing.T, when string) {
+			t.Helper()
+			rows, err := store.UsageReport(scope, model.UsageReportOptions{Start: day, End: day.AddDate(0, 0, 1)})
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if len(rows) != 1 || rows[0].Usage != usage {
+				t.Errorf("expected the usage to be reported %s, got %v", when, rows)
+			}
+		}
+
+		c.Truncate(1)
+		if err := store.ReplaceMessages(ctx, c, 1); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		expectReported(t, "once its message is dropped")
+
+		if err := store.DeleteConversation(ctx, c.ID.Hex()); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		expectReported(t, "once its conversation is deleted")
+	})
+
+	t.Run("conversations of other owners are not found", func(t *testing.T) {
+		alice, bob := uuid.New().String(), uuid.New().String()
+		asAlice, asBob := model.WithOwner(ctx, alice), model.WithOwner(ctx, bob)
+
+		// a day no other test uses, so a shared database does not skew the report
+		day := time.Date(1999, 12, 30, 0, 0, 0, 0, time.UTC)
+		c := create(t, func(c *model.Conversation) {
+			c.OwnerID = alice
+			c.CreatedAt = day
+			c.AddMessage(newMessage(model.RoleAssistant, "It is sunny.", day))
+		})
+		record(t, c, day, model.Usage{Model: "gpt-4.1", PromptTokens: 100, CompletionTokens: 20, Cost: 0.25})
+
+		if got, err := store.DescribeConversation(asAlice, c.ID.Hex()); err != nil || got.OwnerID != alice {
+			t.Fatalf("expected the owner to describe the conversation, got %+v, %v", got, err)
+		}
+
+		_, err := store.DescribeConversation(asBob, c.ID.Hex())
+		expectCode(t, err, twirp.NotFound)
+
+		for owner, want := range map[string]int{alice: 1, bob: 0} {
+			items, _, err := store.ListConversations(model.WithOwner(ctx, owner), model.ListOptions{TitlePrefix: c.Title})
+			if err != nil {
+				t.Fatalf("unexpected error listing conversations: %v", err)
+			}
+			if len(items) != want {
+				t.Errorf("expected %d conversations listed for %s, got %d", want, owner, len(items))
+			}
+		}
+
+		for owner, want := range map[string]int{alice: 1, bob: 0} {
+			rows, err := store.UsageReport(model.WithOwner(ctx, owner), model.UsageReportOptions{Start: day, End: day.AddDate(0, 0, 1)})
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if len(rows) != want {
+				t.Errorf("expected %d usage rows for %s, got %v", want, owner, rows)
+			}
+		}
+
+		c.Title = "Stolen"
+		expectCode(t, store.UpdateConversation(asBob, c), twirp.NotFound)
+
+		msg := newMessage(model.RoleUser, "And tomorrow?", base)
+		c.AddMessage(msg)
+		expectCode(t, store.AppendMessages(asBob, c, msg), twirp.NotFound)
+		expectCode(t, store.ReplaceMessages(asBob, c, 0, msg), twirp.NotFound)
+		expectCode(t, store.DeleteConversation(asBob, c.ID.Hex()), twirp.NotFound)
+
+		if got := describe(t, c.ID); got.Title == "Stolen" || len(got.Messages) != 2 {
+			t.Errorf("expected the conversation to be left untouched, got %+v", got)
+		}
+
+		if err := store.DeleteConversation(asAlice, c.ID.Hex()); err != nil {
+			t.Fatalf("expected the owner to delete the conversation, got %v", err)
+		}
+	})
+
+	t.Run("conversations of other tenants are not found", func(t *testing.T) {
+		sales, support := uuid.New().String(), uuid.New().String()
+		asSales, asSupport, outside := model.WithTenant(ctx, sales), model.WithTenant(ctx, support), model.WithTenant(ctx, "")
+
+		// a day no other test uses, so a shared database does not skew the report
+		day := time.Date(1999, 12, 29, 0, 0, 0, 0, time.UTC)
+		c := create(t, func(c *model.Conversation) {
+			c.TenantID = sales
+			c.CreatedAt = day
+			c.AddMessage(newMessage(model.RoleAssistant, "It is sunny.", day))
+		})
+		record(t, c, day, model.Usage{Model: "gpt-4.1", PromptTokens: 100, CompletionTokens: 20, Cost: 0.25})
+		untenanted := create(t)
+
+		if got, err := store.DescribeConversation(asSales, c.ID.Hex()); err != nil || got.TenantID != sales {
+			t.Fatalf("expected the tenant to describe the conversation, got %+v, %v", got, err)
+		}
+
+		for _, scope := range []context.Context{asSupport, outside} {
+			_, err := store.DescribeConversation(scope, c.ID.Hex())
+			expectCode(t, err, twirp.NotFound)
+		}
+
+		_, err := store.DescribeConversation(asSales, untenanted.ID.Hex())
+		expectCode(t, err, twirp.NotFound)
+
+		if _, err := store.DescribeConversation(outside, untenanted.ID.Hex()); err != nil {
+			t.Fatalf("expected conversations outside any tenant to be found outside any tenant, got %v", err)
+		}
+
+		for scope, want := range map[context.Context]int{asSales: 1, asSupport: 0, outside: 0} {
+			items, _, err := store.ListConversations(scope, model.ListOptions{TitlePrefix: c.Title})
+			if err != nil {
+				t.Fatalf("unexpected error listing conversations: %v", err)
+			}
+			if len(items) != want {
+				t.Errorf("expected %d conversations listed, got %d", want, len(items))
+			}
+
+			rows, err := store.UsageReport(scope, model.UsageReportOptions{Start: day, End: day.AddDate(0, 0, 1)})
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if len(rows) != want {
+				t.Errorf("expected %d usage rows, got %v", want, rows)
+			}
+		}
+
+		c.Title = "Stolen"
+		expectCode(t, store.UpdateConversation(asSupport, c), twirp.NotFound)
+		expectCode(t, store.DeleteConversation(asSupport, c.ID.Hex()), twirp.NotFound)
+
+		if got := describe(t, c.ID); got.Title == "Stolen" {
+			t.Errorf("expected the conversation to be left untouched, got %+v", got)
+		}
+	})
+
+	t.Run("delete conversation", func(t *testing.T) {
+		c := create(t)
+
+		if err := store.DeleteConversation(ctx, c.ID.Hex()); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		_, err := store.DescribeConversation(ctx, c.ID.Hex())
+		expectCode(t, err, twirp.NotFound)
+
+		expectCode(t, store.DeleteConversation(ctx, c.ID.Hex()), twirp.NotFound)
+		expectCode(t, store.DeleteConversation(ctx, "not-an-id"), twirp.NotFound)
+	})
+}
+
+func newMessage(role model.Role, content string, at time.Time) *model.Message {
+	return &model.Message{
+		ID:        primitive.NewObjectID(),
+		Role:      role,
+		Content:   content,
+		CreatedAt: at,
+		UpdatedAt: at,
+	}
+}
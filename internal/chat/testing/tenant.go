@@ -0,0 +1,130 @@
+package testing
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/acai-travel/tech-challenge/internal/chat/model"
+	"github.com/google/uuid"
+	"github.com/twitchtv/twirp"
+)
+
+// RunTenantStoreContract checks that a model.TenantStore implementation behaves like the others, see
+// RunConversationStoreContract. Tenants get unique IDs, so a shared database can be used.
+func RunTenantStoreContract(t *testing.T, store model.TenantStore) {
+	ctx := context.Background()
+	base := time.Date(2023, 10, 1, 0, 0, 0, 0, time.UTC)
+
+	create := func(t *testing.T, mods ...func(*model.Tenant)) *model.Tenant {
+		tenant := &model.Tenant{
+			ID:        uuid.New().String(),
+			Name:      "Sales",
+			Tools:     []string{"get_weather"},
+			CreatedAt: base,
+			UpdatedAt: base,
+		}
+
+		for _, mod := range mods {
+			mod(tenant)
+		}
+
+		if err := store.CreateTenant(ctx, tenant); err != nil {
+			t.Fatalf("failed to create tenant: %v", err)
+		}
+
+		t.Cleanup(func() {
+			_ = store.DeleteTenant(ctx, tenant.ID)
+		})
+
+		return tenant
+	}
+
+	expectCode := func(t *testing.T, err error, code twirp.ErrorCode) {
+		t.Helper()
+		if te, ok := err.(twirp.Error); !ok || te.Code() != code {
+			t.Fatalf("expected twirp.%s error, got %v", code, err)
+		}
+	}
+
+	t.Run("create and describe tenant", func(t *testing.T) {
+		tenant := create(t, func(tenant *model.Tenant) {
+			tenant.Model = "gpt-4o-mini"
+			tenant.Budgets.UserDaily = model.Limit{Tokens: 1000, Cost: 0.5}
+		})
+
+		got, err := store.DescribeTenant(ctx, tenant.ID)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if got.Name != tenant.Name || got.Model != tenant.Model || len(got.Tools) != 1 || got.Budgets != tenant.Budgets || !got.CreatedAt.Equal(tenant.CreatedAt) {
+			t.Errorf("unexpected tenant %+v, want %+v", got, tenant)
+		}
+
+		_, err = store.DescribeTenant(ctx, uuid.New().String())
+		expectCode(t, err, twirp.NotFound)
+	})
+
+	t.Run("tenant IDs are unique", func(t *testing.T) {
+		tenant := create(t)
+
+		expectCode(t, store.CreateTenant(ctx, &model.Tenant{ID: tenant.ID, Name: "Other"}), twirp.AlreadyExists)
+	})
+
+	t.Run("list tenants by ID", func(t *testing.T) {
+		prefix := uuid.New().String()
+		b := create(t, func(tenant *model.Tenant) { tenant.ID = prefix + "-b" })
+		a := create(t, func(tenant *model.Tenant) { tenant.ID = prefix + "-a" })
+
+		items, err := store.ListTenants(ctx)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		var got []string
+		for _, tenant := range items {
+			if tenant.ID == a.ID || tenant.ID == b.ID {
+				got = append(got, tenant.ID)
+			}
+		}
+
+		if len(got) != 2 || got[0] != a.ID || got[1] != b.ID {
+			t.Errorf("expected tenants sorted by ID, got %v", got)
+		}
+	})
+
+	t.Run("update tenant", func(t *testing.T) {
+		tenant := create(t)
+
+		tenant.SystemPrompt = "You are an IT helper."
+		tenant.Tools = nil
+		if err := store.UpdateTenant(ctx, tenant); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		got, err := store.DescribeTenant(ctx, tenant.ID)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if got.SystemPrompt != "You are an IT helper." || len(got.Tools) != 0 {
+			t.Errorf("unexpected tenant after update %+v", got)
+		}
+
+		expectCode(t, store.UpdateTenant(ctx, &model.Tenant{ID: uuid.New().String()}), twirp.NotFound)
+	})
+
+	t.Run("delete tenant", func(t *testing.T) {
+		tenant := create(t)
+
+		if err := store.DeleteTenant(ctx, tenant.ID); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		_, err := store.DescribeTenant(ctx, tenant.ID)
+		expectCode(t, err, twirp.NotFound)
+
+		expectCode(t, store.DeleteTenant(ctx, tenant.ID), twirp.NotFound)
+	})
+}
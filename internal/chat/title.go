@@ -0,0 +1,146 @@
+package chat
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"slices"
+	"time"
+
+	"github.com/acai-travel/tech-challenge/internal/chat/assistant"
+	"github.com/acai-travel/tech-challenge/internal/chat/model"
+	"github.com/acai-travel/tech-challenge/internal/webhook"
+)
+
+const (
+	// titleTimeout bounds the generation of titles, which may outlive the request starting the conversation.
+	titleTimeout = time.Minute
+
+	// titleSaveAttempts is how often saving a title is attempted when the conversation keeps being modified meanwhile.
+	titleSaveAttempts = 5
+
+	// webhookTitleUpdated is the type of the webhook events sent once a generated title was saved.
+	webhookTitleUpdated = "conversation.title_updated"
+)
+
+// WithWebhooks sends events about conversations to the subscribers of the sender, e.g. once their title was generated.
+func WithWebhooks(w *webhook.Sender) Option {
+	return func(s *Server) { s.webhooks = w }
+}
+
+// generatedTitle is the outcome of generating the title of a conversation.
+type generatedTitle struct {
+	title string
+	usage model.Usage
+	err   error
+}
+
+// generateTitle generates the title of a new conversation in the background, so it does not add to the latency of the
+// reply, and sends the outcome on the returned channel. Streaming callers are sent the title as soon as it is generated.
+func (s *Server) generateTitle(ctx context.Context, conv *model.Conversation, emit func(assistant.Event)) <-chan generatedTitle {
+	// replying adds messages to the conversation meanwhile
+	snapshot := *conv
+	snapshot.Messages = slices.Clone(conv.Messages)
+
+	titles := make(chan generatedTitle, 1)
+	go func() {
+		ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), titleTimeout)
+		defer cancel()
+
+		title, usage, err := s.assist.Title(ctx, &snapshot)
+		if usage != (model.Usage{}) {
+			// a failed title may still have been paid for
+			s.charge(ctx, &snapshot, &usage)
+		}
+		if err != nil {
+			slog.ErrorContext(ctx, "Failed to generate conversation title", "error", err)
+		} else if emit != nil {
+			emit(assistant.Event{Type: assistant.EventTitleUpdated, Title: title})
+		}
+
+		titles <- generatedTitle{title: title, usage: usage, err: err}
+	}()
+
+	return titles
+}
+
+// saveTitle saves the title of a conversation that was created while its title was pending. The title is dropped if
+// the conversation was renamed meanwhile, though what it cost is still recorded.
+func (s *Server) saveTitle(ctx context.Context, id string, titles <-chan generatedTitle) {
+	t := <-titles
+
+	for range titleSaveAttempts {
+		conv, err := s.repo.DescribeConversation(ctx, id)
+		if err != nil {
+			slog.WarnContext(ctx, "Failed to load conversation to save its title", "conversation_id", id, "error", err)
+			return
+		}
+
+		renamed := !conv.TitlePending
+		if renamed && t.err != nil {
+			return
+		}
+
+		conv.TitlePending = false
+		if t.err == nil {
+			title := conv.Title
+			conv.SetTitle(t.title, t.usage)
+			if renamed {
+				conv.Title = title
+			}
+		}
+
+		err = s.repo.UpdateConversation(ctx, conv)
+		if errors.Is(err, model.ErrConflict) {
+			continue
+		}
+		if err != nil {
+			slog.ErrorContext(ctx, "Failed to save conversation title", "conversation_id", id, "error", err)
+			return
+		}
+
+		if t.err == nil && !renamed {
+			s.notifyTitle(ctx, conv)
+		}
+		return
+	}
+
+	slog.ErrorContext(ctx, "Gave up saving conversation title, the conversation kept being modified", "conversation_id", id)
+}
+
+// notifyTitle tells webhook subscribers about the generated title of a conversation.
+func (s *Server) notifyTitle(ctx context.Context, conv *model.Conversation) {
+	if s.webhooks == nil {
+		return
+	}
+
+	s.webhooks.Send(ctx, webhookTitleUpdated, map[string]string{
+		"conversation_id": conv.ID.Hex(),
+		"owner_id":        conv.OwnerID,
+		"tenant_id":       conv.TenantID,
+		"title":           conv.Title,
+	})
+}
+
+// writeRetitled runs a write of the conversation and, should it conflict with its pending title being saved or the
+// conversation being renamed since it was read, carries the new title over and runs it again. Otherwise saving titles
+// in the background would fail the replies made to new conversations meanwhile.
+func (s *Server) writeRetitled(ctx context.Context, conv *model.Conversation, write func() error) error {
+	err := write()
+	if !errors.Is(err, model.ErrConflict) || !conv.TitlePending {
+		return err
+	}
+
+	latest, derr := s.repo.DescribeConversation(ctx, conv.ID.Hex())
+	if derr != nil || latest.TitlePending || latest.Version != conv.Version+1 {
+		return err
+	}
+
+	conv.Title, conv.TitlePending, conv.Version = latest.Title, false, latest.Version
+	if latest.TitleUsage != nil {
+		conv.TitleUsage = latest.TitleUsage
+		conv.Usage.Add(*latest.TitleUsage)
+	}
+
+	return write()
+}
@@ -0,0 +1,230 @@
+package chat
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/acai-travel/tech-challenge/internal/chat/assistant"
+	"github.com/acai-travel/tech-challenge/internal/chat/model"
+	. "github.com/acai-travel/tech-challenge/internal/chat/testing"
+	"github.com/acai-travel/tech-challenge/internal/pb"
+	"github.com/acai-travel/tech-challenge/internal/webhook"
+)
+
+// slowTitleAssistant holds titles back until they are released, and calls replying before every reply.
+type slowTitleAssistant struct {
+	Assistant
+	release  chan struct{}
+	replying func()
+}
+
+func (a *slowTitleAssistant) Reply(ctx context.Context, conv *model.Conversation) ([]*model.Message, error) {
+	if a.replying != nil {
+		a.replying()
+	}
+	return a.Assistant.Reply(ctx, conv)
+}
+
+func (a *slowTitleAssistant) Title(ctx context.Context, conv *model.Conversation) (string, model.Usage, error) {
+	<-a.release
+	return a.Assistant.Title(ctx, conv)
+}
+
+// awaitTitle describes the conversation once its title is no longer pending.
+func awaitTitle(t *testing.T, srv *Server, ctx context.Context, id string) *pb.DescribeConversationResponse {
+	t.Helper()
+
+	deadline := time.Now().Add(5 * time.Second)
+	for {
+		out, err := srv.DescribeConversation(ctx, &pb.DescribeConversationRequest{ConversationId: id})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if !out.GetConversation().GetTitlePending() {
+			return out
+		}
+
+		if time.Now().After(deadline) {
+			t.Fatal("expected the title to be generated")
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
+
+// splitRequests tells title requests apart from reply requests.
+func splitRequests(reqs []FakeRequest) (titles, replies []FakeRequest) {
+	for _, r := range reqs {
+		if r.IsTitle() {
+			titles = append(titles, r)
+		} else {
+			replies = append(replies, r)
+		}
+	}
+	return titles, replies
+}
+
+func TestServer_BackgroundTitle(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("titles generated after the reply are saved in the background and sent to webhooks", WithFixture(func(t *testing.T, f *Fixture) {
+		events := make(chan webhook.Event, 1)
+		subscriber := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			var e webhook.Event
+			body, _ := io.ReadAll(r.Body)
+			_ = json.Unmarshal(body, &e)
+			events <- e
+		}))
+		defer subscriber.Close()
+
+		openai := StartFakeOpenAI(t).Title("Weather today").Reply("It is sunny.")
+		assist := &slowTitleAssistant{Assistant: assistant.New(assistant.WithBaseURL(openai.URL)), release: make(chan struct{})}
+		srv := NewServer(f.ConversationStore, assist, WithWebhooks(webhook.New([]string{subscriber.URL}, "")))
+
+		out, err := srv.StartConversation(ctx, &pb.StartConversationRequest{Message: "What is the weather like?"})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		f.Cleanup(out.GetConversationId())
+
+		if !out.GetTitlePending() || out.GetTitle() != "Untitled conversation" || out.GetReply() != "It is sunny." {
+			t.Fatalf("expected the reply with the title pending, got %v", out)
+		}
+
+		described, err := srv.DescribeConversation(ctx, &pb.DescribeConversationRequest{ConversationId: out.GetConversationId()})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if !described.GetConversation().GetTitlePending() {
+			t.Errorf("expected the conversation to have its title pending, got %v", described.GetConversation())
+		}
+
+		close(assist.release)
+
+		if title := awaitTitle(t, srv, ctx, out.GetConversationId()).GetConversation().GetTitle(); title != "Weather today" {
+			t.Errorf("expected the generated title to be saved, got %q", title)
+		}
+
+		select {
+		case e := <-events:
+			data, _ := e.Data.(map[string]any)
+			if e.Type != "conversation.title_updated" || data["conversation_id"] != out.GetConversationId() || data["title"] != "Weather today" {
+				t.Errorf("unexpected webhook event: %+v", e)
+			}
+		case <-time.After(5 * time.Second):
+			t.Fatal("expected a webhook event")
+		}
+	}))
+
+	t.Run("forks made while the title is pending get a title of their own", WithFixture(func(t *testing.T, f *Fixture) {
+		openai := StartFakeOpenAI(t).Title("Weather today").Reply("It is sunny.").Title("Weather today")
+		assist := &slowTitleAssistant{Assistant: assistant.New(assistant.WithBaseURL(openai.URL)), release: make(chan struct{})}
+		srv := NewServer(f.ConversationStore, assist)
+
+		out, err := srv.StartConversation(ctx, &pb.StartConversationRequest{Message: "What is the weather like?"})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		f.Cleanup(out.GetConversationId())
+
+		described, err := srv.DescribeConversation(ctx, &pb.DescribeConversationRequest{ConversationId: out.GetConversationId()})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		fork, err := srv.ForkConversation(ctx, &pb.ForkConversationRequest{
+			ConversationId: out.GetConversationId(),
+			MessageId:      described.GetConversation().GetMessages()[0].GetId(),
+		})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		f.Cleanup(fork.GetConversation().GetId())
+
+		if !fork.GetConversation().GetTitlePending() {
+			t.Errorf("expected the fork to have its title pending, got %v", fork.GetConversation())
+		}
+
+		close(assist.release)
+
+		for _, id := range []string{out.GetConversationId(), fork.GetConversation().GetId()} {
+			if title := awaitTitle(t, srv, ctx, id).GetConversation().GetTitle(); title != "Weather today" {
+				t.Errorf("expected the generated title to be saved to %s, got %q", id, title)
+			}
+		}
+	}))
+
+	t.Run("renaming the conversation first keeps its new title", WithFixture(func(t *testing.T, f *Fixture) {
+		openai := StartFakeOpenAI(t).Title("Weather today").WithUsage(10, 5).Reply("It is sunny.")
+		assist := &slowTitleAssistant{Assistant: assistant.New(assistant.WithBaseURL(openai.URL)), release: make(chan struct{})}
+		srv := NewServer(f.ConversationStore, assist)
+
+		out, err := srv.StartConversation(ctx, &pb.StartConversationRequest{Message: "What is the weather like?"})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		f.Cleanup(out.GetConversationId())
+
+		_, err = srv.UpdateConversationTitle(ctx, &pb.UpdateConversationTitleRequest{ConversationId: out.GetConversationId(), Title: "My trip"})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		close(assist.release)
+
+		// the title usage is still recorded once generated
+		deadline := time.Now().Add(5 * time.Second)
+		for {
+			conv, err := f.DescribeConversation(ctx, out.GetConversationId())
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if conv.TitleUsage != nil {
+				if conv.Title != "My trip" || conv.TitlePending {
+					t.Errorf("expected the new title to be kept, got %q", conv.Title)
+				}
+				break
+			}
+
+			if time.Now().After(deadline) {
+				t.Fatal("expected the title usage to be recorded")
+			}
+			time.Sleep(10 * time.Millisecond)
+		}
+	}))
+	t.Run("replies are saved when the title is saved while replying", WithFixture(func(t *testing.T, f *Fixture) {
+		openai := StartFakeOpenAI(t).Title("Weather today").WithUsage(10, 5).Reply("It is sunny.").Reply("It will rain.")
+		assist := &slowTitleAssistant{Assistant: assistant.New(assistant.WithBaseURL(openai.URL)), release: make(chan struct{})}
+		srv := NewServer(f.ConversationStore, assist)
+
+		out, err := srv.StartConversation(ctx, &pb.StartConversationRequest{Message: "What is the weather like?"})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		f.Cleanup(out.GetConversationId())
+
+		assist.replying = func() {
+			close(assist.release)
+			awaitTitle(t, srv, ctx, out.GetConversationId())
+		}
+
+		if _, err := srv.ContinueConversation(ctx, &pb.ContinueConversationRequest{ConversationId: out.GetConversationId(), Message: "And tomorrow?"}); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		conv, err := f.DescribeConversation(ctx, out.GetConversationId())
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if conv.Title != "Weather today" || conv.TitlePending || len(conv.Messages) != 4 || conv.Usage.PromptTokens != 10 {
+			t.Errorf("expected the title and the reply to be saved, got %q with %d messages and usage %+v", conv.Title, len(conv.Messages), conv.Usage)
+		}
+	}))
+}
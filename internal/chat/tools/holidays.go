@@ -2,7 +2,6 @@ package tools
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
 	"log/slog"
 	"os"
@@ -10,7 +9,6 @@ import (
 	"time"
 
 	ics "github.com/arran4/golang-ical"
-	"github.com/openai/openai-go/v2"
 )
 
 func loadCalendar(ctx context.Context, link string) ([]*ics.VEvent, error) {
@@ -25,35 +23,15 @@ func loadCalendar(ctx context.Context, link string) ([]*ics.VEvent, error) {
 }
 
 type HolidayArgs struct {
+	BeforeDate time.Time `json:"before_date,omitzero" description:"Optional date in RFC3339 format to get holidays before this date. If not provided, all holidays will be returned."`
+	AfterDate  time.Time `json:"after_date,omitzero" description:"Optional date in RFC3339 format to get holidays after this date. If not provided, all holidays will be returned."`
+	MaxCount   int       `json:"max_count,omitempty" description:"Optional maximum number of holidays to return. If not provided, all holidays will be returned."`
 }
 
-type HolidayTool struct{}
+// HolidayTool lists the holidays of the calendar at HOLIDAY_CALENDAR_LINK, Catalonia's by default.
+var HolidayTool = Define("get_holidays", "Gets local bank and public holidays. Each line is a single holiday in the format 'YYYY-MM-DD: Holiday Name'.", getHolidays)
 
-func (w HolidayTool) Name() string { return "get_holidays" }
-func (w HolidayTool) Description() string {
-	return "Gets local bank and public holidays. Each line is a single holiday in the format 'YYYY-MM-DD: Holiday Name'."
-}
-func (w HolidayTool) Parameters() openai.FunctionParameters {
-	return openai.FunctionParameters{
-		"type": "object",
-		"properties": map[string]any{
-			"before_date": map[string]string{
-				"type":        "string",
-				"description": "Optional date in RFC3339 format to get holidays before this date. If not provided, all holidays will be returned.",
-			},
-			"after_date": map[string]string{
-				"type":        "string",
-				"description": "Optional date in RFC3339 format to get holidays after this date. If not provided, all holidays will be returned.",
-			},
-			"max_count": map[string]string{
-				"type":        "integer",
-				"description": "Optional maximum number of holidays to return. If not provided, all holidays will be returned.",
-			},
-		},
-	}
-}
-
-func (w HolidayTool) Handle(ctx context.Context, args json.RawMessage) (string, error) {
+func getHolidays(ctx context.Context, args HolidayArgs) (string, error) {
 	link := "https://www.officeholidays.com/ics/spain/catalonia"
 	if v := os.Getenv("HOLIDAY_CALENDAR_LINK"); v != "" {
 		link = v
@@ -64,17 +42,6 @@ func (w HolidayTool) Handle(ctx context.Context, args json.RawMessage) (string,
 		return "failed to load holiday events", err
 	}
 
-	var payload struct {
-		BeforeDate time.Time `json:"before_date,omitempty"`
-		AfterDate  time.Time `json:"after_date,omitempty"`
-		MaxCount   int       `json:"max_count,omitempty"`
-	}
-
-	var holidayArgs HolidayArgs
-	if err := json.Unmarshal(args, &holidayArgs); err != nil {
-		return "failed to parse tool call arguments: " + err.Error(), err
-	}
-
 	var holidays []string
 	for _, event := range events {
 		date, err := event.GetAllDayStartAt()
@@ -82,15 +49,15 @@ func (w HolidayTool) Handle(ctx context.Context, args json.RawMessage) (string,
 			continue
 		}
 
-		if payload.MaxCount > 0 && len(holidays) >= payload.MaxCount {
+		if args.MaxCount > 0 && len(holidays) >= args.MaxCount {
 			break
 		}
 
-		if !payload.BeforeDate.IsZero() && date.After(payload.BeforeDate) {
+		if !args.BeforeDate.IsZero() && date.After(args.BeforeDate) {
 			continue
 		}
 
-		if !payload.AfterDate.IsZero() && date.Before(payload.AfterDate) {
+		if !args.AfterDate.IsZero() && date.Before(args.AfterDate) {
 			continue
 		}
 
@@ -101,5 +68,5 @@ func (w HolidayTool) Handle(ctx context.Context, args json.RawMessage) (string,
 }
 
 func init() {
-	Register(HolidayTool{})
+	Register(HolidayTool)
 }
@@ -0,0 +1,144 @@
+package mcp
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"maps"
+	"os"
+	"slices"
+	"time"
+)
+
+// Config is the JSON file listing the MCP servers whose tools the assistant may call, by name, e.g.
+//
+//	{
+//	  "servers": {
+//	    "files": {
+//	      "command": "npx",
+//	      "args": ["-y", "@modelcontextprotocol/server-filesystem", "/srv/docs"],
+//	      "required": true
+//	    },
+//	    "flights": {
+//	      "url": "https://mcp.example.com/flights",
+//	      "headers": {"Authorization": "Bearer ${FLIGHTS_API_KEY}"},
+//	      "timeout": "20s",
+//	      "require_approval": true
+//	    }
+//	  }
+//	}
+//
+// Servers with a command run as subprocesses, the others are reached over streamable HTTP. Environment variables are
+// expanded in env and header values, so secrets do not need to be written in the file.
+type Config struct {
+	Servers map[string]ServerConfig `json:"servers"`
+}
+
+// ServerConfig describes how to reach an MCP server, and how its tools are called.
+type ServerConfig struct {
+	Command string            `json:"command"`
+	Args    []string          `json:"args"`
+	Env     map[string]string `json:"env"`
+
+	URL     string            `json:"url"`
+	Headers map[string]string `json:"headers"`
+
+	// Timeout bounds the calls to the server's tools, instead of tools.DefaultTimeout.
+	Timeout string `json:"timeout"`
+	// RequireApproval makes the calls to the server's tools wait for the user's approval.
+	RequireApproval bool `json:"require_approval"`
+	// Required keeps the assistant from starting without the server's tools, see RegisterFromEnv.
+	Required bool `json:"required"`
+}
+
+func (c ServerConfig) validate() error {
+	switch {
+	case c.Command == "" && c.URL == "":
+		return errors.New("either a command or a URL is required")
+	case c.Command != "" && c.URL != "":
+		return errors.New("a command and a URL are mutually exclusive")
+	}
+
+	if _, err := c.timeout(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (c ServerConfig) timeout() (time.Duration, error) {
+	if c.Timeout == "" {
+		return 0, nil
+	}
+
+	d, err := time.ParseDuration(c.Timeout)
+	if err != nil || d <= 0 {
+		return 0, fmt.Errorf("invalid timeout %q", c.Timeout)
+	}
+	return d, nil
+}
+
+// LoadConfig reads the config file at path, see Config.
+func LoadConfig(path string) (Config, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return Config{}, fmt.Errorf("failed to read MCP config file: %w", err)
+	}
+
+	var c Config
+	if err := json.Unmarshal(data, &c); err != nil {
+		return Config{}, fmt.Errorf("failed to parse MCP config file %s: %w", path, err)
+	}
+
+	for name, server := range c.Servers {
+		if err := server.validate(); err != nil {
+			return Config{}, fmt.Errorf("invalid MCP server %s in %s: %w", name, path, err)
+		}
+	}
+
+	return c, nil
+}
+
+// RegisterFromEnv registers the tools of the MCP servers listed in the config file at MCP_CONFIG_FILE, see Config, and
+// returns their clients. It does nothing when MCP_CONFIG_FILE is not set. Servers that cannot be reached are logged and
+// skipped, so they do not keep the assistant from starting, unless they are required: RegisterFromEnv then fails, after
+// closing the clients registered so far. Skipped servers are not retried, restart the assistant once they are back.
+func RegisterFromEnv(ctx context.Context) ([]*Client, error) {
+	path := os.Getenv("MCP_CONFIG_FILE")
+	if path == "" {
+		return nil, nil
+	}
+
+	config, err := LoadConfig(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var clients []*Client
+	for _, name := range slices.Sorted(maps.Keys(config.Servers)) {
+		c, err := New(name, config.Servers[name])
+		if err != nil {
+			return nil, err
+		}
+
+		registered, err := Register(ctx, c)
+		if err != nil {
+			_ = c.Close()
+			if config.Servers[name].Required {
+				for _, c := range clients {
+					_ = c.Close()
+				}
+				return nil, fmt.Errorf("failed to register the tools of required MCP server %s: %w", name, err)
+			}
+			slog.ErrorContext(ctx, "Failed to register the tools of MCP server", "mcp_server", name, "error", err)
+			continue
+		}
+
+		slog.InfoContext(ctx, "Registered the tools of MCP server", "mcp_server", name, "tools", len(registered))
+		clients = append(clients, c)
+	}
+
+	return clients, nil
+}
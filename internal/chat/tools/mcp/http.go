@@ -0,0 +1,257 @@
+package mcp
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"mime"
+	"net/http"
+	"os"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	sessionHeader  = "Mcp-Session-Id"
+	protocolHeader = "MCP-Protocol-Version"
+
+	// notifyTimeout bounds the notifications sent once the request they are about is over
+	notifyTimeout = 5 * time.Second
+)
+
+// httpConn is a server reached over streamable HTTP: every message is POSTed to its endpoint, which responds with JSON or
+// with a stream of server-sent events ending with the response. The session the server assigns at initialization is
+// kept until the server forgets it.
+type httpConn struct {
+	name    string
+	url     string
+	headers map[string]string
+	client  *http.Client
+
+	mu       sync.Mutex
+	session  string
+	protocol string
+	lost     chan struct{}
+	once     sync.Once
+}
+
+func dialHTTP(name string, config ServerConfig) *httpConn {
+	headers := map[string]string{}
+	for k, v := range config.Headers {
+		headers[k] = os.ExpandEnv(v)
+	}
+
+	return &httpConn{
+		name:    name,
+		url:     config.URL,
+		headers: headers,
+		// calls are bounded by the context of the tool call, streamed responses must not be cut short
+		client: &http.Client{},
+		lost:   make(chan struct{}),
+	}
+}
+
+func (c *httpConn) call(ctx context.Context, req *message) (*message, error) {
+	resp, err := c.post(ctx, req)
+	if err != nil {
+		c.abandon(ctx, req)
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusAccepted {
+		return nil, fmt.Errorf("mcp: no response to %s", req.Method)
+	}
+
+	if session := resp.Header.Get(sessionHeader); session != "" && req.Method == "initialize" {
+		c.mu.Lock()
+		c.session = session
+		c.mu.Unlock()
+	}
+
+	var m *message
+	mediaType, _, _ := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+	switch mediaType {
+	case "application/json":
+		m = &message{}
+		if err := json.NewDecoder(resp.Body).Decode(m); err != nil {
+			return nil, fmt.Errorf("mcp: invalid response to %s: %w", req.Method, err)
+		}
+	case "text/event-stream":
+		if m, err = c.await(ctx, resp.Body, req); err != nil {
+			c.abandon(ctx, req)
+			return nil, err
+		}
+	default:
+		return nil, fmt.Errorf("mcp: unexpected content type %q in response to %s", mediaType, req.Method)
+	}
+
+	if req.Method == "initialize" && m.Result != nil {
+		var result struct {
+			ProtocolVersion string `json:"protocolVersion"`
+		}
+		_ = json.Unmarshal(m.Result, &result)
+
+		c.mu.Lock()
+		c.protocol = result.ProtocolVersion
+		c.mu.Unlock()
+	}
+
+	return m, nil
+}
+
+// abandon tells the server the client stopped waiting for the request, when its context ended.
+func (c *httpConn) abandon(ctx context.Context, req *message) {
+	cause := ctx.Err()
+	if cause == nil {
+		return
+	}
+
+	go func() {
+		ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), notifyTimeout)
+		defer cancel()
+		_ = c.notify(ctx, cancelled(req, cause))
+	}()
+}
+
+func (c *httpConn) notify(ctx context.Context, m *message) error {
+	resp, err := c.post(ctx, m)
+	if err != nil {
+		return err
+	}
+	resp.Body.Close()
+	return nil
+}
+
+func (c *httpConn) done() <-chan struct{} {
+	return c.lost
+}
+
+// close ends the session, so the server can release it.
+func (c *httpConn) close() error {
+	c.once.Do(func() { close(c.lost) })
+
+	c.mu.Lock()
+	session := c.session
+	c.session = ""
+	c.mu.Unlock()
+
+	if session == "" {
+		return nil
+	}
+
+	req, err := http.NewRequest(http.MethodDelete, c.url, nil)
+	if err != nil {
+		return err
+	}
+	c.setHeaders(req, session, "")
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return err
+	}
+	resp.Body.Close()
+	return nil
+}
+
+func (c *httpConn) post(ctx context.Context, m *message) (*http.Response, error) {
+	body, err := json.Marshal(m)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.url, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+
+	c.mu.Lock()
+	session, protocol := c.session, c.protocol
+	c.mu.Unlock()
+
+	c.setHeaders(req, session, protocol)
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Accept", "application/json, text/event-stream")
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+
+	switch {
+	case resp.StatusCode == http.StatusNotFound && session != "":
+		// the server forgot the session, e.g. because it restarted, the request must be sent again in a new one
+		resp.Body.Close()
+		c.once.Do(func() { close(c.lost) })
+		return nil, fmt.Errorf("%w: session expired", errNotDelivered)
+	case resp.StatusCode >= 300:
+		defer resp.Body.Close()
+		b, _ := io.ReadAll(io.LimitReader(resp.Body, 512))
+		return nil, fmt.Errorf("mcp: %s responded with %s: %s", m.Method, resp.Status, strings.TrimSpace(string(b)))
+	}
+
+	return resp, nil
+}
+
+func (c *httpConn) setHeaders(req *http.Request, session, protocol string) {
+	for k, v := range c.headers {
+		req.Header.Set(k, v)
+	}
+	if session != "" {
+		req.Header.Set(sessionHeader, session)
+	}
+	if protocol != "" {
+		req.Header.Set(protocolHeader, protocol)
+	}
+}
+
+// await reads the events of the stream until the response to req, answering the requests the server sends meanwhile.
+func (c *httpConn) await(ctx context.Context, stream io.Reader, req *message) (*message, error) {
+	r := bufio.NewReader(stream)
+
+	var data []string
+	for {
+		line, err := r.ReadString('\n')
+		if err != nil && (!errors.Is(err, io.EOF) || line == "") {
+			if errors.Is(err, io.EOF) {
+				err = ErrClosed
+			}
+			return nil, fmt.Errorf("mcp: stream of %s ended without a response: %w", req.Method, err)
+		}
+
+		line = strings.TrimRight(line, "\r\n")
+		if v, ok := strings.CutPrefix(line, "data:"); ok {
+			data = append(data, strings.TrimPrefix(v, " "))
+			continue
+		}
+		if line != "" || len(data) == 0 {
+			// event names, IDs and comments are not needed to find the response
+			continue
+		}
+
+		var m message
+		err = json.Unmarshal([]byte(strings.Join(data, "\n")), &m)
+		data = nil
+		if err != nil {
+			slog.WarnContext(ctx, "Invalid message from MCP server", "mcp_server", c.name, "error", err)
+			continue
+		}
+
+		switch {
+		case m.isRequest():
+			if err := c.notify(ctx, answer(&m)); err != nil {
+				slog.WarnContext(ctx, "Failed to answer MCP server", "mcp_server", c.name, "method", m.Method, "error", err)
+			}
+		case m.isNotification():
+			slog.DebugContext(ctx, "Notification from MCP server", "mcp_server", c.name, "method", m.Method)
+		case bytes.Equal(m.ID, req.ID):
+			return &m, nil
+		}
+	}
+}
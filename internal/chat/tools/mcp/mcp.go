@@ -0,0 +1,382 @@
+// Package mcp is a Model Context Protocol client, offering the tools of MCP servers to the assistant next to the
+// built-in ones. Servers run as subprocesses speaking over their standard input and output, or are reached over
+// streamable HTTP.
+package mcp
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// ProtocolVersion is the revision of the protocol the client speaks.
+const ProtocolVersion = "2025-06-18"
+
+const (
+	defaultBackoff    = time.Second
+	defaultMaxBackoff = 30 * time.Second
+)
+
+var (
+	// ErrClosed is returned for the requests in flight when the connection to the server is lost.
+	ErrClosed = errors.New("mcp: connection closed")
+
+	// ErrUnavailable is returned while the client waits to reconnect to a server it failed to connect to.
+	ErrUnavailable = errors.New("mcp: server unavailable")
+
+	// errNotDelivered is returned when a request did not reach the server, so it can be sent again once reconnected.
+	errNotDelivered = errors.New("mcp: request not delivered")
+)
+
+// message is a JSON-RPC message, either a request, a notification or a response.
+type message struct {
+	JSONRPC string          `json:"jsonrpc"`
+	ID      json.RawMessage `json:"id,omitempty"`
+	Method  string          `json:"method,omitempty"`
+	Params  json.RawMessage `json:"params,omitempty"`
+	Result  json.RawMessage `json:"result,omitempty"`
+	Error   *RPCError       `json:"error,omitempty"`
+}
+
+func newMessage(id json.RawMessage, method string, params any) (*message, error) {
+	m := &message{JSONRPC: "2.0", ID: id, Method: method}
+	if params != nil {
+		b, err := json.Marshal(params)
+		if err != nil {
+			return nil, err
+		}
+		m.Params = b
+	}
+	return m, nil
+}
+
+func (m *message) isRequest() bool      { return m.Method != "" && m.ID != nil }
+func (m *message) isNotification() bool { return m.Method != "" && m.ID == nil }
+
+// RPCError is the error of a request the server failed.
+type RPCError struct {
+	Code    int             `json:"code"`
+	Message string          `json:"message"`
+	Data    json.RawMessage `json:"data,omitempty"`
+}
+
+func (e *RPCError) Error() string {
+	return fmt.Sprintf("mcp: %s (code %d)", e.Message, e.Code)
+}
+
+// answer replies to a request of the server. The client offers no capabilities, so it only answers pings.
+func answer(req *message) *message {
+	if req.Method == "ping" {
+		return &message{JSONRPC: "2.0", ID: req.ID, Result: json.RawMessage("{}")}
+	}
+	return &message{JSONRPC: "2.0", ID: req.ID, Error: &RPCError{Code: -32601, Message: "method not found: " + req.Method}}
+}
+
+// conn is a connection to a server, established by a transport.
+type conn interface {
+	// call sends the request and waits for its response.
+	call(ctx context.Context, req *message) (*message, error)
+	// notify sends the notification.
+	notify(ctx context.Context, m *message) error
+	// done is closed once the connection is lost.
+	done() <-chan struct{}
+	close() error
+}
+
+// Client is connected to a single server. It connects on first use, and reconnects once the connection is lost: a
+// server failing to start, or to respond to the handshake, is retried after an exponential backoff.
+type Client struct {
+	name       string
+	config     ServerConfig
+	dial       func(ctx context.Context) (conn, error)
+	backoff    time.Duration
+	maxBackoff time.Duration
+	now        func() time.Time
+	ids        atomic.Int64
+
+	mu       sync.Mutex
+	conn     conn
+	failures int
+	retryAt  time.Time
+	lastErr  error
+}
+
+type Option func(*Client)
+
+// WithReconnectBackoff sets how long the client waits before connecting again to a server it failed to connect to,
+// doubled for every failure in a row up to max.
+func WithReconnectBackoff(base, max time.Duration) Option {
+	return func(c *Client) {
+		c.backoff = base
+		c.maxBackoff = max
+	}
+}
+
+// New creates a client for the server named name, see ServerConfig. It does not connect yet.
+func New(name string, config ServerConfig, opts ...Option) (*Client, error) {
+	if err := config.validate(); err != nil {
+		return nil, fmt.Errorf("invalid MCP server %s: %w", name, err)
+	}
+
+	c := &Client{
+		name:       name,
+		config:     config,
+		backoff:    defaultBackoff,
+		maxBackoff: defaultMaxBackoff,
+		now:        time.Now,
+	}
+
+	if config.URL != "" {
+		c.dial = func(ctx context.Context) (conn, error) { return dialHTTP(name, config), nil }
+	} else {
+		c.dial = func(ctx context.Context) (conn, error) { return dialStdio(name, config) }
+	}
+
+	for _, opt := range opts {
+		opt(c)
+	}
+
+	return c, nil
+}
+
+// Name returns the name of the server the client is connected to.
+func (c *Client) Name() string {
+	return c.name
+}
+
+// ToolInfo describes a tool offered by a server.
+type ToolInfo struct {
+	Name        string         `json:"name"`
+	Description string         `json:"description"`
+	InputSchema map[string]any `json:"inputSchema"`
+}
+
+// ListTools returns every tool the server offers.
+func (c *Client) ListTools(ctx context.Context) ([]ToolInfo, error) {
+	var all []ToolInfo
+
+	params := map[string]any{}
+	for {
+		var page struct {
+			Tools      []ToolInfo `json:"tools"`
+			NextCursor string     `json:"nextCursor"`
+		}
+		if err := c.call(ctx, "tools/list", params, &page); err != nil {
+			return nil, err
+		}
+
+		all = append(all, page.Tools...)
+		if page.NextCursor == "" {
+			return all, nil
+		}
+		params = map[string]any{"cursor": page.NextCursor}
+	}
+}
+
+// content is an item of the result of a tool call.
+type content struct {
+	Type     string `json:"type"`
+	Text     string `json:"text"`
+	MimeType string `json:"mimeType"`
+	Resource struct {
+		URI  string `json:"uri"`
+		Text string `json:"text"`
+	} `json:"resource"`
+}
+
+// CallTool calls the tool named name with the JSON encoded arguments, and returns its result as text. A failure reported
+// by the tool is returned as an error holding its result, for the model to read.
+func (c *Client) CallTool(ctx context.Context, name string, args json.RawMessage) (string, error) {
+	if len(strings.TrimSpace(string(args))) == 0 {
+		args = json.RawMessage("{}")
+	}
+
+	var result struct {
+		Content           []content       `json:"content"`
+		StructuredContent json.RawMessage `json:"structuredContent"`
+		IsError           bool            `json:"isError"`
+	}
+	if err := c.call(ctx, "tools/call", map[string]any{"name": name, "arguments": args}, &result); err != nil {
+		return "", err
+	}
+
+	var parts []string
+	for _, item := range result.Content {
+		switch {
+		case item.Type == "text":
+			parts = append(parts, item.Text)
+		case item.Type == "resource" && item.Resource.Text != "":
+			parts = append(parts, item.Resource.Text)
+		case item.Type == "resource":
+			parts = append(parts, fmt.Sprintf("[resource %s]", item.Resource.URI))
+		default:
+			// images and audio cannot be passed on to the model as a tool result
+			parts = append(parts, fmt.Sprintf("[%s %s omitted]", item.Type, item.MimeType))
+		}
+	}
+
+	text := strings.Join(parts, "\n")
+	if text == "" && len(result.StructuredContent) > 0 {
+		text = string(result.StructuredContent)
+	}
+
+	if result.IsError {
+		if text == "" {
+			text = "the tool failed without a reason"
+		}
+		return "", errors.New(text)
+	}
+
+	return text, nil
+}
+
+// Close closes the connection to the server, the client connects again if it is used afterwards.
+func (c *Client) Close() error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.conn == nil {
+		return nil
+	}
+
+	err := c.conn.close()
+	c.conn = nil
+	return err
+}
+
+// call sends a request to the server and decodes its result into result. Requests the server did not get, because the
+// connection was lost or its session expired, are sent again once reconnected.
+func (c *Client) call(ctx context.Context, method string, params, result any) error {
+	for attempt := 0; ; attempt++ {
+		cn, err := c.connect(ctx)
+		if err != nil {
+			return err
+		}
+
+		err = c.request(ctx, cn, method, params, result)
+		if errors.Is(err, errNotDelivered) || errors.Is(err, ErrClosed) {
+			c.drop(cn)
+		}
+		if errors.Is(err, errNotDelivered) && attempt == 0 {
+			slog.WarnContext(ctx, "MCP request not delivered, reconnecting", "mcp_server", c.name, "method", method, "error", err)
+			continue
+		}
+		return err
+	}
+}
+
+func (c *Client) request(ctx context.Context, cn conn, method string, params, result any) error {
+	req, err := newMessage(json.RawMessage(strconv.FormatInt(c.ids.Add(1), 10)), method, params)
+	if err != nil {
+		return err
+	}
+
+	resp, err := cn.call(ctx, req)
+	if err != nil {
+		return err
+	}
+
+	if resp.Error != nil {
+		return resp.Error
+	}
+
+	if result != nil {
+		if err := json.Unmarshal(resp.Result, result); err != nil {
+			return fmt.Errorf("mcp: invalid result of %s: %w", method, err)
+		}
+	}
+
+	return nil
+}
+
+// connect returns the connection to the server, establishing it if it was never established or got lost.
+func (c *Client) connect(ctx context.Context) (conn, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.conn != nil {
+		select {
+		case <-c.conn.done():
+			slog.WarnContext(ctx, "MCP server connection lost, reconnecting", "mcp_server", c.name)
+			_ = c.conn.close()
+			c.conn = nil
+		default:
+			return c.conn, nil
+		}
+	}
+
+	if wait := c.retryAt.Sub(c.now()); wait > 0 {
+		return nil, fmt.Errorf("%w: %s, retrying in %s: %v", ErrUnavailable, c.name, wait.Round(time.Millisecond), c.lastErr)
+	}
+
+	cn, err := c.handshake(ctx)
+	if err != nil {
+		c.failures++
+		c.retryAt = c.now().Add(min(c.backoff<<(c.failures-1), c.maxBackoff))
+		c.lastErr = err
+		return nil, fmt.Errorf("failed to connect to MCP server %s: %w", c.name, err)
+	}
+
+	c.conn = cn
+	c.failures = 0
+	c.retryAt = time.Time{}
+	return cn, nil
+}
+
+// handshake connects to the server and initializes the session.
+func (c *Client) handshake(ctx context.Context) (conn, error) {
+	cn, err := c.dial(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var result struct {
+		ProtocolVersion string `json:"protocolVersion"`
+		ServerInfo      struct {
+			Name    string `json:"name"`
+			Version string `json:"version"`
+		} `json:"serverInfo"`
+	}
+	err = c.request(ctx, cn, "initialize", map[string]any{
+		"protocolVersion": ProtocolVersion,
+		"capabilities":    map[string]any{},
+		"clientInfo":      map[string]any{"name": "acai-chat", "version": "1.0.0"},
+	}, &result)
+	if err == nil {
+		var initialized *message
+		if initialized, err = newMessage(nil, "notifications/initialized", nil); err == nil {
+			err = cn.notify(ctx, initialized)
+		}
+	}
+	if err != nil {
+		_ = cn.close()
+		return nil, err
+	}
+
+	slog.InfoContext(ctx, "Connected to MCP server", "mcp_server", c.name, "server", result.ServerInfo.Name, "version", result.ServerInfo.Version, "protocol_version", result.ProtocolVersion)
+	return cn, nil
+}
+
+// drop forgets the connection if it is still the current one, so the next request reconnects.
+func (c *Client) drop(cn conn) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.conn == cn {
+		_ = cn.close()
+		c.conn = nil
+	}
+}
+
+// cancelled notifies the server that the client no longer waits for the request, e.g. because the tool call timed out.
+func cancelled(req *message, cause error) *message {
+	m, _ := newMessage(nil, "notifications/cancelled", map[string]any{"requestId": req.ID, "reason": cause.Error()})
+	return m
+}
@@ -0,0 +1,382 @@
+package mcp
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"slices"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/acai-travel/tech-challenge/internal/chat/tools"
+)
+
+// serverBin is the test MCP server, see testdata/server.
+var serverBin string
+
+func TestMain(m *testing.M) {
+	dir, err := os.MkdirTemp("", "mcp")
+	if err != nil {
+		panic(err)
+	}
+
+	serverBin = filepath.Join(dir, "server")
+	if out, err := exec.Command("go", "build", "-o", serverBin, "./testdata/server").CombinedOutput(); err != nil {
+		panic(fmt.Sprintf("failed to build the test server: %v\n%s", err, out))
+	}
+
+	code := m.Run()
+	_ = os.RemoveAll(dir)
+	os.Exit(code)
+}
+
+func newClient(t *testing.T, name string, config ServerConfig, opts ...Option) *Client {
+	c, err := New(name, config, opts...)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	t.Cleanup(func() { _ = c.Close() })
+	return c
+}
+
+// register registers the tools of the client for the duration of the test.
+func register(t *testing.T, c *Client) []*Tool {
+	list, err := Register(context.Background(), c)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	t.Cleanup(func() {
+		for _, tool := range list {
+			delete(tools.Registry, tool.Name())
+		}
+	})
+	return list
+}
+
+func call(t *testing.T, name, args string) string {
+	out, err := tools.Registry[name].Handle(context.Background(), json.RawMessage(args))
+	if err != nil {
+		t.Fatalf("unexpected error calling %s: %v", name, err)
+	}
+	return out
+}
+
+func TestClient_Stdio(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("tools are listed across pages and registered", func(t *testing.T) {
+		c := newClient(t, "local", ServerConfig{Command: serverBin})
+		list := register(t, c)
+
+		var names []string
+		for _, tool := range list {
+			names = append(names, tool.Name())
+		}
+		if want := []string{"local_echo", "local_env", "local_pid", "local_fail", "local_sleep", "local_crash"}; !slices.Equal(names, want) {
+			t.Fatalf("expected tools %v, got %v", want, names)
+		}
+
+		if out := call(t, "local_echo", `{"text": "hi"}`); out != "hi" {
+			t.Errorf("expected the call to reach the server, got %q", out)
+		}
+
+		if p := tools.Registry["local_pid"].Parameters(); p["type"] != "object" || p["properties"] == nil {
+			t.Errorf("expected object parameters with properties, got %v", p)
+		}
+
+		if _, err := Register(ctx, c); err == nil {
+			t.Errorf("expected registered tools not to be replaced")
+		}
+	})
+
+	t.Run("tools whose names clash are told apart", func(t *testing.T) {
+		c := newClient(t, "local", ServerConfig{Command: serverBin, Env: map[string]string{"CLASHING_TOOLS": "1"}})
+		list := register(t, c)
+
+		first, second := list[len(list)-2], list[len(list)-1]
+		if want := ToolName("local", first.remote); first.Name() != want || second.Name() != want[:62]+"_2" {
+			t.Fatalf("expected the second tool to be suffixed, got %s and %s", first.Name(), second.Name())
+		}
+
+		if tools.Registry[second.Name()] != second {
+			t.Errorf("expected both tools to be registered")
+		}
+	})
+
+	t.Run("failures reported by a tool are errors", func(t *testing.T) {
+		c := newClient(t, "local", ServerConfig{Command: serverBin})
+
+		if _, err := c.CallTool(ctx, "fail", nil); err == nil || err.Error() != "something broke" {
+			t.Errorf("expected the failure to be returned, got %v", err)
+		}
+
+		var rpcErr *RPCError
+		if _, err := c.CallTool(ctx, "missing", nil); !errors.As(err, &rpcErr) || rpcErr.Code != -32602 {
+			t.Errorf("expected the protocol error to be returned, got %v", err)
+		}
+	})
+
+	t.Run("the server is restarted once it exits", func(t *testing.T) {
+		c := newClient(t, "local", ServerConfig{Command: serverBin})
+
+		before, err := c.CallTool(ctx, "pid", nil)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if _, err := c.CallTool(ctx, "crash", nil); !errors.Is(err, ErrClosed) {
+			t.Fatalf("expected the connection to be lost, got %v", err)
+		}
+
+		after, err := c.CallTool(ctx, "pid", nil)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if before == after {
+			t.Errorf("expected a new server process, got %s again", after)
+		}
+	})
+
+	t.Run("calls given up on do not hold up the connection", func(t *testing.T) {
+		c := newClient(t, "local", ServerConfig{Command: serverBin})
+
+		ctx, cancel := context.WithTimeout(ctx, 50*time.Millisecond)
+		defer cancel()
+
+		start := time.Now()
+		if _, err := c.CallTool(ctx, "sleep", nil); !errors.Is(err, context.DeadlineExceeded) {
+			t.Fatalf("expected the call to time out, got %v", err)
+		}
+		if elapsed := time.Since(start); elapsed >= time.Second {
+			t.Errorf("expected the call to be given up on, took %s", elapsed)
+		}
+
+		if out, err := c.CallTool(context.Background(), "echo", json.RawMessage(`{"text": "still there"}`)); err != nil || out != "still there" {
+			t.Errorf("expected the connection to still work, got %q and %v", out, err)
+		}
+	})
+
+	t.Run("servers failing to start are retried after a backoff", func(t *testing.T) {
+		c := newClient(t, "broken", ServerConfig{Command: filepath.Join(t.TempDir(), "missing")}, WithReconnectBackoff(time.Minute, time.Hour))
+
+		now := time.Now()
+		c.now = func() time.Time { return now }
+
+		if _, err := c.ListTools(ctx); err == nil || errors.Is(err, ErrUnavailable) {
+			t.Fatalf("expected the server to fail to start, got %v", err)
+		}
+
+		if _, err := c.ListTools(ctx); !errors.Is(err, ErrUnavailable) {
+			t.Fatalf("expected the client to wait before retrying, got %v", err)
+		}
+
+		now = now.Add(time.Minute)
+		if _, err := c.ListTools(ctx); err == nil || errors.Is(err, ErrUnavailable) {
+			t.Fatalf("expected the client to retry, got %v", err)
+		}
+
+		// the backoff doubles with every failure
+		now = now.Add(time.Minute)
+		if _, err := c.ListTools(ctx); !errors.Is(err, ErrUnavailable) {
+			t.Errorf("expected the client to wait longer before retrying, got %v", err)
+		}
+	})
+}
+
+// fakeHTTPServer is an MCP server over streamable HTTP, whose tool calls respond with a stream of events.
+type fakeHTTPServer struct {
+	mu          sync.Mutex
+	sessions    map[string]bool
+	initialized int
+	answered    bool
+	headers     []http.Header
+}
+
+func (s *fakeHTTPServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	session := r.Header.Get(sessionHeader)
+	s.headers = append(s.headers, r.Header.Clone())
+
+	if r.Method == http.MethodDelete {
+		delete(s.sessions, session)
+		return
+	}
+
+	var m message
+	if err := json.NewDecoder(r.Body).Decode(&m); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	if m.Method == "initialize" {
+		s.initialized++
+		session = fmt.Sprint("session-", s.initialized)
+		s.sessions[session] = true
+		w.Header().Set(sessionHeader, session)
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprintf(w, `{"jsonrpc": "2.0", "id": %s, "result": {"protocolVersion": %q, "serverInfo": {"name": "fake"}}}`, m.ID, ProtocolVersion)
+		return
+	}
+
+	if !s.sessions[session] {
+		http.Error(w, "unknown session", http.StatusNotFound)
+		return
+	}
+
+	switch {
+	case string(m.ID) == `"ping-1"`:
+		s.answered = m.Result != nil
+		w.WriteHeader(http.StatusAccepted)
+	case m.ID == nil:
+		w.WriteHeader(http.StatusAccepted)
+	case m.Method == "tools/list":
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprintf(w, `{"jsonrpc": "2.0", "id": %s, "result": {"tools": [{"name": "echo", "inputSchema": {"type": "object"}}]}}`, m.ID)
+	case m.Method == "tools/call":
+		var params struct {
+			Arguments struct {
+				Text string `json:"text"`
+			} `json:"arguments"`
+		}
+		_ = json.Unmarshal(m.Params, &params)
+
+		// the server pings the client and reports progress before responding
+		w.Header().Set("Content-Type", "text/event-stream")
+		fmt.Fprint(w, "event: message\ndata: {\"jsonrpc\": \"2.0\", \"id\": \"ping-1\", \"method\": \"ping\"}\n\n")
+		fmt.Fprint(w, ": keep-alive\n\n")
+		fmt.Fprint(w, "data: {\"jsonrpc\": \"2.0\", \"method\": \"notifications/progress\", \"params\": {\"progress\": 1}}\n\n")
+		fmt.Fprintf(w, "id: 1\ndata: {\"jsonrpc\": \"2.0\", \"id\": %s,\ndata: \"result\": {\"content\": [{\"type\": \"text\", \"text\": %q}]}}\n\n", m.ID, params.Arguments.Text)
+	default:
+		http.Error(w, "unexpected request", http.StatusBadRequest)
+	}
+}
+
+func TestClient_HTTP(t *testing.T) {
+	fake := &fakeHTTPServer{sessions: map[string]bool{}}
+	srv := httptest.NewServer(fake)
+	t.Cleanup(srv.Close)
+
+	t.Setenv("MCP_TEST_TOKEN", "secret")
+	c := newClient(t, "remote", ServerConfig{URL: srv.URL, Headers: map[string]string{"Authorization": "Bearer ${MCP_TEST_TOKEN}"}})
+	register(t, c)
+
+	t.Run("tools are called within a session", func(t *testing.T) {
+		if out := call(t, "remote_echo", `{"text": "hi"}`); out != "hi" {
+			t.Errorf("expected the response from the stream, got %q", out)
+		}
+
+		fake.mu.Lock()
+		defer fake.mu.Unlock()
+
+		if !fake.answered {
+			t.Errorf("expected the ping of the server to be answered")
+		}
+
+		for _, h := range fake.headers[1:] {
+			if h.Get("Authorization") != "Bearer secret" || h.Get(sessionHeader) != "session-1" || h.Get(protocolHeader) != ProtocolVersion {
+				t.Errorf("expected the requests to carry the credentials, session and protocol version, got %v", h)
+			}
+		}
+	})
+
+	t.Run("expired sessions are renewed", func(t *testing.T) {
+		fake.mu.Lock()
+		clear(fake.sessions)
+		fake.mu.Unlock()
+
+		if out := call(t, "remote_echo", `{"text": "again"}`); out != "again" {
+			t.Errorf("expected the call to be sent again in a new session, got %q", out)
+		}
+
+		fake.mu.Lock()
+		defer fake.mu.Unlock()
+
+		if fake.initialized != 2 {
+			t.Errorf("expected a new session, got %d initializations", fake.initialized)
+		}
+	})
+}
+
+func TestRegisterFromEnv(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("reachable servers are registered", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "mcp.json")
+		config := fmt.Sprintf(`{"servers": {
+			"local": {"command": %q, "env": {"GREETING": "${MCP_TEST_GREETING}"}, "timeout": "2s", "require_approval": true},
+			"broken": {"command": %q}
+		}}`, serverBin, filepath.Join(t.TempDir(), "missing"))
+		if err := os.WriteFile(path, []byte(config), 0o600); err != nil {
+			t.Fatal(err)
+		}
+		t.Setenv("MCP_CONFIG_FILE", path)
+		t.Setenv("MCP_TEST_GREETING", "hola")
+
+		clients, err := RegisterFromEnv(ctx)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		t.Cleanup(func() {
+			for name := range tools.Registry {
+				if strings.HasPrefix(name, "local_") {
+					delete(tools.Registry, name)
+				}
+			}
+			for _, c := range clients {
+				_ = c.Close()
+			}
+		})
+
+		if len(clients) != 1 || clients[0].Name() != "local" {
+			t.Fatalf("expected only the local server to be registered, got %d clients", len(clients))
+		}
+
+		if out := call(t, "local_env", `{"name": "GREETING"}`); out != "hola" {
+			t.Errorf("expected the environment of the server to be expanded, got %q", out)
+		}
+
+		tool := tools.Registry["local_echo"]
+		if tools.TimeoutOf(tool) != 2*time.Second || !tools.NeedsApproval(tool) {
+			t.Errorf("expected the tools to be configured like their server, got %s and approval %v", tools.TimeoutOf(tool), tools.NeedsApproval(tool))
+		}
+	})
+
+	t.Run("required servers that cannot be reached fail", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "mcp.json")
+		config := fmt.Sprintf(`{"servers": {
+			"broken": {"command": %q, "required": true},
+			"local": {"command": %q}
+		}}`, filepath.Join(t.TempDir(), "missing"), serverBin)
+		if err := os.WriteFile(path, []byte(config), 0o600); err != nil {
+			t.Fatal(err)
+		}
+		t.Setenv("MCP_CONFIG_FILE", path)
+
+		if _, err := RegisterFromEnv(ctx); err == nil || !strings.Contains(err.Error(), "required MCP server broken") {
+			t.Errorf("expected the required server to fail registration, got %v", err)
+		}
+	})
+
+	t.Run("invalid servers are reported", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "mcp.json")
+		if err := os.WriteFile(path, []byte(`{"servers": {"both": {"command": "server", "url": "http://localhost"}}}`), 0o600); err != nil {
+			t.Fatal(err)
+		}
+		t.Setenv("MCP_CONFIG_FILE", path)
+
+		if _, err := RegisterFromEnv(ctx); err == nil || !strings.Contains(err.Error(), "mutually exclusive") {
+			t.Errorf("expected the config to be rejected, got %v", err)
+		}
+	})
+}
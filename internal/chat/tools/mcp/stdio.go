@@ -0,0 +1,220 @@
+package mcp
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+	"os/exec"
+	"sync"
+	"time"
+)
+
+// stopTimeout is how long a server may take to exit once its input is closed, before it is killed.
+const stopTimeout = 5 * time.Second
+
+// stdioConn is a server running as a subprocess, exchanging newline delimited JSON-RPC messages over its standard input
+// and output. Its standard error is logged.
+type stdioConn struct {
+	name  string
+	cmd   *exec.Cmd
+	stdin io.WriteCloser
+	wmu   sync.Mutex
+
+	mu      sync.Mutex
+	pending map[string]chan *message
+	err     error
+	lost    chan struct{}
+	exited  chan struct{}
+}
+
+func dialStdio(name string, config ServerConfig) (*stdioConn, error) {
+	cmd := exec.Command(config.Command, config.Args...)
+	cmd.Env = os.Environ()
+	for k, v := range config.Env {
+		cmd.Env = append(cmd.Env, k+"="+os.ExpandEnv(v))
+	}
+
+	stdin, err := cmd.StdinPipe()
+	if err != nil {
+		return nil, err
+	}
+
+	stdout, err := cmd.StdoutPipe()
+	if err != nil {
+		return nil, err
+	}
+
+	cmd.Stderr = &stderrLogger{name: name}
+
+	if err := cmd.Start(); err != nil {
+		return nil, fmt.Errorf("failed to start %s: %w", config.Command, err)
+	}
+
+	c := &stdioConn{
+		name:    name,
+		cmd:     cmd,
+		stdin:   stdin,
+		pending: map[string]chan *message{},
+		lost:    make(chan struct{}),
+		exited:  make(chan struct{}),
+	}
+
+	go c.read(stdout)
+
+	return c, nil
+}
+
+func (c *stdioConn) call(ctx context.Context, req *message) (*message, error) {
+	ch := make(chan *message, 1)
+
+	c.mu.Lock()
+	if c.err != nil {
+		c.mu.Unlock()
+		return nil, fmt.Errorf("%w: %v", errNotDelivered, c.err)
+	}
+	c.pending[string(req.ID)] = ch
+	c.mu.Unlock()
+
+	defer func() {
+		c.mu.Lock()
+		delete(c.pending, string(req.ID))
+		c.mu.Unlock()
+	}()
+
+	if err := c.write(req); err != nil {
+		return nil, fmt.Errorf("%w: %v", errNotDelivered, err)
+	}
+
+	select {
+	case resp := <-ch:
+		return resp, nil
+	case <-c.lost:
+		return nil, fmt.Errorf("%w: %v", ErrClosed, c.err)
+	case <-ctx.Done():
+		_ = c.write(cancelled(req, ctx.Err()))
+		return nil, ctx.Err()
+	}
+}
+
+func (c *stdioConn) notify(ctx context.Context, m *message) error {
+	return c.write(m)
+}
+
+func (c *stdioConn) done() <-chan struct{} {
+	return c.lost
+}
+
+// close closes the server's input, which tells it to exit, and kills it if it does not.
+func (c *stdioConn) close() error {
+	_ = c.stdin.Close()
+
+	select {
+	case <-c.exited:
+	case <-time.After(stopTimeout):
+		slog.Warn("MCP server did not exit, killing it", "mcp_server", c.name)
+		_ = c.cmd.Process.Kill()
+		<-c.exited
+	}
+
+	return nil
+}
+
+func (c *stdioConn) write(m *message) error {
+	b, err := json.Marshal(m)
+	if err != nil {
+		return err
+	}
+
+	c.wmu.Lock()
+	defer c.wmu.Unlock()
+
+	_, err = c.stdin.Write(append(b, '\n'))
+	return err
+}
+
+// read dispatches the messages of the server until its output is closed, which means it exited.
+func (c *stdioConn) read(stdout io.Reader) {
+	r := bufio.NewReader(stdout)
+	for {
+		line, err := r.ReadBytes('\n')
+		if line = bytes.TrimSpace(line); len(line) > 0 {
+			c.dispatch(line)
+		}
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				err = ErrClosed
+			}
+			c.fail(err)
+			return
+		}
+	}
+}
+
+func (c *stdioConn) dispatch(line []byte) {
+	var m message
+	if err := json.Unmarshal(line, &m); err != nil {
+		slog.Warn("Invalid message from MCP server", "mcp_server", c.name, "error", err)
+		return
+	}
+
+	switch {
+	case m.isRequest():
+		if err := c.write(answer(&m)); err != nil {
+			slog.Warn("Failed to answer MCP server", "mcp_server", c.name, "method", m.Method, "error", err)
+		}
+	case m.isNotification():
+		slog.Debug("Notification from MCP server", "mcp_server", c.name, "method", m.Method)
+	default:
+		c.mu.Lock()
+		ch, ok := c.pending[string(m.ID)]
+		c.mu.Unlock()
+
+		// responses to requests no longer waited for, e.g. because they timed out, are dropped
+		if ok {
+			select {
+			case ch <- &m:
+			default:
+			}
+		}
+	}
+}
+
+// fail records why the connection was lost once the server exited, and releases the requests waiting for it.
+func (c *stdioConn) fail(err error) {
+	waitErr := c.cmd.Wait()
+	close(c.exited)
+
+	if waitErr != nil {
+		err = fmt.Errorf("server exited: %w", waitErr)
+	}
+
+	c.mu.Lock()
+	c.err = err
+	c.mu.Unlock()
+
+	close(c.lost)
+}
+
+// stderrLogger logs every line a server writes to its standard error.
+type stderrLogger struct {
+	name string
+	buf  []byte
+}
+
+func (l *stderrLogger) Write(p []byte) (int, error) {
+	l.buf = append(l.buf, p...)
+	for {
+		line, rest, ok := bytes.Cut(l.buf, []byte("\n"))
+		if !ok {
+			return len(p), nil
+		}
+		slog.Info("MCP server: "+string(line), "mcp_server", l.name)
+		l.buf = rest
+	}
+}
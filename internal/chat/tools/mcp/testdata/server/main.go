@@ -0,0 +1,140 @@
+// Command server is an MCP server speaking over its standard input and output, for the tests of the client. Its tools:
+//   - echo replies with its text argument.
+//   - env replies with the value of the environment variable named by its name argument.
+//   - pid replies with the ID of the server's process, which tells restarts apart.
+//   - fail reports a failure.
+//   - sleep replies after a second, unless the call is cancelled first.
+//   - crash exits the server without replying.
+//
+// When CLASHING_TOOLS is set, it also lists two tools whose names only differ past the length the model accepts.
+package main
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+type message struct {
+	JSONRPC string          `json:"jsonrpc"`
+	ID      json.RawMessage `json:"id,omitempty"`
+	Method  string          `json:"method,omitempty"`
+	Params  json.RawMessage `json:"params,omitempty"`
+	Result  any             `json:"result,omitempty"`
+	Error   any             `json:"error,omitempty"`
+}
+
+var (
+	mu  sync.Mutex
+	out = json.NewEncoder(os.Stdout)
+)
+
+func send(m message) {
+	mu.Lock()
+	defer mu.Unlock()
+
+	m.JSONRPC = "2.0"
+	_ = out.Encode(m)
+}
+
+func text(s string, isError bool) map[string]any {
+	return map[string]any{"content": []any{map[string]any{"type": "text", "text": s}}, "isError": isError}
+}
+
+func tool(name string, properties map[string]any) map[string]any {
+	schema := map[string]any{"type": "object"}
+	if properties != nil {
+		schema["properties"] = properties
+	}
+	return map[string]any{"name": name, "description": "The " + name + " tool", "inputSchema": schema}
+}
+
+func main() {
+	fmt.Fprintln(os.Stderr, "test server started")
+
+	in := bufio.NewScanner(os.Stdin)
+	for in.Scan() {
+		var m message
+		if err := json.Unmarshal(in.Bytes(), &m); err != nil {
+			fmt.Fprintln(os.Stderr, "invalid message:", err)
+			continue
+		}
+		if m.ID == nil {
+			// notifications need no response
+			continue
+		}
+		go handle(m)
+	}
+}
+
+func handle(m message) {
+	switch m.Method {
+	case "initialize":
+		var params struct {
+			ProtocolVersion string `json:"protocolVersion"`
+		}
+		_ = json.Unmarshal(m.Params, &params)
+		send(message{ID: m.ID, Result: map[string]any{
+			"protocolVersion": params.ProtocolVersion,
+			"capabilities":    map[string]any{"tools": map[string]any{}},
+			"serverInfo":      map[string]any{"name": "test", "version": "1.0.0"},
+		}})
+
+	case "tools/list":
+		// tools are listed over two pages
+		var params struct {
+			Cursor string `json:"cursor"`
+		}
+		_ = json.Unmarshal(m.Params, &params)
+		if params.Cursor == "" {
+			send(message{ID: m.ID, Result: map[string]any{
+				"tools": []any{
+					tool("echo", map[string]any{"text": map[string]any{"type": "string"}}),
+					tool("env", map[string]any{"name": map[string]any{"type": "string"}}),
+					tool("pid", nil),
+				},
+				"nextCursor": "2",
+			}})
+		} else {
+			tools := []any{tool("fail", nil), tool("sleep", nil), tool("crash", nil)}
+			if os.Getenv("CLASHING_TOOLS") != "" {
+				long := strings.Repeat("x", 64)
+				tools = append(tools, tool(long+"_a", nil), tool(long+"_b", nil))
+			}
+			send(message{ID: m.ID, Result: map[string]any{"tools": tools}})
+		}
+
+	case "tools/call":
+		var params struct {
+			Name      string            `json:"name"`
+			Arguments map[string]string `json:"arguments"`
+		}
+		_ = json.Unmarshal(m.Params, &params)
+
+		switch params.Name {
+		case "echo":
+			send(message{ID: m.ID, Result: text(params.Arguments["text"], false)})
+		case "env":
+			send(message{ID: m.ID, Result: text(os.Getenv(params.Arguments["name"]), false)})
+		case "pid":
+			send(message{ID: m.ID, Result: text(strconv.Itoa(os.Getpid()), false)})
+		case "fail":
+			send(message{ID: m.ID, Result: text("something broke", true)})
+		case "sleep":
+			time.Sleep(time.Second)
+			send(message{ID: m.ID, Result: text("slept", false)})
+		case "crash":
+			os.Exit(3)
+		default:
+			send(message{ID: m.ID, Error: map[string]any{"code": -32602, "message": "unknown tool " + params.Name}})
+		}
+
+	default:
+		send(message{ID: m.ID, Error: map[string]any{"code": -32601, "message": "method not found"}})
+	}
+}
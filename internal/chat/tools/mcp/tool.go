@@ -0,0 +1,132 @@
+package mcp
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"regexp"
+	"strconv"
+	"time"
+
+	"github.com/acai-travel/tech-challenge/internal/chat/tools"
+	"github.com/openai/openai-go/v2"
+)
+
+var (
+	_ tools.TimeoutTool  = (*Tool)(nil)
+	_ tools.ApprovalTool = (*Tool)(nil)
+)
+
+// maxToolName is the longest tool name the model accepts.
+const maxToolName = 64
+
+var invalidToolName = regexp.MustCompile(`[^a-zA-Z0-9_-]+`)
+
+// Tool is a tool of an MCP server, adapted to be called by the model like the built-in tools.
+type Tool struct {
+	client      *Client
+	name        string
+	remote      string
+	description string
+	parameters  openai.FunctionParameters
+	timeout     time.Duration
+	approval    bool
+}
+
+// ToolName returns the name the tool of a server is registered as. It is prefixed with the server's name, so tools of
+// different servers, or built-in ones, do not clash. Names of the same server may still clash once invalid characters
+// are replaced and long names truncated, see Tools.
+func ToolName(server, tool string) string {
+	name := invalidToolName.ReplaceAllString(server+"_"+tool, "_")
+	if len(name) > maxToolName {
+		name = name[:maxToolName]
+	}
+	return name
+}
+
+// uniqueName returns name, suffixed with a number if it is taken already, and marks it as taken.
+func uniqueName(name string, taken map[string]bool) string {
+	unique := name
+	for i := 2; taken[unique]; i++ {
+		suffix := "_" + strconv.Itoa(i)
+		unique = name[:min(len(name), maxToolName-len(suffix))] + suffix
+	}
+	taken[unique] = true
+	return unique
+}
+
+func (t *Tool) Name() string           { return t.name }
+func (t *Tool) Description() string    { return t.description }
+func (t *Tool) Timeout() time.Duration { return t.timeout }
+func (t *Tool) RequiresApproval() bool { return t.approval }
+
+func (t *Tool) Parameters() openai.FunctionParameters {
+	return t.parameters
+}
+
+func (t *Tool) Handle(ctx context.Context, args json.RawMessage) (string, error) {
+	return t.client.CallTool(ctx, t.remote, args)
+}
+
+// Tools lists the tools of the client's server, adapted to be called by the model. Tools whose names clash, see
+// ToolName, are told apart by a numbered suffix, e.g. files_read_2.
+func Tools(ctx context.Context, c *Client) ([]*Tool, error) {
+	infos, err := c.ListTools(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// validated when the client was created
+	timeout, _ := c.config.timeout()
+
+	out := make([]*Tool, 0, len(infos))
+	taken := map[string]bool{}
+	for _, info := range infos {
+		out = append(out, &Tool{
+			client:      c,
+			name:        uniqueName(ToolName(c.name, info.Name), taken),
+			remote:      info.Name,
+			description: info.Description,
+			parameters:  parameters(info.InputSchema),
+			timeout:     timeout,
+			approval:    c.config.RequireApproval,
+		})
+	}
+
+	return out, nil
+}
+
+// Register adds the tools of the client's server to tools.Registry, and returns them. It fails without registering any
+// if one would replace a tool registered already.
+func Register(ctx context.Context, c *Client) ([]*Tool, error) {
+	list, err := Tools(ctx, c)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, t := range list {
+		if _, ok := tools.Registry[t.name]; ok {
+			return nil, fmt.Errorf("tool %s of MCP server %s is registered already", t.remote, c.name)
+		}
+	}
+
+	for _, t := range list {
+		tools.Register(t)
+	}
+
+	return list, nil
+}
+
+// parameters returns the input schema of a tool as its parameters. The model requires the properties of an object,
+// even if it has none.
+func parameters(schema map[string]any) openai.FunctionParameters {
+	p := openai.FunctionParameters{"type": "object"}
+	maps.Copy(p, schema)
+
+	if _, ok := p["properties"]; !ok {
+		p["properties"] = map[string]any{}
+	}
+
+	return p
+}
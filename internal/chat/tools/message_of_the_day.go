@@ -5,19 +5,18 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
-
-	"github.com/openai/openai-go/v2"
+	"time"
 )
 
-type MessageOfTheDayTool struct{}
+// MessageOfTheDayTool gets the quote of the day from ZenQuotes, it takes no arguments.
+var MessageOfTheDayTool = Define("get_message_of_the_day", "Returns the message of the day", getMessageOfTheDay, WithTimeout(5*time.Second))
 
-func (m MessageOfTheDayTool) Name() string        { return "get_message_of_the_day" }
-func (m MessageOfTheDayTool) Description() string { return "Returns the message of the day" }
-func (m MessageOfTheDayTool) Parameters() openai.FunctionParameters {
-	return nil
-}
-func (m MessageOfTheDayTool) Handle(ctx context.Context, args json.RawMessage) (string, error) {
-	resp, err := http.Get("https://zenquotes.io/api/today")
+func getMessageOfTheDay(ctx context.Context, _ struct{}) (string, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, "https://zenquotes.io/api/today", nil)
+	if err != nil {
+		return "", err
+	}
+	resp, err := http.DefaultClient.Do(req)
 	if err != nil {
 		return "", err
 	}
@@ -38,5 +37,5 @@ func (m MessageOfTheDayTool) Handle(ctx context.Context, args json.RawMessage) (
 }
 
 func init() {
-	Register(MessageOfTheDayTool{})
+	Register(MessageOfTheDayTool)
 }
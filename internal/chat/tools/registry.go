@@ -7,6 +7,8 @@ import (
 	"github.com/openai/openai-go/v2"
 )
 
+// Tool is a function the model may call. Define creates tools from a handler taking typed arguments, deriving their
+// parameters and validating the calls.
 type Tool interface {
 	Name() string
 	Description() string
@@ -14,6 +16,19 @@ type Tool interface {
 	Handle(ctx context.Context, args json.RawMessage) (string, error)
 }
 
+// ApprovalTool is implemented by tools that must not run without the user's approval, e.g. because they spend money or
+// contact someone on the user's behalf.
+type ApprovalTool interface {
+	Tool
+	RequiresApproval() bool
+}
+
+// NeedsApproval reports whether calls to the tool need the user's approval.
+func NeedsApproval(tool Tool) bool {
+	t, ok := tool.(ApprovalTool)
+	return ok && t.RequiresApproval()
+}
+
 var Registry = map[string]Tool{}
 
 func Register(tool Tool) {
@@ -0,0 +1,267 @@
+package tools
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+)
+
+var timeType = reflect.TypeFor[time.Time]()
+
+// Schema is the JSON Schema of a tool's arguments, built from a Go struct by SchemaOf.
+type Schema struct {
+	Type        string             `json:"type"`
+	Description string             `json:"description,omitempty"`
+	Format      string             `json:"format,omitempty"`
+	Enum        []any              `json:"enum,omitempty"`
+	Items       *Schema            `json:"items,omitempty"`
+	Properties  map[string]*Schema `json:"properties,omitempty"`
+	Required    []string           `json:"required,omitempty"`
+
+	// AdditionalProperties is false on objects, arguments they do not declare are rejected.
+	AdditionalProperties *bool `json:"additionalProperties,omitempty"`
+}
+
+// SchemaOf builds the schema of the arguments decoded into T, which must be a struct. Fields are named after their json
+// tag, and described by these tags:
+//   - description: what the field is for, the model relies on it to fill the field in.
+//   - required:"true": the field must be given.
+//   - enum: comma separated list of the allowed values.
+//
+// Strings, booleans, numbers, time.Time (as RFC3339 strings), slices and nested structs are supported, pointers are
+// treated as the type they point to. SchemaOf panics on other types, tools are defined at init time.
+func SchemaOf[T any]() *Schema {
+	t := reflect.TypeFor[T]()
+	if t.Kind() != reflect.Struct {
+		panic(fmt.Sprintf("tool arguments must be a struct, got %s", t))
+	}
+	return schemaOf(t)
+}
+
+func schemaOf(t reflect.Type) *Schema {
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+
+	if t == timeType {
+		return &Schema{Type: "string", Format: "date-time"}
+	}
+
+	switch t.Kind() {
+	case reflect.String:
+		return &Schema{Type: "string"}
+	case reflect.Bool:
+		return &Schema{Type: "boolean"}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return &Schema{Type: "integer"}
+	case reflect.Float32, reflect.Float64:
+		return &Schema{Type: "number"}
+	case reflect.Slice, reflect.Array:
+		return &Schema{Type: "array", Items: schemaOf(t.Elem())}
+	case reflect.Struct:
+		return objectSchema(t)
+	default:
+		panic(fmt.Sprintf("unsupported tool argument type %s", t))
+	}
+}
+
+func objectSchema(t reflect.Type) *Schema {
+	closed := false
+	s := &Schema{Type: "object", Properties: map[string]*Schema{}, AdditionalProperties: &closed}
+
+	for i := range t.NumField() {
+		f := t.Field(i)
+		if !f.IsExported() {
+			continue
+		}
+
+		name, _, _ := strings.Cut(f.Tag.Get("json"), ",")
+		if name == "-" {
+			continue
+		}
+		if name == "" {
+			name = f.Name
+		}
+
+		prop := schemaOf(f.Type)
+		prop.Description = f.Tag.Get("description")
+
+		if enum := f.Tag.Get("enum"); enum != "" {
+			for _, v := range strings.Split(enum, ",") {
+				prop.Enum = append(prop.Enum, enumValue(prop.Type, strings.TrimSpace(v)))
+			}
+		}
+
+		if f.Tag.Get("required") == "true" {
+			s.Required = append(s.Required, name)
+		}
+
+		s.Properties[name] = prop
+	}
+
+	return s
+}
+
+// enumValue converts an enum tag value to the type of the field, so it compares equal to the decoded arguments.
+func enumValue(typ, v string) any {
+	switch typ {
+	case "integer", "number":
+		var n float64
+		if err := json.Unmarshal([]byte(v), &n); err != nil {
+			panic(fmt.Sprintf("invalid %s enum value %q", typ, v))
+		}
+		return n
+	case "string":
+		return v
+	default:
+		panic(fmt.Sprintf("enum is not supported on %s fields", typ))
+	}
+}
+
+// Parameters converts the schema to the parameters of a tool definition.
+func (s *Schema) Parameters() map[string]any {
+	b, err := json.Marshal(s)
+	if err != nil {
+		panic(err)
+	}
+
+	var params map[string]any
+	if err := json.Unmarshal(b, &params); err != nil {
+		panic(err)
+	}
+	return params
+}
+
+// Validate checks the JSON value against the schema, and returns every violation found, each prefixed by the path of
+// the offending value.
+func (s *Schema) Validate(v any) []string {
+	var violations []string
+	s.validate("", v, &violations)
+	return violations
+}
+
+func (s *Schema) validate(path string, v any, violations *[]string) {
+	fail := func(format string, args ...any) {
+		msg := fmt.Sprintf(format, args...)
+		if path != "" {
+			msg = path + ": " + msg
+		}
+		*violations = append(*violations, msg)
+	}
+
+	if v == nil {
+		// null is how optional arguments are left out
+		return
+	}
+
+	switch s.Type {
+	case "object":
+		obj, ok := v.(map[string]any)
+		if !ok {
+			fail("expected an object, got %s", jsonType(v))
+			return
+		}
+
+		for _, name := range s.Required {
+			if obj[name] == nil {
+				fail("%s is required", name)
+			}
+		}
+
+		names := make([]string, 0, len(obj))
+		for name := range obj {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+
+		for _, name := range names {
+			prop, ok := s.Properties[name]
+			if !ok {
+				fail("unknown argument %s", name)
+				continue
+			}
+			prop.validate(join(path, name), obj[name], violations)
+		}
+	case "array":
+		arr, ok := v.([]any)
+		if !ok {
+			fail("expected an array, got %s", jsonType(v))
+			return
+		}
+		for i, item := range arr {
+			s.Items.validate(fmt.Sprintf("%s[%d]", path, i), item, violations)
+		}
+	case "string":
+		str, ok := v.(string)
+		if !ok {
+			fail("expected a string, got %s", jsonType(v))
+			return
+		}
+		if s.Format == "date-time" {
+			if _, err := time.Parse(time.RFC3339, str); err != nil {
+				fail("expected an RFC3339 date and time, got %q", str)
+			}
+		}
+	case "integer", "number":
+		n, ok := v.(float64)
+		if !ok {
+			fail("expected %s, got %s", article(s.Type), jsonType(v))
+			return
+		}
+		if s.Type == "integer" && n != float64(int64(n)) {
+			fail("expected an integer, got %v", n)
+		}
+	case "boolean":
+		if _, ok := v.(bool); !ok {
+			fail("expected a boolean, got %s", jsonType(v))
+		}
+	}
+
+	if len(s.Enum) > 0 && !slices.Contains(s.Enum, v) {
+		fail("expected one of %s, got %v", enumList(s.Enum), v)
+	}
+}
+
+func join(path, name string) string {
+	if path == "" {
+		return name
+	}
+	return path + "." + name
+}
+
+func jsonType(v any) string {
+	switch v.(type) {
+	case map[string]any:
+		return "an object"
+	case []any:
+		return "an array"
+	case string:
+		return "a string"
+	case float64:
+		return "a number"
+	case bool:
+		return "a boolean"
+	default:
+		return fmt.Sprintf("%T", v)
+	}
+}
+
+func article(typ string) string {
+	if typ == "integer" {
+		return "an integer"
+	}
+	return "a " + typ
+}
+
+func enumList(enum []any) string {
+	values := make([]string, len(enum))
+	for i, v := range enum {
+		values[i] = fmt.Sprint(v)
+	}
+	return strings.Join(values, ", ")
+}
@@ -0,0 +1,61 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// DefaultTimeout bounds the calls to tools that do not set a timeout of their own, see TimeoutTool.
+const DefaultTimeout = 30 * time.Second
+
+// ErrTimeout is returned by Call when the tool did not finish within its timeout.
+var ErrTimeout = errors.New("tool timed out")
+
+// TimeoutTool is implemented by tools that need a different timeout than DefaultTimeout.
+type TimeoutTool interface {
+	Tool
+	Timeout() time.Duration
+}
+
+// TimeoutOf returns how long calls to the tool may take.
+func TimeoutOf(tool Tool) time.Duration {
+	if t, ok := tool.(TimeoutTool); ok && t.Timeout() > 0 {
+		return t.Timeout()
+	}
+	return DefaultTimeout
+}
+
+// Call calls the tool, bounded by its timeout. Tools are expected to give up once ctx is done, Call returns
+// ErrTimeout without waiting for those that do not.
+func Call(ctx context.Context, tool Tool, args json.RawMessage) (string, error) {
+	timeout := TimeoutOf(tool)
+	callCtx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	type result struct {
+		out string
+		err error
+	}
+
+	done := make(chan result, 1)
+	go func() {
+		out, err := tool.Handle(callCtx, args)
+		done <- result{out, err}
+	}()
+
+	var r result
+	select {
+	case r = <-done:
+	case <-callCtx.Done():
+		r.err = callCtx.Err()
+	}
+
+	if r.err != nil && callCtx.Err() != nil && ctx.Err() == nil {
+		return "", fmt.Errorf("%w: %s did not finish within %s", ErrTimeout, tool.Name(), timeout)
+	}
+
+	return r.out, r.err
+}
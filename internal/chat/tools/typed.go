@@ -0,0 +1,114 @@
+package tools
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/openai/openai-go/v2"
+)
+
+var (
+	_ TimeoutTool  = (*Typed[struct{}])(nil)
+	_ ApprovalTool = (*Typed[struct{}])(nil)
+)
+
+// ErrInvalidArguments is returned when the model called a tool with arguments that do not match its schema.
+var ErrInvalidArguments = errors.New("invalid arguments")
+
+// Typed is a tool whose arguments are decoded into Args before calling its handler. The schema offered to the model is
+// derived from Args, see SchemaOf, and the arguments are validated against it: violations are reported back to the
+// model instead of reaching the handler.
+type Typed[Args any] struct {
+	name        string
+	description string
+	timeout     time.Duration
+	approval    bool
+	schema      *Schema
+	handle      func(ctx context.Context, args Args) (string, error)
+}
+
+type TypedOption func(*typedOptions)
+
+type typedOptions struct {
+	timeout  time.Duration
+	approval bool
+}
+
+// WithTimeout bounds the calls to the tool, instead of DefaultTimeout.
+func WithTimeout(d time.Duration) TypedOption {
+	return func(o *typedOptions) { o.timeout = d }
+}
+
+// WithApproval makes the tool wait for the user's approval before running, see ApprovalTool.
+func WithApproval() TypedOption {
+	return func(o *typedOptions) { o.approval = true }
+}
+
+// Define creates a tool calling handle with the decoded arguments.
+func Define[Args any](name, description string, handle func(ctx context.Context, args Args) (string, error), opts ...TypedOption) *Typed[Args] {
+	var o typedOptions
+	for _, opt := range opts {
+		opt(&o)
+	}
+
+	return &Typed[Args]{
+		name:        name,
+		description: description,
+		timeout:     o.timeout,
+		approval:    o.approval,
+		schema:      SchemaOf[Args](),
+		handle:      handle,
+	}
+}
+
+func (t *Typed[Args]) Name() string           { return t.name }
+func (t *Typed[Args]) Description() string    { return t.description }
+func (t *Typed[Args]) Timeout() time.Duration { return t.timeout }
+func (t *Typed[Args]) RequiresApproval() bool { return t.approval }
+
+func (t *Typed[Args]) Parameters() openai.FunctionParameters {
+	return t.schema.Parameters()
+}
+
+func (t *Typed[Args]) Handle(ctx context.Context, raw json.RawMessage) (string, error) {
+	args, err := t.Decode(raw)
+	if err != nil {
+		return "", err
+	}
+	return t.handle(ctx, args)
+}
+
+// Decode validates the raw arguments against the tool's schema, and decodes them.
+func (t *Typed[Args]) Decode(raw json.RawMessage) (Args, error) {
+	var args Args
+
+	if len(bytes.TrimSpace(raw)) == 0 {
+		// tools without arguments may be called without any
+		raw = json.RawMessage("{}")
+	}
+
+	var v any
+	if err := json.Unmarshal(raw, &v); err != nil {
+		return args, invalidArguments("arguments are not valid JSON: " + err.Error())
+	}
+
+	if violations := t.schema.Validate(v); len(violations) > 0 {
+		return args, invalidArguments(violations...)
+	}
+
+	if err := json.Unmarshal(raw, &args); err != nil {
+		return args, invalidArguments(err.Error())
+	}
+
+	return args, nil
+}
+
+// invalidArguments lists the schema violations of a tool call, the message is meant for the model to fix its call.
+func invalidArguments(violations ...string) error {
+	return fmt.Errorf("%w: %s", ErrInvalidArguments, strings.Join(violations, "; "))
+}
@@ -0,0 +1,132 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+type bookingArgs struct {
+	City     string    `json:"city" description:"City to book in" required:"true"`
+	Class    string    `json:"class,omitempty" description:"Travel class" enum:"economy,business"`
+	Nights   int       `json:"nights,omitempty" enum:"1,2,3"`
+	Checkin  time.Time `json:"checkin,omitzero"`
+	Guests   []string  `json:"guests,omitempty"`
+	Flexible *bool     `json:"flexible,omitempty"`
+	internal string
+}
+
+func TestSchemaOf(t *testing.T) {
+	got := SchemaOf[bookingArgs]().Parameters()
+
+	want := map[string]any{
+		"type": "object",
+		"properties": map[string]any{
+			"city":     map[string]any{"type": "string", "description": "City to book in"},
+			"class":    map[string]any{"type": "string", "description": "Travel class", "enum": []any{"economy", "business"}},
+			"nights":   map[string]any{"type": "integer", "enum": []any{1.0, 2.0, 3.0}},
+			"checkin":  map[string]any{"type": "string", "format": "date-time"},
+			"guests":   map[string]any{"type": "array", "items": map[string]any{"type": "string"}},
+			"flexible": map[string]any{"type": "boolean"},
+		},
+		"required":             []any{"city"},
+		"additionalProperties": false,
+	}
+
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("unexpected schema (-want +got):\n%s", diff)
+	}
+}
+
+func TestTyped(t *testing.T) {
+	ctx := context.Background()
+
+	var received bookingArgs
+	tool := Define("book", "Books a stay", func(ctx context.Context, args bookingArgs) (string, error) {
+		received = args
+		return "booked in " + args.City, nil
+	})
+
+	t.Run("arguments are decoded", func(t *testing.T) {
+		out, err := tool.Handle(ctx, json.RawMessage(`{"city": "Barcelona", "class": "business", "checkin": "2025-09-24T15:00:00Z", "guests": ["Ana"]}`))
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if out != "booked in Barcelona" || received.Class != "business" || received.Checkin.Day() != 24 || len(received.Guests) != 1 {
+			t.Errorf("unexpected arguments %+v", received)
+		}
+	})
+
+	t.Run("schema violations are reported", func(t *testing.T) {
+		_, err := tool.Handle(ctx, json.RawMessage(`{"class": "first", "nights": 1.5, "checkin": "tomorrow", "guests": [1], "pets": true}`))
+		if !errors.Is(err, ErrInvalidArguments) {
+			t.Fatalf("expected invalid arguments, got %v", err)
+		}
+
+		for _, violation := range []string{
+			"city is required",
+			"class: expected one of economy, business, got first",
+			"nights: expected an integer, got 1.5",
+			`checkin: expected an RFC3339 date and time, got "tomorrow"`,
+			"guests[0]: expected a string, got a number",
+			"unknown argument pets",
+		} {
+			if !strings.Contains(err.Error(), violation) {
+				t.Errorf("expected %q to be reported, got %q", violation, err)
+			}
+		}
+	})
+
+	t.Run("tools without arguments may be called without any", func(t *testing.T) {
+		tool := Define("ping", "Pings", func(ctx context.Context, _ struct{}) (string, error) { return "pong", nil })
+
+		if out, err := tool.Handle(ctx, nil); err != nil || out != "pong" {
+			t.Errorf("expected pong, got %q and %v", out, err)
+		}
+	})
+}
+
+func TestHolidayTool(t *testing.T) {
+	calendar := strings.Join([]string{
+		"BEGIN:VCALENDAR",
+		"VERSION:2.0",
+		holiday("20250101", "New Year's Day"),
+		holiday("20250421", "Easter Monday"),
+		holiday("20250624", "St John's Day"),
+		holiday("20251225", "Christmas Day"),
+		"END:VCALENDAR",
+	}, "\r\n")
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(calendar))
+	}))
+	t.Cleanup(srv.Close)
+	t.Setenv("HOLIDAY_CALENDAR_LINK", srv.URL)
+
+	out, err := HolidayTool.Handle(context.Background(), json.RawMessage(`{"after_date": "2025-02-01T00:00:00Z", "before_date": "2025-12-01T00:00:00Z", "max_count": 1}`))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if out != "2025-04-21: Easter Monday" {
+		t.Errorf("expected the arguments to filter the holidays, got %q", out)
+	}
+}
+
+func holiday(date, name string) string {
+	return strings.Join([]string{
+		"BEGIN:VEVENT",
+		"UID:" + date,
+		"DTSTART;VALUE=DATE:" + date,
+		"SUMMARY:" + name,
+		"END:VEVENT",
+	}, "\r\n")
+}
@@ -2,46 +2,22 @@ package tools
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
 	"io"
 	"net/http"
 	"os"
-
-	"github.com/openai/openai-go/v2"
+	"time"
 )
 
 type WeatherArgs struct {
-	Location     string `json:"location"`
-	ForecastDays int    `json:"forecastDays"`
+	Location     string `json:"location" description:"The location to get the weather for" required:"true"`
+	ForecastDays int    `json:"forecastDays,omitempty" description:"The number of days to include in the weather forecast"`
 }
 
-type WeatherTool struct{}
-
-func (w WeatherTool) Name() string        { return "get_weather" }
-func (w WeatherTool) Description() string { return "Get weather at the given location" }
-func (w WeatherTool) Parameters() openai.FunctionParameters {
-	return openai.FunctionParameters{
-		"type": "object",
-		"properties": map[string]any{
-			"location": map[string]string{
-				"type":        "string",
-				"description": "The location to get the weather for",
-			},
-			"forecastDays": map[string]string{
-				"type":        "integer",
-				"description": "The number of days to include in the weather forecast",
-			},
-		},
-		"required": []string{"location"},
-	}
-}
+// WeatherTool gets the current weather, or the forecast, from WeatherAPI.
+var WeatherTool = Define("get_weather", "Get weather at the given location", getWeather, WithTimeout(10*time.Second))
 
-func (w WeatherTool) Handle(ctx context.Context, args json.RawMessage) (string, error) {
-	var wa WeatherArgs
-	if err := json.Unmarshal(args, &wa); err != nil {
-		return "failed to parse weather arguments", err
-	}
+func getWeather(ctx context.Context, wa WeatherArgs) (string, error) {
 	weatherAPIKey := os.Getenv("WEATHER_API_KEY")
 	url := "https://api.weatherapi.com/v1/"
 	params := fmt.Sprintf("?key=%s&q=%s", weatherAPIKey, wa.Location)
@@ -53,7 +29,11 @@ func (w WeatherTool) Handle(ctx context.Context, args json.RawMessage) (string,
 		apiPath = "current.json"
 	}
 	url += apiPath + params
-	resp, err := http.Get(url)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return "failed to get weather data", err
+	}
+	resp, err := http.DefaultClient.Do(req)
 	if err != nil {
 		return "failed to get weather data", err
 	}
@@ -66,5 +46,5 @@ func (w WeatherTool) Handle(ctx context.Context, args json.RawMessage) (string,
 }
 
 func init() {
-	Register(WeatherTool{})
+	Register(WeatherTool)
 }
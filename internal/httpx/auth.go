@@ -0,0 +1,217 @@
+package httpx
+
+import (
+	"context"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/twitchtv/twirp"
+)
+
+// APIKeyHeader carries an API key, which may also be sent as a bearer token in the Authorization header.
+const APIKeyHeader = "X-API-Key"
+
+// defaultLeeway tolerates clock skew between the issuer of tokens and the server.
+const defaultLeeway = time.Minute
+
+// KeyFile is the JSON file listing the credentials the server accepts, e.g.
+//
+//	{
+//	  "api_keys": [{"user": "alice", "sha256": "<hex SHA-256 of the key>", "tenant": "sales", "roles": ["admin"]}],
+//	  "jwt": {
+//	    "issuer": "https://auth.example.com",
+//	    "audience": "chat",
+//	    "keys": [
+//	      {"kid": "2024-01", "alg": "HS256", "secret": "<at least 32 bytes>"},
+//	      {"kid": "2024-02", "alg": "RS256", "public_key": "-----BEGIN PUBLIC KEY-----\n..."}
+//	    ]
+//	  }
+//	}
+//
+// API keys are stored hashed, so the file never holds a usable key. Their tenant and roles are optional, JWTs carry
+// theirs in the "tenant" and "roles" claims. Issuer and audience are only checked when set.
+type KeyFile struct {
+	APIKeys []struct {
+		User   string   `json:"user"`
+		SHA256 string   `json:"sha256"`
+		Tenant string   `json:"tenant"`
+		Roles  []string `json:"roles"`
+	} `json:"api_keys"`
+
+	JWT struct {
+		Issuer   string `json:"issuer"`
+		Audience string `json:"audience"`
+		Keys     []struct {
+			ID        string `json:"kid"`
+			Algorithm string `json:"alg"`
+			Secret    string `json:"secret"`
+			PublicKey string `json:"public_key"`
+		} `json:"keys"`
+	} `json:"jwt"`
+}
+
+// Authenticator identifies the callers of requests by their API key or JWT.
+type Authenticator struct {
+	apiKeys  map[[sha256.Size]byte]Identity
+	jwtKeys  []jwtKey
+	issuer   string
+	audience string
+	leeway   time.Duration
+	now      func() time.Time
+}
+
+// NewAuthenticator creates an authenticator accepting the credentials listed in the key file.
+func NewAuthenticator(f KeyFile) (*Authenticator, error) {
+	a := &Authenticator{
+		apiKeys:  map[[sha256.Size]byte]Identity{},
+		issuer:   f.JWT.Issuer,
+		audience: f.JWT.Audience,
+		leeway:   defaultLeeway,
+		now:      time.Now,
+	}
+
+	for i, k := range f.APIKeys {
+		hash, err := hex.DecodeString(k.SHA256)
+		if err != nil || len(hash) != sha256.Size {
+			return nil, fmt.Errorf("api key %d: sha256 must be a hex encoded SHA-256 hash", i)
+		}
+		if strings.TrimSpace(k.User) == "" {
+			return nil, fmt.Errorf("api key %d: user is required", i)
+		}
+		a.apiKeys[[sha256.Size]byte(hash)] = Identity{User: k.User, Tenant: k.Tenant, Roles: k.Roles}
+	}
+
+	for _, k := range f.JWT.Keys {
+		key, err := newJWTKey(k.ID, k.Algorithm, k.Secret, k.PublicKey)
+		if err != nil {
+			return nil, err
+		}
+		a.jwtKeys = append(a.jwtKeys, key)
+	}
+
+	if len(a.apiKeys) == 0 && len(a.jwtKeys) == 0 {
+		return nil, errors.New("no api keys nor jwt keys configured")
+	}
+
+	return a, nil
+}
+
+// LoadAuthenticator creates an authenticator from the key file at path, see KeyFile.
+func LoadAuthenticator(path string) (*Authenticator, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read key file: %w", err)
+	}
+
+	var f KeyFile
+	if err := json.Unmarshal(data, &f); err != nil {
+		return nil, fmt.Errorf("failed to parse key file %s: %w", path, err)
+	}
+
+	a, err := NewAuthenticator(f)
+	if err != nil {
+		return nil, fmt.Errorf("invalid key file %s: %w", path, err)
+	}
+
+	return a, nil
+}
+
+// Authenticate returns the identity of the caller of the request. Bearer tokens that look like a JWT are verified as
+// such, any other credential is looked up among the API keys.
+func (a *Authenticator) Authenticate(r *http.Request) (Identity, error) {
+	credential := strings.TrimSpace(r.Header.Get(APIKeyHeader))
+	if credential == "" {
+		scheme, token, _ := strings.Cut(r.Header.Get("Authorization"), " ")
+		if !strings.EqualFold(scheme, "Bearer") {
+			return Identity{}, errors.New("missing credentials")
+		}
+		credential = strings.TrimSpace(token)
+	}
+
+	if credential == "" {
+		return Identity{}, errors.New("missing credentials")
+	}
+
+	if strings.Count(credential, ".") == 2 && len(a.jwtKeys) > 0 {
+		claims, err := a.verifyJWT(credential)
+		if err != nil {
+			return Identity{}, err
+		}
+		id := Identity{User: claims["sub"].(string), Claims: claims}
+		id.Tenant, _ = claims["tenant"].(string)
+		if roles, ok := claims["roles"].([]any); ok {
+			for _, r := range roles {
+				if role, ok := r.(string); ok {
+					id.Roles = append(id.Roles, role)
+				}
+			}
+		}
+		return id, nil
+	}
+
+	return a.apiKey(credential)
+}
+
+func (a *Authenticator) apiKey(key string) (Identity, error) {
+	hash := sha256.Sum256([]byte(key))
+
+	// Hashing first makes the lookup independent of the key, compare anyway to not rely on map internals
+	for known, id := range a.apiKeys {
+		if subtle.ConstantTimeCompare(known[:], hash[:]) == 1 {
+			return id, nil
+		}
+	}
+
+	return Identity{}, errors.New("unknown api key")
+}
+
+// Auth rejects requests without valid credentials with a twirp.Unauthenticated error, and stores the identity of the
+// caller in the context of the others, see UserFrom and IdentityFrom.
+func Auth(a *Authenticator) func(handler http.Handler) http.Handler {
+	return func(handler http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			id, err := a.Authenticate(r)
+			if err != nil {
+				slog.InfoContext(r.Context(), "Request rejected", "reason", err)
+				w.Header().Set("WWW-Authenticate", "Bearer")
+				_ = twirp.WriteError(w, twirp.NewError(twirp.Unauthenticated, "invalid or missing credentials"))
+				return
+			}
+
+			handler.ServeHTTP(w, r.WithContext(WithIdentity(r.Context(), id)))
+		})
+	}
+}
+
+type authDisabledKey struct{}
+
+// NoAuth marks requests as served without authentication, in place of Auth when no key file is configured. Anonymous
+// callers are then trusted like admins, see AuthDisabled.
+func NoAuth() func(handler http.Handler) http.Handler {
+	return func(handler http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			handler.ServeHTTP(w, r.WithContext(WithAuthDisabled(r.Context())))
+		})
+	}
+}
+
+// WithAuthDisabled returns a copy of ctx marking the request as served without authentication.
+func WithAuthDisabled(ctx context.Context) context.Context {
+	return context.WithValue(ctx, authDisabledKey{}, true)
+}
+
+// AuthDisabled reports whether the request is served without authentication, see NoAuth. Requests without an identity
+// are not trusted otherwise, even if they somehow got past Auth.
+func AuthDisabled(ctx context.Context) bool {
+	disabled, _ := ctx.Value(authDisabledKey{}).(bool)
+	return disabled
+}
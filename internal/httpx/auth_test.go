@@ -0,0 +1,187 @@
+package httpx
+
+import (
+	"crypto"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"encoding/pem"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+const hsSecret = "0123456789abcdef0123456789abcdef"
+
+func TestAuth(t *testing.T) {
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatalf("failed to generate RSA key: %v", err)
+	}
+
+	der, err := x509.MarshalPKIXPublicKey(&rsaKey.PublicKey)
+	if err != nil {
+		t.Fatalf("failed to marshal RSA public key: %v", err)
+	}
+
+	apiKeyHash := sha256.Sum256([]byte("secret-api-key"))
+
+	var f KeyFile
+	if err := json.Unmarshal([]byte(`{
+		"api_keys": [{"user": "alice", "sha256": "`+hex.EncodeToString(apiKeyHash[:])+`", "tenant": "support"}],
+		"jwt": {"issuer": "auth", "audience": "chat", "keys": [
+			{"kid": "hs", "alg": "HS256", "secret": "`+hsSecret+`"},
+			{"kid": "rs", "alg": "RS256", "public_key": `+jsonString(string(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der})))+`}
+		]}
+	}`), &f); err != nil {
+		t.Fatalf("failed to parse key file: %v", err)
+	}
+
+	a, err := NewAuthenticator(f)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	now := time.Date(2025, 8, 20, 10, 0, 0, 0, time.UTC)
+	a.now = func() time.Time { return now }
+
+	claims := func(mods ...func(map[string]any)) map[string]any {
+		c := map[string]any{"sub": "bob", "iss": "auth", "aud": "chat", "exp": now.Add(time.Hour).Unix()}
+		for _, mod := range mods {
+			mod(c)
+		}
+		return c
+	}
+
+	hs256 := func(header, claims map[string]any) string {
+		signed := segment(header) + "." + segment(claims)
+		mac := hmac.New(sha256.New, []byte(hsSecret))
+		mac.Write([]byte(signed))
+		return signed + "." + base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+	}
+
+	rs256 := func(claims map[string]any) string {
+		signed := segment(map[string]any{"alg": "RS256", "kid": "rs"}) + "." + segment(claims)
+		digest := sha256.Sum256([]byte(signed))
+		sig, err := rsa.SignPKCS1v15(rand.Reader, rsaKey, crypto.SHA256, digest[:])
+		if err != nil {
+			t.Fatalf("failed to sign token: %v", err)
+		}
+		return signed + "." + base64.RawURLEncoding.EncodeToString(sig)
+	}
+
+	hsHeader := map[string]any{"alg": "HS256", "kid": "hs"}
+
+	tests := []struct {
+		name   string
+		header http.Header
+		user   string
+		tenant string
+		admin  bool
+	}{
+		{name: "api key header", header: apiKeyHeader("secret-api-key"), user: "alice", tenant: "support"},
+		{name: "api key as bearer token", header: http.Header{"Authorization": {"Bearer secret-api-key"}}, user: "alice", tenant: "support"},
+		{name: "HS256 token", header: bearerHeader(hs256(hsHeader, claims())), user: "bob"},
+		{name: "RS256 token", header: bearerHeader(rs256(claims())), user: "bob"},
+		{name: "token with tenant and roles", header: bearerHeader(hs256(hsHeader, claims(func(c map[string]any) { c["tenant"] = "sales"; c["roles"] = []string{"admin"} }))), user: "bob", tenant: "sales", admin: true},
+		{name: "audience among several", header: bearerHeader(hs256(hsHeader, claims(func(c map[string]any) { c["aud"] = []string{"other", "chat"} }))), user: "bob"},
+		{name: "missing credentials", header: http.Header{}},
+		{name: "unknown api key", header: apiKeyHeader("guessed")},
+		{name: "basic auth", header: http.Header{"Authorization": {"Basic YWxpY2U6cGFzcw=="}}},
+		{name: "tampered claims", header: bearerHeader(tamper(hs256(hsHeader, claims()), claims(func(c map[string]any) { c["sub"] = "admin" })))},
+		{name: "unsigned token", header: bearerHeader(segment(map[string]any{"alg": "none"}) + "." + segment(claims()) + ".")},
+		{name: "HS256 token claiming the RSA key", header: bearerHeader(hs256(map[string]any{"alg": "HS256", "kid": "rs"}, claims()))},
+		{name: "expired token", header: bearerHeader(hs256(hsHeader, claims(func(c map[string]any) { c["exp"] = now.Add(-2 * time.Minute).Unix() })))},
+		{name: "token without expiry", header: bearerHeader(hs256(hsHeader, claims(func(c map[string]any) { delete(c, "exp") })))},
+		{name: "token not valid yet", header: bearerHeader(hs256(hsHeader, claims(func(c map[string]any) { c["nbf"] = now.Add(time.Hour).Unix() })))},
+		{name: "token without subject", header: bearerHeader(hs256(hsHeader, claims(func(c map[string]any) { delete(c, "sub") })))},
+		{name: "token from another issuer", header: bearerHeader(hs256(hsHeader, claims(func(c map[string]any) { c["iss"] = "evil" })))},
+		{name: "token for another audience", header: bearerHeader(hs256(hsHeader, claims(func(c map[string]any) { c["aud"] = "other" })))},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var id Identity
+			handler := Auth(a)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				id, _ = IdentityFrom(r.Context())
+			}))
+
+			req := httptest.NewRequest(http.MethodPost, "/twirp/acai.chat.ChatService/ListConversations", nil)
+			req.Header = tt.header
+			rec := httptest.NewRecorder()
+			handler.ServeHTTP(rec, req)
+
+			if tt.user == "" {
+				if rec.Code != http.StatusUnauthorized {
+					t.Errorf("expected status 401, got %d", rec.Code)
+				}
+				if id.User != "" {
+					t.Errorf("expected the handler not to be called, it was for %q", id.User)
+				}
+				return
+			}
+
+			if rec.Code != http.StatusOK || id.User != tt.user {
+				t.Errorf("expected %q to be authenticated, got status %d and user %q", tt.user, rec.Code, id.User)
+			}
+
+			if id.Tenant != tt.tenant || id.HasRole(RoleAdmin) != tt.admin {
+				t.Errorf("unexpected tenant %q and roles %v", id.Tenant, id.Roles)
+			}
+		})
+	}
+}
+
+func TestNewAuthenticator(t *testing.T) {
+	for name, f := range map[string]string{
+		"no keys":            `{}`,
+		"short HS256 secret": `{"jwt": {"keys": [{"alg": "HS256", "secret": "short"}]}}`,
+		"unknown algorithm":  `{"jwt": {"keys": [{"alg": "none"}]}}`,
+		"invalid public key": `{"jwt": {"keys": [{"alg": "RS256", "public_key": "not a key"}]}}`,
+		"invalid hash":       `{"api_keys": [{"user": "alice", "sha256": "abc"}]}`,
+	} {
+		t.Run(name, func(t *testing.T) {
+			var kf KeyFile
+			if err := json.Unmarshal([]byte(f), &kf); err != nil {
+				t.Fatalf("failed to parse key file: %v", err)
+			}
+
+			if _, err := NewAuthenticator(kf); err == nil {
+				t.Error("expected an error")
+			}
+		})
+	}
+}
+
+func segment(v map[string]any) string {
+	data, _ := json.Marshal(v)
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+// tamper replaces the claims of a signed token, keeping its signature.
+func tamper(token string, claims map[string]any) string {
+	parts := strings.Split(token, ".")
+	return parts[0] + "." + segment(claims) + "." + parts[2]
+}
+
+func apiKeyHeader(key string) http.Header {
+	h := http.Header{}
+	h.Set(APIKeyHeader, key)
+	return h
+}
+
+func bearerHeader(token string) http.Header {
+	return http.Header{"Authorization": {"Bearer " + token}}
+}
+
+func jsonString(s string) string {
+	data, _ := json.Marshal(s)
+	return string(data)
+}
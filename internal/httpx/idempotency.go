@@ -0,0 +1,49 @@
+package httpx
+
+import (
+	"context"
+	"net/http"
+	"strings"
+
+	"github.com/twitchtv/twirp"
+)
+
+// IdempotencyKeyHeader carries a key chosen by the client that identifies a request across its retries.
+const IdempotencyKeyHeader = "Idempotency-Key"
+
+// MaxIdempotencyKeyLength bounds the length of idempotency keys, UUIDs and the like fit comfortably.
+const MaxIdempotencyKeyLength = 255
+
+type idempotencyKey struct{}
+
+// IdempotencyKey stores the IdempotencyKeyHeader of a request in its context, see IdempotencyKeyFrom. Keys that are too
+// long are rejected with a twirp.InvalidArgument error.
+func IdempotencyKey() func(handler http.Handler) http.Handler {
+	return func(handler http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			key := strings.TrimSpace(r.Header.Get(IdempotencyKeyHeader))
+
+			if len(key) > MaxIdempotencyKeyLength {
+				_ = twirp.WriteError(w, twirp.InvalidArgumentError(IdempotencyKeyHeader, "is too long"))
+				return
+			}
+
+			if key != "" {
+				r = r.WithContext(WithIdempotencyKey(r.Context(), key))
+			}
+
+			handler.ServeHTTP(w, r)
+		})
+	}
+}
+
+// WithIdempotencyKey returns a copy of ctx carrying the idempotency key of the request.
+func WithIdempotencyKey(ctx context.Context, key string) context.Context {
+	return context.WithValue(ctx, idempotencyKey{}, key)
+}
+
+// IdempotencyKeyFrom returns the idempotency key of the request, or an empty string when it has none.
+func IdempotencyKeyFrom(ctx context.Context) string {
+	key, _ := ctx.Value(idempotencyKey{}).(string)
+	return key
+}
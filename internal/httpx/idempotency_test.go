@@ -0,0 +1,46 @@
+package httpx
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestIdempotencyKey(t *testing.T) {
+	tests := []struct {
+		name   string
+		header string
+		status int
+		key    string
+	}{
+		{name: "no key", status: http.StatusOK},
+		{name: "key", header: " 5f0c6a2e-retry ", status: http.StatusOK, key: "5f0c6a2e-retry"},
+		{name: "key too long", header: strings.Repeat("k", MaxIdempotencyKeyLength+1), status: http.StatusBadRequest},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var key string
+			handler := IdempotencyKey()(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				key = IdempotencyKeyFrom(r.Context())
+			}))
+
+			req := httptest.NewRequest(http.MethodPost, "/twirp/acai.chat.ChatService/StartConversation", nil)
+			if tt.header != "" {
+				req.Header.Set(IdempotencyKeyHeader, tt.header)
+			}
+
+			rec := httptest.NewRecorder()
+			handler.ServeHTTP(rec, req)
+
+			if rec.Code != tt.status {
+				t.Fatalf("expected status %d, got %d", tt.status, rec.Code)
+			}
+
+			if key != tt.key {
+				t.Errorf("expected key %q, got %q", tt.key, key)
+			}
+		})
+	}
+}
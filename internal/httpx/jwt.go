@@ -0,0 +1,179 @@
+package httpx
+
+import (
+	"crypto"
+	"crypto/hmac"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+)
+
+// jwtKey verifies the signatures of tokens signed with one algorithm, HS256 or RS256.
+type jwtKey struct {
+	id        string
+	algorithm string
+	secret    []byte
+	public    *rsa.PublicKey
+}
+
+func newJWTKey(id, algorithm, secret, publicKey string) (jwtKey, error) {
+	k := jwtKey{id: id, algorithm: algorithm}
+
+	switch algorithm {
+	case "HS256":
+		if len(secret) < 32 {
+			return k, fmt.Errorf("HS256 key %q: secret must be at least 32 bytes", id)
+		}
+		k.secret = []byte(secret)
+	case "RS256":
+		block, _ := pem.Decode([]byte(publicKey))
+		if block == nil {
+			return k, fmt.Errorf("RS256 key %q: public key is not PEM encoded", id)
+		}
+
+		pub, err := x509.ParsePKIXPublicKey(block.Bytes)
+		if err != nil {
+			return k, fmt.Errorf("RS256 key %q: %w", id, err)
+		}
+
+		rsaPub, ok := pub.(*rsa.PublicKey)
+		if !ok {
+			return k, fmt.Errorf("RS256 key %q: not an RSA public key", id)
+		}
+		k.public = rsaPub
+	default:
+		return k, fmt.Errorf("key %q: unsupported algorithm %q, expected HS256 or RS256", id, algorithm)
+	}
+
+	return k, nil
+}
+
+func (k jwtKey) verify(signed, signature []byte) error {
+	switch k.algorithm {
+	case "HS256":
+		mac := hmac.New(sha256.New, k.secret)
+		mac.Write(signed)
+		if !hmac.Equal(mac.Sum(nil), signature) {
+			return errors.New("invalid signature")
+		}
+		return nil
+	case "RS256":
+		digest := sha256.Sum256(signed)
+		if err := rsa.VerifyPKCS1v15(k.public, crypto.SHA256, digest[:], signature); err != nil {
+			return errors.New("invalid signature")
+		}
+		return nil
+	}
+
+	return errors.New("unsupported algorithm")
+}
+
+// verifyJWT checks the signature and the registered claims of a compact JWT, and returns its claims. The algorithm of
+// the token must match the one of the key, so an HS256 token can never be checked against an RSA public key.
+func (a *Authenticator) verifyJWT(token string) (map[string]any, error) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return nil, errors.New("malformed token")
+	}
+
+	var header struct {
+		Algorithm string `json:"alg"`
+		KeyID     string `json:"kid"`
+	}
+	if err := decodeSegment(parts[0], &header); err != nil {
+		return nil, fmt.Errorf("malformed token header: %w", err)
+	}
+
+	signature, err := base64.RawURLEncoding.DecodeString(parts[2])
+	if err != nil {
+		return nil, errors.New("malformed token signature")
+	}
+
+	signed := []byte(parts[0] + "." + parts[1])
+	verified := false
+	for _, k := range a.jwtKeys {
+		if k.algorithm != header.Algorithm || (header.KeyID != "" && k.id != header.KeyID) {
+			continue
+		}
+		if k.verify(signed, signature) == nil {
+			verified = true
+			break
+		}
+	}
+
+	if !verified {
+		return nil, errors.New("invalid token signature")
+	}
+
+	var claims map[string]any
+	if err := decodeSegment(parts[1], &claims); err != nil {
+		return nil, fmt.Errorf("malformed token claims: %w", err)
+	}
+
+	if err := a.checkClaims(claims); err != nil {
+		return nil, err
+	}
+
+	return claims, nil
+}
+
+// checkClaims validates the time and audience claims, tokens must expire and name their subject.
+func (a *Authenticator) checkClaims(claims map[string]any) error {
+	now := a.now()
+
+	exp, ok := claims["exp"].(float64)
+	if !ok {
+		return errors.New("token has no expiry")
+	}
+	if !now.Before(time.Unix(int64(exp), 0).Add(a.leeway)) {
+		return errors.New("token expired")
+	}
+
+	if nbf, ok := claims["nbf"].(float64); ok && now.Add(a.leeway).Before(time.Unix(int64(nbf), 0)) {
+		return errors.New("token not valid yet")
+	}
+
+	if sub, _ := claims["sub"].(string); sub == "" {
+		return errors.New("token has no subject")
+	}
+
+	if a.issuer != "" && claims["iss"] != a.issuer {
+		return errors.New("token issued by an unknown issuer")
+	}
+
+	if a.audience != "" {
+		var audiences []string
+		switch aud := claims["aud"].(type) {
+		case string:
+			audiences = []string{aud}
+		case []any:
+			for _, v := range aud {
+				if s, ok := v.(string); ok {
+					audiences = append(audiences, s)
+				}
+			}
+		}
+
+		if !slices.Contains(audiences, a.audience) {
+			return errors.New("token is meant for another audience")
+		}
+	}
+
+	return nil
+}
+
+func decodeSegment(segment string, v any) error {
+	data, err := base64.RawURLEncoding.DecodeString(segment)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(data, v)
+}
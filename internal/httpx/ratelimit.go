@@ -0,0 +1,147 @@
+package httpx
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"math"
+	"net"
+	"net/http"
+	"os"
+	"path"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/twitchtv/twirp"
+)
+
+// RateLimitStore keeps the token buckets of the rate limiter. MemoryRateLimitStore suits a single replica, replicas
+// sharing their limits need a shared store like model.Repository.
+type RateLimitStore interface {
+	// TakeToken takes a token from the bucket of key, which holds up to burst tokens and is refilled with rate tokens
+	// per second, starting full. When the bucket is empty, it returns false and how long until it holds a token again.
+	TakeToken(ctx context.Context, key string, rate float64, burst int) (bool, time.Duration, error)
+}
+
+// Rate allows a number of requests per period, in bursts of up to Requests. The zero value is unlimited.
+type Rate struct {
+	Requests int
+	Per      time.Duration
+}
+
+// ParseRate parses rates like "10/s", "30/m" or "100/h".
+func ParseRate(s string) (Rate, error) {
+	count, unit, ok := strings.Cut(strings.TrimSpace(s), "/")
+	requests, err := strconv.Atoi(count)
+	if !ok || err != nil || requests <= 0 {
+		return Rate{}, fmt.Errorf("invalid rate %q, expected a number of requests per s, m or h, e.g. 30/m", s)
+	}
+
+	per, ok := map[string]time.Duration{"s": time.Second, "m": time.Minute, "h": time.Hour}[unit]
+	if !ok {
+		return Rate{}, fmt.Errorf("invalid rate %q, expected a number of requests per s, m or h, e.g. 30/m", s)
+	}
+
+	return Rate{Requests: requests, Per: per}, nil
+}
+
+// RateLimits are the rates allowed to each caller, per method.
+type RateLimits struct {
+	// Default applies to the methods without a rate of their own.
+	Default Rate
+	// Methods are the rates of the methods, by name, e.g. "StartConversation".
+	Methods map[string]Rate
+}
+
+// RateLimitsFromEnv reads the default rate from RATE_LIMIT, e.g. "60/m", and the rates of methods from
+// RATE_LIMIT_METHODS, e.g. "StartConversation=10/m,ContinueConversation=30/m". It panics if they are invalid.
+func RateLimitsFromEnv() RateLimits {
+	limits := RateLimits{Methods: map[string]Rate{}}
+
+	if v := os.Getenv("RATE_LIMIT"); v != "" {
+		rate, err := ParseRate(v)
+		if err != nil {
+			panic(fmt.Errorf("invalid RATE_LIMIT: %w", err))
+		}
+		limits.Default = rate
+	}
+
+	if v := os.Getenv("RATE_LIMIT_METHODS"); v != "" {
+		for _, entry := range strings.Split(v, ",") {
+			method, value, ok := strings.Cut(entry, "=")
+			if !ok {
+				panic(fmt.Errorf("invalid RATE_LIMIT_METHODS entry %q, expected Method=rate", entry))
+			}
+
+			rate, err := ParseRate(value)
+			if err != nil {
+				panic(fmt.Errorf("invalid RATE_LIMIT_METHODS: %w", err))
+			}
+			limits.Methods[strings.TrimSpace(method)] = rate
+		}
+	}
+
+	return limits
+}
+
+// RateLimit limits the rate of requests of each caller to each method, the method being the last segment of the
+// request path, so Twirp and streaming calls share their limits. Callers are identified by their user, see UserFrom,
+// or by their IP address when anonymous, so it must run after Auth. Limited requests are rejected with a
+// twirp.ResourceExhausted error and a Retry-After header. Requests are let through when the store fails, so an outage
+// of the store does not take the API down with it.
+func RateLimit(store RateLimitStore, limits RateLimits) func(handler http.Handler) http.Handler {
+	return func(handler http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			method := path.Base(r.URL.Path)
+
+			rate, ok := limits.Methods[method]
+			if !ok {
+				rate = limits.Default
+			}
+
+			if rate.Requests == 0 {
+				handler.ServeHTTP(w, r)
+				return
+			}
+
+			key := "rate:" + caller(r) + ":" + method
+			refill := float64(rate.Requests) / rate.Per.Seconds()
+
+			allowed, wait, err := store.TakeToken(r.Context(), key, refill, rate.Requests)
+			if err != nil {
+				slog.ErrorContext(r.Context(), "Rate limiter failed, letting the request through", "error", err)
+				allowed = true
+			}
+
+			if !allowed {
+				retryAfter := strconv.Itoa(int(math.Ceil(wait.Seconds())))
+				slog.InfoContext(r.Context(), "Request rate limited", "key", key, "retry_after", retryAfter)
+
+				w.Header().Set("Retry-After", retryAfter)
+				_ = twirp.WriteError(w, twirp.NewError(twirp.ResourceExhausted, "too many requests, retry later").
+					WithMeta("retry_after", retryAfter))
+				return
+			}
+
+			handler.ServeHTTP(w, r)
+		})
+	}
+}
+
+// caller identifies the caller of the request for rate limiting. Users are scoped to their tenant, as the same user ID
+// may be taken in several tenants.
+func caller(r *http.Request) string {
+	if user := UserFrom(r.Context()); user != "" {
+		if tenant := TenantFrom(r.Context()); tenant != "" {
+			return "tenant:" + tenant + ":user:" + user
+		}
+		return "user:" + user
+	}
+
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		host = r.RemoteAddr
+	}
+	return "ip:" + host
+}
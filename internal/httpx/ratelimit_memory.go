@@ -0,0 +1,75 @@
+package httpx
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+var _ RateLimitStore = (*MemoryRateLimitStore)(nil)
+
+// sweepInterval is how often full buckets are dropped, a full bucket is the same as no bucket.
+const sweepInterval = time.Minute
+
+// MemoryRateLimitStore keeps token buckets in memory, limits are per replica.
+type MemoryRateLimitStore struct {
+	mu      sync.Mutex
+	buckets map[string]*bucket
+	swept   time.Time
+	now     func() time.Time
+}
+
+type bucket struct {
+	tokens  float64
+	rate    float64
+	burst   int
+	updated time.Time
+}
+
+func NewMemoryRateLimitStore() *MemoryRateLimitStore {
+	return &MemoryRateLimitStore{buckets: map[string]*bucket{}, now: time.Now}
+}
+
+func (s *MemoryRateLimitStore) TakeToken(ctx context.Context, key string, rate float64, burst int) (bool, time.Duration, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := s.now()
+	s.sweep(now)
+
+	b, ok := s.buckets[key]
+	if !ok {
+		b = &bucket{tokens: float64(burst), updated: now}
+		s.buckets[key] = b
+	}
+
+	b.rate, b.burst = rate, burst
+	b.refill(now)
+
+	if b.tokens < 1 {
+		return false, time.Duration((1 - b.tokens) / rate * float64(time.Second)), nil
+	}
+
+	b.tokens--
+	return true, 0, nil
+}
+
+func (b *bucket) refill(now time.Time) {
+	b.tokens = min(float64(b.burst), b.tokens+now.Sub(b.updated).Seconds()*b.rate)
+	b.updated = now
+}
+
+// sweep drops the buckets that refilled completely, so callers that went away do not use memory forever.
+func (s *MemoryRateLimitStore) sweep(now time.Time) {
+	if now.Sub(s.swept) < sweepInterval {
+		return
+	}
+	s.swept = now
+
+	for key, b := range s.buckets {
+		b.refill(now)
+		if b.tokens >= float64(b.burst) {
+			delete(s.buckets, key)
+		}
+	}
+}
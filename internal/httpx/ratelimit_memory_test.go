@@ -0,0 +1,12 @@
+package httpx_test
+
+import (
+	"testing"
+
+	. "github.com/acai-travel/tech-challenge/internal/chat/testing"
+	"github.com/acai-travel/tech-challenge/internal/httpx"
+)
+
+func TestMemoryRateLimitStore(t *testing.T) {
+	RunRateLimitStoreContract(t, httpx.NewMemoryRateLimitStore())
+}
@@ -0,0 +1,107 @@
+package httpx
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestRateLimit(t *testing.T) {
+	limits := RateLimits{
+		Default: Rate{Requests: 3, Per: time.Minute},
+		Methods: map[string]Rate{"StartConversation": {Requests: 1, Per: time.Minute}},
+	}
+
+	call := func(handler http.Handler, method, user, addr string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodPost, "/twirp/acai.chat.ChatService/"+method, nil)
+		req.RemoteAddr = addr
+		if tenant, name, ok := strings.Cut(user, "/"); ok {
+			req = req.WithContext(WithTenant(req.Context(), tenant))
+			user = name
+		}
+		if user != "" {
+			req = req.WithContext(WithUser(req.Context(), user))
+		}
+
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+		return rec
+	}
+
+	ok := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {})
+
+	t.Run("methods have limits of their own", func(t *testing.T) {
+		handler := RateLimit(NewMemoryRateLimitStore(), limits)(ok)
+
+		if rec := call(handler, "StartConversation", "alice", "10.0.0.1:1234"); rec.Code != http.StatusOK {
+			t.Fatalf("expected the first request to pass, got %d", rec.Code)
+		}
+
+		rec := call(handler, "StartConversation", "alice", "10.0.0.1:1234")
+		if rec.Code != http.StatusTooManyRequests || rec.Header().Get("Retry-After") != "60" {
+			t.Fatalf("expected the second request to be limited for a minute, got %d and Retry-After %q", rec.Code, rec.Header().Get("Retry-After"))
+		}
+
+		for i := range 3 {
+			if rec := call(handler, "ListConversations", "alice", "10.0.0.1:1234"); rec.Code != http.StatusOK {
+				t.Fatalf("expected request %d to other methods to pass, got %d", i+1, rec.Code)
+			}
+		}
+
+		if rec := call(handler, "ListConversations", "alice", "10.0.0.1:1234"); rec.Code != http.StatusTooManyRequests {
+			t.Fatalf("expected the default limit to apply, got %d", rec.Code)
+		}
+	})
+
+	t.Run("callers are limited by user, or by IP when anonymous", func(t *testing.T) {
+		handler := RateLimit(NewMemoryRateLimitStore(), limits)(ok)
+
+		for _, c := range []struct{ user, addr string }{
+			{"alice", "10.0.0.1:1234"},
+			{"bob", "10.0.0.1:1234"},
+			// users of different tenants may share their ID
+			{"sales/alice", "10.0.0.1:1234"},
+			{"support/alice", "10.0.0.1:1234"},
+			{"", "10.0.0.1:1234"},
+			{"", "10.0.0.2:1234"},
+		} {
+			if rec := call(handler, "StartConversation", c.user, c.addr); rec.Code != http.StatusOK {
+				t.Errorf("expected %q from %s to have a limit of their own, got %d", c.user, c.addr, rec.Code)
+			}
+		}
+
+		if rec := call(handler, "StartConversation", "", "10.0.0.2:5678"); rec.Code != http.StatusTooManyRequests {
+			t.Errorf("expected anonymous callers to be limited by IP, got %d", rec.Code)
+		}
+	})
+
+	t.Run("requests pass when the store fails", func(t *testing.T) {
+		handler := RateLimit(failingStore{}, limits)(ok)
+
+		if rec := call(handler, "StartConversation", "alice", "10.0.0.1:1234"); rec.Code != http.StatusOK {
+			t.Errorf("expected the request to pass, got %d", rec.Code)
+		}
+	})
+}
+
+func TestParseRate(t *testing.T) {
+	if rate, err := ParseRate("30/m"); err != nil || rate != (Rate{Requests: 30, Per: time.Minute}) {
+		t.Errorf("unexpected rate %v, %v", rate, err)
+	}
+
+	for _, s := range []string{"", "30", "0/m", "-1/s", "30/d", "x/m"} {
+		if _, err := ParseRate(s); err == nil {
+			t.Errorf("expected %q to be invalid", s)
+		}
+	}
+}
+
+type failingStore struct{}
+
+func (failingStore) TakeToken(context.Context, string, float64, int) (bool, time.Duration, error) {
+	return false, 0, errors.New("store is down")
+}
@@ -0,0 +1,57 @@
+package httpx
+
+import (
+	"context"
+	"net/http"
+	"strings"
+
+	"github.com/twitchtv/twirp"
+)
+
+// TenantHeader selects the tenant of a request, for admins and when authentication is disabled.
+const TenantHeader = "X-Tenant-Id"
+
+type tenantKey struct{}
+
+// Tenant stores the tenant of a request in its context, see TenantFrom. Callers are in the tenant of their credentials,
+// or outside any tenant if their credentials do not tie them to one. Only admins, and anyone when authentication is
+// disabled, may pick another tenant with the TenantHeader, other requests asking for one are rejected with
+// twirp.PermissionDenied. It must run after Auth or NoAuth.
+func Tenant() func(handler http.Handler) http.Handler {
+	return func(handler http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			tenant := strings.TrimSpace(r.Header.Get(TenantHeader))
+			id, authenticated := IdentityFrom(r.Context())
+
+			switch {
+			case authenticated && id.Tenant != "":
+				if tenant != "" && tenant != id.Tenant {
+					_ = twirp.WriteError(w, twirp.NewError(twirp.PermissionDenied, "credentials belong to another tenant"))
+					return
+				}
+				tenant = id.Tenant
+			case tenant == "", authenticated && id.HasRole(RoleAdmin), AuthDisabled(r.Context()):
+			default:
+				_ = twirp.WriteError(w, twirp.NewError(twirp.PermissionDenied, "credentials do not belong to the tenant"))
+				return
+			}
+
+			if tenant != "" {
+				r = r.WithContext(WithTenant(r.Context(), tenant))
+			}
+
+			handler.ServeHTTP(w, r)
+		})
+	}
+}
+
+// WithTenant returns a copy of ctx carrying the tenant of the request.
+func WithTenant(ctx context.Context, tenant string) context.Context {
+	return context.WithValue(ctx, tenantKey{}, tenant)
+}
+
+// TenantFrom returns the tenant of the request, or an empty string for requests outside any tenant.
+func TenantFrom(ctx context.Context) string {
+	tenant, _ := ctx.Value(tenantKey{}).(string)
+	return tenant
+}
@@ -0,0 +1,56 @@
+package httpx
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestTenant(t *testing.T) {
+	tests := []struct {
+		name     string
+		identity *Identity
+		disabled bool
+		header   string
+		status   int
+		tenant   string
+	}{
+		{name: "no tenant", status: http.StatusOK},
+		{name: "header picks the tenant without authentication", disabled: true, header: "sales", status: http.StatusOK, tenant: "sales"},
+		{name: "anonymous callers can not pick a tenant with authentication", header: "sales", status: http.StatusForbidden},
+		{name: "tenant of the credentials", identity: &Identity{User: "alice", Tenant: "sales"}, status: http.StatusOK, tenant: "sales"},
+		{name: "same tenant in both", identity: &Identity{User: "alice", Tenant: "sales"}, header: "sales", status: http.StatusOK, tenant: "sales"},
+		{name: "credentials without tenant stay outside any tenant", identity: &Identity{User: "alice"}, status: http.StatusOK},
+		{name: "credentials without tenant can not pick one", identity: &Identity{User: "alice"}, header: "support", status: http.StatusForbidden},
+		{name: "admins pick any tenant", identity: &Identity{User: "root", Roles: []string{RoleAdmin}}, header: "support", status: http.StatusOK, tenant: "support"},
+		{name: "header asks for another tenant", identity: &Identity{User: "alice", Tenant: "sales"}, header: "support", status: http.StatusForbidden},
+		{name: "admins are bound to the tenant of their credentials", identity: &Identity{User: "root", Tenant: "sales", Roles: []string{RoleAdmin}}, header: "support", status: http.StatusForbidden},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var tenant string
+			handler := Tenant()(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				tenant = TenantFrom(r.Context())
+			}))
+
+			req := httptest.NewRequest(http.MethodPost, "/twirp/acai.chat.ChatService/ListConversations", nil)
+			if tt.header != "" {
+				req.Header.Set(TenantHeader, tt.header)
+			}
+			if tt.identity != nil {
+				req = req.WithContext(WithIdentity(req.Context(), *tt.identity))
+			}
+			if tt.disabled {
+				req = req.WithContext(WithAuthDisabled(req.Context()))
+			}
+
+			rec := httptest.NewRecorder()
+			handler.ServeHTTP(rec, req)
+
+			if rec.Code != tt.status || tenant != tt.tenant {
+				t.Errorf("expected status %d and tenant %q, got %d and %q", tt.status, tt.tenant, rec.Code, tenant)
+			}
+		})
+	}
+}
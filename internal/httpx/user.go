@@ -0,0 +1,52 @@
+package httpx
+
+import (
+	"context"
+	"slices"
+)
+
+// Identity is the authenticated caller of a request, see Auth.
+type Identity struct {
+	User string
+
+	// Tenant the caller belongs to, if their credentials tie them to one, see Tenant.
+	Tenant string
+
+	// Roles granted to the caller, e.g. RoleAdmin.
+	Roles []string
+
+	// Claims of the JWT the caller authenticated with, nil for API keys.
+	Claims map[string]any
+}
+
+// RoleAdmin lets callers manage the deployment, e.g. its tenants.
+const RoleAdmin = "admin"
+
+// HasRole reports whether the caller was granted the role.
+func (id Identity) HasRole(role string) bool {
+	return slices.Contains(id.Roles, role)
+}
+
+type identityKey struct{}
+
+// WithIdentity returns a copy of ctx carrying the identity of the caller.
+func WithIdentity(ctx context.Context, id Identity) context.Context {
+	return context.WithValue(ctx, identityKey{}, id)
+}
+
+// WithUser returns a copy of ctx carrying a caller known by its user ID only.
+func WithUser(ctx context.Context, user string) context.Context {
+	return WithIdentity(ctx, Identity{User: user})
+}
+
+// IdentityFrom returns the identity of the caller, if the request was authenticated.
+func IdentityFrom(ctx context.Context) (Identity, bool) {
+	id, ok := ctx.Value(identityKey{}).(Identity)
+	return id, ok
+}
+
+// UserFrom returns the user making the request, or an empty string for anonymous requests.
+func UserFrom(ctx context.Context) string {
+	id, _ := IdentityFrom(ctx)
+	return id.User
+}
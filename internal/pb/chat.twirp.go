@@ -32,18 +32,52 @@ const _ = twirp.TwirpPackageMinVersion_8_1_0
 // =====================
 
 type ChatService interface {
-	// create a new conversation by sending a message and getting a reply
+	// Create a new conversation by sending a message and getting a reply
 	// use ContinueConversation with the returned conversation_id to continue the conversation
 	StartConversation(context.Context, *StartConversationRequest) (*StartConversationResponse, error)
 
-	// continue an existing conversation by adding a new message and getting a reply
+	// Continue an existing conversation by adding a new message and getting a reply
 	ContinueConversation(context.Context, *ContinueConversationRequest) (*ContinueConversationResponse, error)
 
-	// list most recent conversations
+	// List most recently updated conversations, one page at a time
 	ListConversations(context.Context, *ListConversationsRequest) (*ListConversationsResponse, error)
 
-	// describe a conversation by its ID
+	// Describe a conversation by its ID
 	DescribeConversation(context.Context, *DescribeConversationRequest) (*DescribeConversationResponse, error)
+
+	// Delete a conversation by its ID
+	DeleteConversation(context.Context, *DeleteConversationRequest) (*DeleteConversationResponse, error)
+
+	// Change the title of a conversation
+	UpdateConversationTitle(context.Context, *UpdateConversationTitleRequest) (*UpdateConversationTitleResponse, error)
+
+	// Archive a conversation, archived conversations are left out of ListConversations by default
+	ArchiveConversation(context.Context, *ArchiveConversationRequest) (*ArchiveConversationResponse, error)
+
+	// Restore an archived conversation
+	UnarchiveConversation(context.Context, *UnarchiveConversationRequest) (*UnarchiveConversationResponse, error)
+
+	// Replace the last reply of a conversation with a new one, the replaced reply is kept in the message's versions
+	RegenerateReply(context.Context, *RegenerateReplyRequest) (*RegenerateReplyResponse, error)
+
+	// Rewrite a user message and reply to it again, the messages that followed it are dropped
+	EditMessage(context.Context, *EditMessageRequest) (*EditMessageResponse, error)
+
+	// Create a new conversation with the messages of an existing one up to and including the given message
+	ForkConversation(context.Context, *ForkConversationRequest) (*ForkConversationResponse, error)
+
+	// Change the settings used to reply to a conversation, they apply from the next reply on
+	UpdateConversationSettings(context.Context, *UpdateConversationSettingsRequest) (*UpdateConversationSettingsResponse, error)
+
+	// Approve a tool call the conversation is awaiting approval for, the reply resumes once every pending call is decided
+	ApproveToolCall(context.Context, *ApproveToolCallRequest) (*ApproveToolCallResponse, error)
+
+	// Reject a tool call the conversation is awaiting approval for, the model is told the call was rejected instead of
+	// running it. The reply resumes once every pending call is decided.
+	RejectToolCall(context.Context, *RejectToolCallRequest) (*RejectToolCallResponse, error)
+
+	// Report the tokens used and their cost by day and model, days are in UTC
+	GetUsageReport(context.Context, *GetUsageReportRequest) (*GetUsageReportResponse, error)
 }
 
 // ===========================
@@ -52,7 +86,7 @@ type ChatService interface {
 
 type chatServiceProtobufClient struct {
 	client      HTTPClient
-	urls        [4]string
+	urls        [15]string
 	interceptor twirp.Interceptor
 	opts        twirp.ClientOptions
 }
@@ -80,11 +114,22 @@ func NewChatServiceProtobufClient(baseURL string, client HTTPClient, opts ...twi
 	// Build method URLs: <baseURL>[<prefix>]/<package>.<Service>/<Method>
 	serviceURL := sanitizeBaseURL(baseURL)
 	serviceURL += baseServicePath(pathPrefix, "acai.chat", "ChatService")
-	urls := [4]string{
+	urls := [15]string{
 		serviceURL + "StartConversation",
 		serviceURL + "ContinueConversation",
 		serviceURL + "ListConversations",
 		serviceURL + "DescribeConversation",
+		serviceURL + "DeleteConversation",
+		serviceURL + "UpdateConversationTitle",
+		serviceURL + "ArchiveConversation",
+		serviceURL + "UnarchiveConversation",
+		serviceURL + "RegenerateReply",
+		serviceURL + "EditMessage",
+		serviceURL + "ForkConversation",
+		serviceURL + "UpdateConversationSettings",
+		serviceURL + "ApproveToolCall",
+		serviceURL + "RejectToolCall",
+		serviceURL + "GetUsageReport",
 	}
 
 	return &chatServiceProtobufClient{
@@ -279,75 +324,164 @@ func (c *chatServiceProtobufClient) callDescribeConversation(ctx context.Context
 	return out, nil
 }
 
-// =======================
-// ChatService JSON Client
-// =======================
-
-type chatServiceJSONClient struct {
-	client      HTTPClient
-	urls        [4]string
-	interceptor twirp.Interceptor
-	opts        twirp.ClientOptions
+func (c *chatServiceProtobufClient) DeleteConversation(ctx context.Context, in *DeleteConversationRequest) (*DeleteConversationResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "acai.chat")
+	ctx = ctxsetters.WithServiceName(ctx, "ChatService")
+	ctx = ctxsetters.WithMethodName(ctx, "DeleteConversation")
+	caller := c.callDeleteConversation
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *DeleteConversationRequest) (*DeleteConversationResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*DeleteConversationRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*DeleteConversationRequest) when calling interceptor")
+					}
+					return c.callDeleteConversation(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*DeleteConversationResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*DeleteConversationResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
 }
 
-// NewChatServiceJSONClient creates a JSON client that implements the ChatService interface.
-// It communicates using JSON and can be configured with a custom HTTPClient.
-func NewChatServiceJSONClient(baseURL string, client HTTPClient, opts ...twirp.ClientOption) ChatService {
-	if c, ok := client.(*http.Client); ok {
-		client = withoutRedirects(c)
+func (c *chatServiceProtobufClient) callDeleteConversation(ctx context.Context, in *DeleteConversationRequest) (*DeleteConversationResponse, error) {
+	out := new(DeleteConversationResponse)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[4], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
 	}
 
-	clientOpts := twirp.ClientOptions{}
-	for _, o := range opts {
-		o(&clientOpts)
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *chatServiceProtobufClient) UpdateConversationTitle(ctx context.Context, in *UpdateConversationTitleRequest) (*UpdateConversationTitleResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "acai.chat")
+	ctx = ctxsetters.WithServiceName(ctx, "ChatService")
+	ctx = ctxsetters.WithMethodName(ctx, "UpdateConversationTitle")
+	caller := c.callUpdateConversationTitle
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *UpdateConversationTitleRequest) (*UpdateConversationTitleResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*UpdateConversationTitleRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*UpdateConversationTitleRequest) when calling interceptor")
+					}
+					return c.callUpdateConversationTitle(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*UpdateConversationTitleResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*UpdateConversationTitleResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
 	}
+	return caller(ctx, in)
+}
 
-	// Using ReadOpt allows backwards and forwards compatibility with new options in the future
-	literalURLs := false
-	_ = clientOpts.ReadOpt("literalURLs", &literalURLs)
-	var pathPrefix string
-	if ok := clientOpts.ReadOpt("pathPrefix", &pathPrefix); !ok {
-		pathPrefix = "/twirp" // default prefix
+func (c *chatServiceProtobufClient) callUpdateConversationTitle(ctx context.Context, in *UpdateConversationTitleRequest) (*UpdateConversationTitleResponse, error) {
+	out := new(UpdateConversationTitleResponse)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[5], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
 	}
 
-	// Build method URLs: <baseURL>[<prefix>]/<package>.<Service>/<Method>
-	serviceURL := sanitizeBaseURL(baseURL)
-	serviceURL += baseServicePath(pathPrefix, "acai.chat", "ChatService")
-	urls := [4]string{
-		serviceURL + "StartConversation",
-		serviceURL + "ContinueConversation",
-		serviceURL + "ListConversations",
-		serviceURL + "DescribeConversation",
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *chatServiceProtobufClient) ArchiveConversation(ctx context.Context, in *ArchiveConversationRequest) (*ArchiveConversationResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "acai.chat")
+	ctx = ctxsetters.WithServiceName(ctx, "ChatService")
+	ctx = ctxsetters.WithMethodName(ctx, "ArchiveConversation")
+	caller := c.callArchiveConversation
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *ArchiveConversationRequest) (*ArchiveConversationResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ArchiveConversationRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ArchiveConversationRequest) when calling interceptor")
+					}
+					return c.callArchiveConversation(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ArchiveConversationResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ArchiveConversationResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
 	}
+	return caller(ctx, in)
+}
 
-	return &chatServiceJSONClient{
-		client:      client,
-		urls:        urls,
-		interceptor: twirp.ChainInterceptors(clientOpts.Interceptors...),
-		opts:        clientOpts,
+func (c *chatServiceProtobufClient) callArchiveConversation(ctx context.Context, in *ArchiveConversationRequest) (*ArchiveConversationResponse, error) {
+	out := new(ArchiveConversationResponse)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[6], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
 	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
 }
 
-func (c *chatServiceJSONClient) StartConversation(ctx context.Context, in *StartConversationRequest) (*StartConversationResponse, error) {
+func (c *chatServiceProtobufClient) UnarchiveConversation(ctx context.Context, in *UnarchiveConversationRequest) (*UnarchiveConversationResponse, error) {
 	ctx = ctxsetters.WithPackageName(ctx, "acai.chat")
 	ctx = ctxsetters.WithServiceName(ctx, "ChatService")
-	ctx = ctxsetters.WithMethodName(ctx, "StartConversation")
-	caller := c.callStartConversation
+	ctx = ctxsetters.WithMethodName(ctx, "UnarchiveConversation")
+	caller := c.callUnarchiveConversation
 	if c.interceptor != nil {
-		caller = func(ctx context.Context, req *StartConversationRequest) (*StartConversationResponse, error) {
+		caller = func(ctx context.Context, req *UnarchiveConversationRequest) (*UnarchiveConversationResponse, error) {
 			resp, err := c.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*StartConversationRequest)
+					typedReq, ok := req.(*UnarchiveConversationRequest)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*StartConversationRequest) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*UnarchiveConversationRequest) when calling interceptor")
 					}
-					return c.callStartConversation(ctx, typedReq)
+					return c.callUnarchiveConversation(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
-				typedResp, ok := resp.(*StartConversationResponse)
+				typedResp, ok := resp.(*UnarchiveConversationResponse)
 				if !ok {
-					return nil, twirp.InternalError("failed type assertion resp.(*StartConversationResponse) when calling interceptor")
+					return nil, twirp.InternalError("failed type assertion resp.(*UnarchiveConversationResponse) when calling interceptor")
 				}
 				return typedResp, err
 			}
@@ -357,9 +491,9 @@ func (c *chatServiceJSONClient) StartConversation(ctx context.Context, in *Start
 	return caller(ctx, in)
 }
 
-func (c *chatServiceJSONClient) callStartConversation(ctx context.Context, in *StartConversationRequest) (*StartConversationResponse, error) {
-	out := new(StartConversationResponse)
-	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[0], in, out)
+func (c *chatServiceProtobufClient) callUnarchiveConversation(ctx context.Context, in *UnarchiveConversationRequest) (*UnarchiveConversationResponse, error) {
+	out := new(UnarchiveConversationResponse)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[7], in, out)
 	if err != nil {
 		twerr, ok := err.(twirp.Error)
 		if !ok {
@@ -374,26 +508,26 @@ func (c *chatServiceJSONClient) callStartConversation(ctx context.Context, in *S
 	return out, nil
 }
 
-func (c *chatServiceJSONClient) ContinueConversation(ctx context.Context, in *ContinueConversationRequest) (*ContinueConversationResponse, error) {
+func (c *chatServiceProtobufClient) RegenerateReply(ctx context.Context, in *RegenerateReplyRequest) (*RegenerateReplyResponse, error) {
 	ctx = ctxsetters.WithPackageName(ctx, "acai.chat")
 	ctx = ctxsetters.WithServiceName(ctx, "ChatService")
-	ctx = ctxsetters.WithMethodName(ctx, "ContinueConversation")
-	caller := c.callContinueConversation
+	ctx = ctxsetters.WithMethodName(ctx, "RegenerateReply")
+	caller := c.callRegenerateReply
 	if c.interceptor != nil {
-		caller = func(ctx context.Context, req *ContinueConversationRequest) (*ContinueConversationResponse, error) {
+		caller = func(ctx context.Context, req *RegenerateReplyRequest) (*RegenerateReplyResponse, error) {
 			resp, err := c.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*ContinueConversationRequest)
+					typedReq, ok := req.(*RegenerateReplyRequest)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*ContinueConversationRequest) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*RegenerateReplyRequest) when calling interceptor")
 					}
-					return c.callContinueConversation(ctx, typedReq)
+					return c.callRegenerateReply(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
-				typedResp, ok := resp.(*ContinueConversationResponse)
+				typedResp, ok := resp.(*RegenerateReplyResponse)
 				if !ok {
-					return nil, twirp.InternalError("failed type assertion resp.(*ContinueConversationResponse) when calling interceptor")
+					return nil, twirp.InternalError("failed type assertion resp.(*RegenerateReplyResponse) when calling interceptor")
 				}
 				return typedResp, err
 			}
@@ -403,9 +537,9 @@ func (c *chatServiceJSONClient) ContinueConversation(ctx context.Context, in *Co
 	return caller(ctx, in)
 }
 
-func (c *chatServiceJSONClient) callContinueConversation(ctx context.Context, in *ContinueConversationRequest) (*ContinueConversationResponse, error) {
-	out := new(ContinueConversationResponse)
-	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[1], in, out)
+func (c *chatServiceProtobufClient) callRegenerateReply(ctx context.Context, in *RegenerateReplyRequest) (*RegenerateReplyResponse, error) {
+	out := new(RegenerateReplyResponse)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[8], in, out)
 	if err != nil {
 		twerr, ok := err.(twirp.Error)
 		if !ok {
@@ -420,26 +554,26 @@ func (c *chatServiceJSONClient) callContinueConversation(ctx context.Context, in
 	return out, nil
 }
 
-func (c *chatServiceJSONClient) ListConversations(ctx context.Context, in *ListConversationsRequest) (*ListConversationsResponse, error) {
+func (c *chatServiceProtobufClient) EditMessage(ctx context.Context, in *EditMessageRequest) (*EditMessageResponse, error) {
 	ctx = ctxsetters.WithPackageName(ctx, "acai.chat")
 	ctx = ctxsetters.WithServiceName(ctx, "ChatService")
-	ctx = ctxsetters.WithMethodName(ctx, "ListConversations")
-	caller := c.callListConversations
+	ctx = ctxsetters.WithMethodName(ctx, "EditMessage")
+	caller := c.callEditMessage
 	if c.interceptor != nil {
-		caller = func(ctx context.Context, req *ListConversationsRequest) (*ListConversationsResponse, error) {
+		caller = func(ctx context.Context, req *EditMessageRequest) (*EditMessageResponse, error) {
 			resp, err := c.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*ListConversationsRequest)
+					typedReq, ok := req.(*EditMessageRequest)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*ListConversationsRequest) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*EditMessageRequest) when calling interceptor")
 					}
-					return c.callListConversations(ctx, typedReq)
+					return c.callEditMessage(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
-				typedResp, ok := resp.(*ListConversationsResponse)
+				typedResp, ok := resp.(*EditMessageResponse)
 				if !ok {
-					return nil, twirp.InternalError("failed type assertion resp.(*ListConversationsResponse) when calling interceptor")
+					return nil, twirp.InternalError("failed type assertion resp.(*EditMessageResponse) when calling interceptor")
 				}
 				return typedResp, err
 			}
@@ -449,9 +583,9 @@ func (c *chatServiceJSONClient) ListConversations(ctx context.Context, in *ListC
 	return caller(ctx, in)
 }
 
-func (c *chatServiceJSONClient) callListConversations(ctx context.Context, in *ListConversationsRequest) (*ListConversationsResponse, error) {
-	out := new(ListConversationsResponse)
-	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[2], in, out)
+func (c *chatServiceProtobufClient) callEditMessage(ctx context.Context, in *EditMessageRequest) (*EditMessageResponse, error) {
+	out := new(EditMessageResponse)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[9], in, out)
 	if err != nil {
 		twerr, ok := err.(twirp.Error)
 		if !ok {
@@ -466,26 +600,26 @@ func (c *chatServiceJSONClient) callListConversations(ctx context.Context, in *L
 	return out, nil
 }
 
-func (c *chatServiceJSONClient) DescribeConversation(ctx context.Context, in *DescribeConversationRequest) (*DescribeConversationResponse, error) {
+func (c *chatServiceProtobufClient) ForkConversation(ctx context.Context, in *ForkConversationRequest) (*ForkConversationResponse, error) {
 	ctx = ctxsetters.WithPackageName(ctx, "acai.chat")
 	ctx = ctxsetters.WithServiceName(ctx, "ChatService")
-	ctx = ctxsetters.WithMethodName(ctx, "DescribeConversation")
-	caller := c.callDescribeConversation
+	ctx = ctxsetters.WithMethodName(ctx, "ForkConversation")
+	caller := c.callForkConversation
 	if c.interceptor != nil {
-		caller = func(ctx context.Context, req *DescribeConversationRequest) (*DescribeConversationResponse, error) {
+		caller = func(ctx context.Context, req *ForkConversationRequest) (*ForkConversationResponse, error) {
 			resp, err := c.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*DescribeConversationRequest)
+					typedReq, ok := req.(*ForkConversationRequest)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*DescribeConversationRequest) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*ForkConversationRequest) when calling interceptor")
 					}
-					return c.callDescribeConversation(ctx, typedReq)
+					return c.callForkConversation(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
-				typedResp, ok := resp.(*DescribeConversationResponse)
+				typedResp, ok := resp.(*ForkConversationResponse)
 				if !ok {
-					return nil, twirp.InternalError("failed type assertion resp.(*DescribeConversationResponse) when calling interceptor")
+					return nil, twirp.InternalError("failed type assertion resp.(*ForkConversationResponse) when calling interceptor")
 				}
 				return typedResp, err
 			}
@@ -495,9 +629,9 @@ func (c *chatServiceJSONClient) DescribeConversation(ctx context.Context, in *De
 	return caller(ctx, in)
 }
 
-func (c *chatServiceJSONClient) callDescribeConversation(ctx context.Context, in *DescribeConversationRequest) (*DescribeConversationResponse, error) {
-	out := new(DescribeConversationResponse)
-	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[3], in, out)
+func (c *chatServiceProtobufClient) callForkConversation(ctx context.Context, in *ForkConversationRequest) (*ForkConversationResponse, error) {
+	out := new(ForkConversationResponse)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[10], in, out)
 	if err != nil {
 		twerr, ok := err.(twirp.Error)
 		if !ok {
@@ -512,36 +646,970 @@ func (c *chatServiceJSONClient) callDescribeConversation(ctx context.Context, in
 	return out, nil
 }
 
-// ==========================
-// ChatService Server Handler
-// ==========================
-
-type chatServiceServer struct {
-	ChatService
-	interceptor      twirp.Interceptor
-	hooks            *twirp.ServerHooks
-	pathPrefix       string // prefix for routing
-	jsonSkipDefaults bool   // do not include unpopulated fields (default values) in the response
-	jsonCamelCase    bool   // JSON fields are serialized as lowerCamelCase rather than keeping the original proto names
+func (c *chatServiceProtobufClient) UpdateConversationSettings(ctx context.Context, in *UpdateConversationSettingsRequest) (*UpdateConversationSettingsResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "acai.chat")
+	ctx = ctxsetters.WithServiceName(ctx, "ChatService")
+	ctx = ctxsetters.WithMethodName(ctx, "UpdateConversationSettings")
+	caller := c.callUpdateConversationSettings
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *UpdateConversationSettingsRequest) (*UpdateConversationSettingsResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*UpdateConversationSettingsRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*UpdateConversationSettingsRequest) when calling interceptor")
+					}
+					return c.callUpdateConversationSettings(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*UpdateConversationSettingsResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*UpdateConversationSettingsResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
 }
 
-// NewChatServiceServer builds a TwirpServer that can be used as an http.Handler to handle
-// HTTP requests that are routed to the right method in the provided svc implementation.
-// The opts are twirp.ServerOption modifiers, for example twirp.WithServerHooks(hooks).
-func NewChatServiceServer(svc ChatService, opts ...interface{}) TwirpServer {
-	serverOpts := newServerOpts(opts)
+func (c *chatServiceProtobufClient) callUpdateConversationSettings(ctx context.Context, in *UpdateConversationSettingsRequest) (*UpdateConversationSettingsResponse, error) {
+	out := new(UpdateConversationSettingsResponse)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[11], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
 
-	// Using ReadOpt allows backwards and forwards compatibility with new options in the future
-	jsonSkipDefaults := false
-	_ = serverOpts.ReadOpt("jsonSkipDefaults", &jsonSkipDefaults)
-	jsonCamelCase := false
-	_ = serverOpts.ReadOpt("jsonCamelCase", &jsonCamelCase)
-	var pathPrefix string
-	if ok := serverOpts.ReadOpt("pathPrefix", &pathPrefix); !ok {
-		pathPrefix = "/twirp" // default prefix
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *chatServiceProtobufClient) ApproveToolCall(ctx context.Context, in *ApproveToolCallRequest) (*ApproveToolCallResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "acai.chat")
+	ctx = ctxsetters.WithServiceName(ctx, "ChatService")
+	ctx = ctxsetters.WithMethodName(ctx, "ApproveToolCall")
+	caller := c.callApproveToolCall
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *ApproveToolCallRequest) (*ApproveToolCallResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ApproveToolCallRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ApproveToolCallRequest) when calling interceptor")
+					}
+					return c.callApproveToolCall(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ApproveToolCallResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ApproveToolCallResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
 	}
+	return caller(ctx, in)
+}
 
-	return &chatServiceServer{
+func (c *chatServiceProtobufClient) callApproveToolCall(ctx context.Context, in *ApproveToolCallRequest) (*ApproveToolCallResponse, error) {
+	out := new(ApproveToolCallResponse)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[12], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *chatServiceProtobufClient) RejectToolCall(ctx context.Context, in *RejectToolCallRequest) (*RejectToolCallResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "acai.chat")
+	ctx = ctxsetters.WithServiceName(ctx, "ChatService")
+	ctx = ctxsetters.WithMethodName(ctx, "RejectToolCall")
+	caller := c.callRejectToolCall
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *RejectToolCallRequest) (*RejectToolCallResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*RejectToolCallRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*RejectToolCallRequest) when calling interceptor")
+					}
+					return c.callRejectToolCall(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*RejectToolCallResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*RejectToolCallResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *chatServiceProtobufClient) callRejectToolCall(ctx context.Context, in *RejectToolCallRequest) (*RejectToolCallResponse, error) {
+	out := new(RejectToolCallResponse)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[13], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *chatServiceProtobufClient) GetUsageReport(ctx context.Context, in *GetUsageReportRequest) (*GetUsageReportResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "acai.chat")
+	ctx = ctxsetters.WithServiceName(ctx, "ChatService")
+	ctx = ctxsetters.WithMethodName(ctx, "GetUsageReport")
+	caller := c.callGetUsageReport
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *GetUsageReportRequest) (*GetUsageReportResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*GetUsageReportRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*GetUsageReportRequest) when calling interceptor")
+					}
+					return c.callGetUsageReport(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*GetUsageReportResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*GetUsageReportResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *chatServiceProtobufClient) callGetUsageReport(ctx context.Context, in *GetUsageReportRequest) (*GetUsageReportResponse, error) {
+	out := new(GetUsageReportResponse)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[14], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+// =======================
+// ChatService JSON Client
+// =======================
+
+type chatServiceJSONClient struct {
+	client      HTTPClient
+	urls        [15]string
+	interceptor twirp.Interceptor
+	opts        twirp.ClientOptions
+}
+
+// NewChatServiceJSONClient creates a JSON client that implements the ChatService interface.
+// It communicates using JSON and can be configured with a custom HTTPClient.
+func NewChatServiceJSONClient(baseURL string, client HTTPClient, opts ...twirp.ClientOption) ChatService {
+	if c, ok := client.(*http.Client); ok {
+		client = withoutRedirects(c)
+	}
+
+	clientOpts := twirp.ClientOptions{}
+	for _, o := range opts {
+		o(&clientOpts)
+	}
+
+	// Using ReadOpt allows backwards and forwards compatibility with new options in the future
+	literalURLs := false
+	_ = clientOpts.ReadOpt("literalURLs", &literalURLs)
+	var pathPrefix string
+	if ok := clientOpts.ReadOpt("pathPrefix", &pathPrefix); !ok {
+		pathPrefix = "/twirp" // default prefix
+	}
+
+	// Build method URLs: <baseURL>[<prefix>]/<package>.<Service>/<Method>
+	serviceURL := sanitizeBaseURL(baseURL)
+	serviceURL += baseServicePath(pathPrefix, "acai.chat", "ChatService")
+	urls := [15]string{
+		serviceURL + "StartConversation",
+		serviceURL + "ContinueConversation",
+		serviceURL + "ListConversations",
+		serviceURL + "DescribeConversation",
+		serviceURL + "DeleteConversation",
+		serviceURL + "UpdateConversationTitle",
+		serviceURL + "ArchiveConversation",
+		serviceURL + "UnarchiveConversation",
+		serviceURL + "RegenerateReply",
+		serviceURL + "EditMessage",
+		serviceURL + "ForkConversation",
+		serviceURL + "UpdateConversationSettings",
+		serviceURL + "ApproveToolCall",
+		serviceURL + "RejectToolCall",
+		serviceURL + "GetUsageReport",
+	}
+
+	return &chatServiceJSONClient{
+		client:      client,
+		urls:        urls,
+		interceptor: twirp.ChainInterceptors(clientOpts.Interceptors...),
+		opts:        clientOpts,
+	}
+}
+
+func (c *chatServiceJSONClient) StartConversation(ctx context.Context, in *StartConversationRequest) (*StartConversationResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "acai.chat")
+	ctx = ctxsetters.WithServiceName(ctx, "ChatService")
+	ctx = ctxsetters.WithMethodName(ctx, "StartConversation")
+	caller := c.callStartConversation
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *StartConversationRequest) (*StartConversationResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*StartConversationRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*StartConversationRequest) when calling interceptor")
+					}
+					return c.callStartConversation(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*StartConversationResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*StartConversationResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *chatServiceJSONClient) callStartConversation(ctx context.Context, in *StartConversationRequest) (*StartConversationResponse, error) {
+	out := new(StartConversationResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[0], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *chatServiceJSONClient) ContinueConversation(ctx context.Context, in *ContinueConversationRequest) (*ContinueConversationResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "acai.chat")
+	ctx = ctxsetters.WithServiceName(ctx, "ChatService")
+	ctx = ctxsetters.WithMethodName(ctx, "ContinueConversation")
+	caller := c.callContinueConversation
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *ContinueConversationRequest) (*ContinueConversationResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ContinueConversationRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ContinueConversationRequest) when calling interceptor")
+					}
+					return c.callContinueConversation(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ContinueConversationResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ContinueConversationResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *chatServiceJSONClient) callContinueConversation(ctx context.Context, in *ContinueConversationRequest) (*ContinueConversationResponse, error) {
+	out := new(ContinueConversationResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[1], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *chatServiceJSONClient) ListConversations(ctx context.Context, in *ListConversationsRequest) (*ListConversationsResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "acai.chat")
+	ctx = ctxsetters.WithServiceName(ctx, "ChatService")
+	ctx = ctxsetters.WithMethodName(ctx, "ListConversations")
+	caller := c.callListConversations
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *ListConversationsRequest) (*ListConversationsResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ListConversationsRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ListConversationsRequest) when calling interceptor")
+					}
+					return c.callListConversations(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ListConversationsResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ListConversationsResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *chatServiceJSONClient) callListConversations(ctx context.Context, in *ListConversationsRequest) (*ListConversationsResponse, error) {
+	out := new(ListConversationsResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[2], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *chatServiceJSONClient) DescribeConversation(ctx context.Context, in *DescribeConversationRequest) (*DescribeConversationResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "acai.chat")
+	ctx = ctxsetters.WithServiceName(ctx, "ChatService")
+	ctx = ctxsetters.WithMethodName(ctx, "DescribeConversation")
+	caller := c.callDescribeConversation
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *DescribeConversationRequest) (*DescribeConversationResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*DescribeConversationRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*DescribeConversationRequest) when calling interceptor")
+					}
+					return c.callDescribeConversation(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*DescribeConversationResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*DescribeConversationResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *chatServiceJSONClient) callDescribeConversation(ctx context.Context, in *DescribeConversationRequest) (*DescribeConversationResponse, error) {
+	out := new(DescribeConversationResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[3], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *chatServiceJSONClient) DeleteConversation(ctx context.Context, in *DeleteConversationRequest) (*DeleteConversationResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "acai.chat")
+	ctx = ctxsetters.WithServiceName(ctx, "ChatService")
+	ctx = ctxsetters.WithMethodName(ctx, "DeleteConversation")
+	caller := c.callDeleteConversation
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *DeleteConversationRequest) (*DeleteConversationResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*DeleteConversationRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*DeleteConversationRequest) when calling interceptor")
+					}
+					return c.callDeleteConversation(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*DeleteConversationResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*DeleteConversationResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *chatServiceJSONClient) callDeleteConversation(ctx context.Context, in *DeleteConversationRequest) (*DeleteConversationResponse, error) {
+	out := new(DeleteConversationResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[4], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *chatServiceJSONClient) UpdateConversationTitle(ctx context.Context, in *UpdateConversationTitleRequest) (*UpdateConversationTitleResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "acai.chat")
+	ctx = ctxsetters.WithServiceName(ctx, "ChatService")
+	ctx = ctxsetters.WithMethodName(ctx, "UpdateConversationTitle")
+	caller := c.callUpdateConversationTitle
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *UpdateConversationTitleRequest) (*UpdateConversationTitleResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*UpdateConversationTitleRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*UpdateConversationTitleRequest) when calling interceptor")
+					}
+					return c.callUpdateConversationTitle(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*UpdateConversationTitleResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*UpdateConversationTitleResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *chatServiceJSONClient) callUpdateConversationTitle(ctx context.Context, in *UpdateConversationTitleRequest) (*UpdateConversationTitleResponse, error) {
+	out := new(UpdateConversationTitleResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[5], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *chatServiceJSONClient) ArchiveConversation(ctx context.Context, in *ArchiveConversationRequest) (*ArchiveConversationResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "acai.chat")
+	ctx = ctxsetters.WithServiceName(ctx, "ChatService")
+	ctx = ctxsetters.WithMethodName(ctx, "ArchiveConversation")
+	caller := c.callArchiveConversation
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *ArchiveConversationRequest) (*ArchiveConversationResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ArchiveConversationRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ArchiveConversationRequest) when calling interceptor")
+					}
+					return c.callArchiveConversation(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ArchiveConversationResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ArchiveConversationResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *chatServiceJSONClient) callArchiveConversation(ctx context.Context, in *ArchiveConversationRequest) (*ArchiveConversationResponse, error) {
+	out := new(ArchiveConversationResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[6], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *chatServiceJSONClient) UnarchiveConversation(ctx context.Context, in *UnarchiveConversationRequest) (*UnarchiveConversationResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "acai.chat")
+	ctx = ctxsetters.WithServiceName(ctx, "ChatService")
+	ctx = ctxsetters.WithMethodName(ctx, "UnarchiveConversation")
+	caller := c.callUnarchiveConversation
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *UnarchiveConversationRequest) (*UnarchiveConversationResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*UnarchiveConversationRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*UnarchiveConversationRequest) when calling interceptor")
+					}
+					return c.callUnarchiveConversation(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*UnarchiveConversationResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*UnarchiveConversationResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *chatServiceJSONClient) callUnarchiveConversation(ctx context.Context, in *UnarchiveConversationRequest) (*UnarchiveConversationResponse, error) {
+	out := new(UnarchiveConversationResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[7], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *chatServiceJSONClient) RegenerateReply(ctx context.Context, in *RegenerateReplyRequest) (*RegenerateReplyResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "acai.chat")
+	ctx = ctxsetters.WithServiceName(ctx, "ChatService")
+	ctx = ctxsetters.WithMethodName(ctx, "RegenerateReply")
+	caller := c.callRegenerateReply
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *RegenerateReplyRequest) (*RegenerateReplyResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*RegenerateReplyRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*RegenerateReplyRequest) when calling interceptor")
+					}
+					return c.callRegenerateReply(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*RegenerateReplyResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*RegenerateReplyResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *chatServiceJSONClient) callRegenerateReply(ctx context.Context, in *RegenerateReplyRequest) (*RegenerateReplyResponse, error) {
+	out := new(RegenerateReplyResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[8], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *chatServiceJSONClient) EditMessage(ctx context.Context, in *EditMessageRequest) (*EditMessageResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "acai.chat")
+	ctx = ctxsetters.WithServiceName(ctx, "ChatService")
+	ctx = ctxsetters.WithMethodName(ctx, "EditMessage")
+	caller := c.callEditMessage
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *EditMessageRequest) (*EditMessageResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*EditMessageRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*EditMessageRequest) when calling interceptor")
+					}
+					return c.callEditMessage(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*EditMessageResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*EditMessageResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *chatServiceJSONClient) callEditMessage(ctx context.Context, in *EditMessageRequest) (*EditMessageResponse, error) {
+	out := new(EditMessageResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[9], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *chatServiceJSONClient) ForkConversation(ctx context.Context, in *ForkConversationRequest) (*ForkConversationResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "acai.chat")
+	ctx = ctxsetters.WithServiceName(ctx, "ChatService")
+	ctx = ctxsetters.WithMethodName(ctx, "ForkConversation")
+	caller := c.callForkConversation
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *ForkConversationRequest) (*ForkConversationResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ForkConversationRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ForkConversationRequest) when calling interceptor")
+					}
+					return c.callForkConversation(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ForkConversationResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ForkConversationResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *chatServiceJSONClient) callForkConversation(ctx context.Context, in *ForkConversationRequest) (*ForkConversationResponse, error) {
+	out := new(ForkConversationResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[10], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *chatServiceJSONClient) UpdateConversationSettings(ctx context.Context, in *UpdateConversationSettingsRequest) (*UpdateConversationSettingsResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "acai.chat")
+	ctx = ctxsetters.WithServiceName(ctx, "ChatService")
+	ctx = ctxsetters.WithMethodName(ctx, "UpdateConversationSettings")
+	caller := c.callUpdateConversationSettings
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *UpdateConversationSettingsRequest) (*UpdateConversationSettingsResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*UpdateConversationSettingsRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*UpdateConversationSettingsRequest) when calling interceptor")
+					}
+					return c.callUpdateConversationSettings(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*UpdateConversationSettingsResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*UpdateConversationSettingsResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *chatServiceJSONClient) callUpdateConversationSettings(ctx context.Context, in *UpdateConversationSettingsRequest) (*UpdateConversationSettingsResponse, error) {
+	out := new(UpdateConversationSettingsResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[11], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *chatServiceJSONClient) ApproveToolCall(ctx context.Context, in *ApproveToolCallRequest) (*ApproveToolCallResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "acai.chat")
+	ctx = ctxsetters.WithServiceName(ctx, "ChatService")
+	ctx = ctxsetters.WithMethodName(ctx, "ApproveToolCall")
+	caller := c.callApproveToolCall
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *ApproveToolCallRequest) (*ApproveToolCallResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ApproveToolCallRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ApproveToolCallRequest) when calling interceptor")
+					}
+					return c.callApproveToolCall(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ApproveToolCallResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ApproveToolCallResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *chatServiceJSONClient) callApproveToolCall(ctx context.Context, in *ApproveToolCallRequest) (*ApproveToolCallResponse, error) {
+	out := new(ApproveToolCallResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[12], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *chatServiceJSONClient) RejectToolCall(ctx context.Context, in *RejectToolCallRequest) (*RejectToolCallResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "acai.chat")
+	ctx = ctxsetters.WithServiceName(ctx, "ChatService")
+	ctx = ctxsetters.WithMethodName(ctx, "RejectToolCall")
+	caller := c.callRejectToolCall
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *RejectToolCallRequest) (*RejectToolCallResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*RejectToolCallRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*RejectToolCallRequest) when calling interceptor")
+					}
+					return c.callRejectToolCall(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*RejectToolCallResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*RejectToolCallResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *chatServiceJSONClient) callRejectToolCall(ctx context.Context, in *RejectToolCallRequest) (*RejectToolCallResponse, error) {
+	out := new(RejectToolCallResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[13], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *chatServiceJSONClient) GetUsageReport(ctx context.Context, in *GetUsageReportRequest) (*GetUsageReportResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "acai.chat")
+	ctx = ctxsetters.WithServiceName(ctx, "ChatService")
+	ctx = ctxsetters.WithMethodName(ctx, "GetUsageReport")
+	caller := c.callGetUsageReport
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *GetUsageReportRequest) (*GetUsageReportResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*GetUsageReportRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*GetUsageReportRequest) when calling interceptor")
+					}
+					return c.callGetUsageReport(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*GetUsageReportResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*GetUsageReportResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *chatServiceJSONClient) callGetUsageReport(ctx context.Context, in *GetUsageReportRequest) (*GetUsageReportResponse, error) {
+	out := new(GetUsageReportResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[14], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+// ==========================
+// ChatService Server Handler
+// ==========================
+
+type chatServiceServer struct {
+	ChatService
+	interceptor      twirp.Interceptor
+	hooks            *twirp.ServerHooks
+	pathPrefix       string // prefix for routing
+	jsonSkipDefaults bool   // do not include unpopulated fields (default values) in the response
+	jsonCamelCase    bool   // JSON fields are serialized as lowerCamelCase rather than keeping the original proto names
+}
+
+// NewChatServiceServer builds a TwirpServer that can be used as an http.Handler to handle
+// HTTP requests that are routed to the right method in the provided svc implementation.
+// The opts are twirp.ServerOption modifiers, for example twirp.WithServerHooks(hooks).
+func NewChatServiceServer(svc ChatService, opts ...interface{}) TwirpServer {
+	serverOpts := newServerOpts(opts)
+
+	// Using ReadOpt allows backwards and forwards compatibility with new options in the future
+	jsonSkipDefaults := false
+	_ = serverOpts.ReadOpt("jsonSkipDefaults", &jsonSkipDefaults)
+	jsonCamelCase := false
+	_ = serverOpts.ReadOpt("jsonCamelCase", &jsonCamelCase)
+	var pathPrefix string
+	if ok := serverOpts.ReadOpt("pathPrefix", &pathPrefix); !ok {
+		pathPrefix = "/twirp" // default prefix
+	}
+
+	return &chatServiceServer{
 		ChatService:      svc,
 		hooks:            serverOpts.Hooks,
 		interceptor:      twirp.ChainInterceptors(serverOpts.Interceptors...),
@@ -549,86 +1617,5330 @@ func NewChatServiceServer(svc ChatService, opts ...interface{}) TwirpServer {
 		jsonSkipDefaults: jsonSkipDefaults,
 		jsonCamelCase:    jsonCamelCase,
 	}
-}
+}
+
+// writeError writes an HTTP response with a valid Twirp error format, and triggers hooks.
+// If err is not a twirp.Error, it will get wrapped with twirp.InternalErrorWith(err)
+func (s *chatServiceServer) writeError(ctx context.Context, resp http.ResponseWriter, err error) {
+	writeError(ctx, resp, err, s.hooks)
+}
+
+// handleRequestBodyError is used to handle error when the twirp server cannot read request
+func (s *chatServiceServer) handleRequestBodyError(ctx context.Context, resp http.ResponseWriter, msg string, err error) {
+	if context.Canceled == ctx.Err() {
+		s.writeError(ctx, resp, twirp.NewError(twirp.Canceled, "failed to read request: context canceled"))
+		return
+	}
+	if context.DeadlineExceeded == ctx.Err() {
+		s.writeError(ctx, resp, twirp.NewError(twirp.DeadlineExceeded, "failed to read request: deadline exceeded"))
+		return
+	}
+	s.writeError(ctx, resp, twirp.WrapError(malformedRequestError(msg), err))
+}
+
+// ChatServicePathPrefix is a convenience constant that may identify URL paths.
+// Should be used with caution, it only matches routes generated by Twirp Go clients,
+// with the default "/twirp" prefix and default CamelCase service and method names.
+// More info: https://twitchtv.github.io/twirp/docs/routing.html
+const ChatServicePathPrefix = "/twirp/acai.chat.ChatService/"
+
+func (s *chatServiceServer) ServeHTTP(resp http.ResponseWriter, req *http.Request) {
+	ctx := req.Context()
+	ctx = ctxsetters.WithPackageName(ctx, "acai.chat")
+	ctx = ctxsetters.WithServiceName(ctx, "ChatService")
+	ctx = ctxsetters.WithResponseWriter(ctx, resp)
+
+	var err error
+	ctx, err = callRequestReceived(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	if req.Method != "POST" {
+		msg := fmt.Sprintf("unsupported method %q (only POST is allowed)", req.Method)
+		s.writeError(ctx, resp, badRouteError(msg, req.Method, req.URL.Path))
+		return
+	}
+
+	// Verify path format: [<prefix>]/<package>.<Service>/<Method>
+	prefix, pkgService, method := parseTwirpPath(req.URL.Path)
+	if pkgService != "acai.chat.ChatService" {
+		msg := fmt.Sprintf("no handler for path %q", req.URL.Path)
+		s.writeError(ctx, resp, badRouteError(msg, req.Method, req.URL.Path))
+		return
+	}
+	if prefix != s.pathPrefix {
+		msg := fmt.Sprintf("invalid path prefix %q, expected %q, on path %q", prefix, s.pathPrefix, req.URL.Path)
+		s.writeError(ctx, resp, badRouteError(msg, req.Method, req.URL.Path))
+		return
+	}
+
+	switch method {
+	case "StartConversation":
+		s.serveStartConversation(ctx, resp, req)
+		return
+	case "ContinueConversation":
+		s.serveContinueConversation(ctx, resp, req)
+		return
+	case "ListConversations":
+		s.serveListConversations(ctx, resp, req)
+		return
+	case "DescribeConversation":
+		s.serveDescribeConversation(ctx, resp, req)
+		return
+	case "DeleteConversation":
+		s.serveDeleteConversation(ctx, resp, req)
+		return
+	case "UpdateConversationTitle":
+		s.serveUpdateConversationTitle(ctx, resp, req)
+		return
+	case "ArchiveConversation":
+		s.serveArchiveConversation(ctx, resp, req)
+		return
+	case "UnarchiveConversation":
+		s.serveUnarchiveConversation(ctx, resp, req)
+		return
+	case "RegenerateReply":
+		s.serveRegenerateReply(ctx, resp, req)
+		return
+	case "EditMessage":
+		s.serveEditMessage(ctx, resp, req)
+		return
+	case "ForkConversation":
+		s.serveForkConversation(ctx, resp, req)
+		return
+	case "UpdateConversationSettings":
+		s.serveUpdateConversationSettings(ctx, resp, req)
+		return
+	case "ApproveToolCall":
+		s.serveApproveToolCall(ctx, resp, req)
+		return
+	case "RejectToolCall":
+		s.serveRejectToolCall(ctx, resp, req)
+		return
+	case "GetUsageReport":
+		s.serveGetUsageReport(ctx, resp, req)
+		return
+	default:
+		msg := fmt.Sprintf("no handler for path %q", req.URL.Path)
+		s.writeError(ctx, resp, badRouteError(msg, req.Method, req.URL.Path))
+		return
+	}
+}
+
+func (s *chatServiceServer) serveStartConversation(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveStartConversationJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveStartConversationProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *chatServiceServer) serveStartConversationJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "StartConversation")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(StartConversationRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.ChatService.StartConversation
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *StartConversationRequest) (*StartConversationResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*StartConversationRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*StartConversationRequest) when calling interceptor")
+					}
+					return s.ChatService.StartConversation(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*StartConversationResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*StartConversationResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *StartConversationResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *StartConversationResponse and nil error while calling StartConversation. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *chatServiceServer) serveStartConversationProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "StartConversation")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := io.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(StartConversationRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.ChatService.StartConversation
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *StartConversationRequest) (*StartConversationResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*StartConversationRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*StartConversationRequest) when calling interceptor")
+					}
+					return s.ChatService.StartConversation(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*StartConversationResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*StartConversationResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *StartConversationResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *StartConversationResponse and nil error while calling StartConversation. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *chatServiceServer) serveContinueConversation(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveContinueConversationJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveContinueConversationProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *chatServiceServer) serveContinueConversationJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "ContinueConversation")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(ContinueConversationRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.ChatService.ContinueConversation
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *ContinueConversationRequest) (*ContinueConversationResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ContinueConversationRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ContinueConversationRequest) when calling interceptor")
+					}
+					return s.ChatService.ContinueConversation(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ContinueConversationResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ContinueConversationResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *ContinueConversationResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *ContinueConversationResponse and nil error while calling ContinueConversation. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *chatServiceServer) serveContinueConversationProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "ContinueConversation")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := io.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(ContinueConversationRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.ChatService.ContinueConversation
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *ContinueConversationRequest) (*ContinueConversationResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ContinueConversationRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ContinueConversationRequest) when calling interceptor")
+					}
+					return s.ChatService.ContinueConversation(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ContinueConversationResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ContinueConversationResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *ContinueConversationResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *ContinueConversationResponse and nil error while calling ContinueConversation. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *chatServiceServer) serveListConversations(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveListConversationsJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveListConversationsProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *chatServiceServer) serveListConversationsJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "ListConversations")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(ListConversationsRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.ChatService.ListConversations
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *ListConversationsRequest) (*ListConversationsResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ListConversationsRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ListConversationsRequest) when calling interceptor")
+					}
+					return s.ChatService.ListConversations(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ListConversationsResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ListConversationsResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *ListConversationsResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *ListConversationsResponse and nil error while calling ListConversations. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *chatServiceServer) serveListConversationsProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "ListConversations")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := io.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(ListConversationsRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.ChatService.ListConversations
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *ListConversationsRequest) (*ListConversationsResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ListConversationsRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ListConversationsRequest) when calling interceptor")
+					}
+					return s.ChatService.ListConversations(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ListConversationsResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ListConversationsResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *ListConversationsResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *ListConversationsResponse and nil error while calling ListConversations. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *chatServiceServer) serveDescribeConversation(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveDescribeConversationJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveDescribeConversationProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *chatServiceServer) serveDescribeConversationJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "DescribeConversation")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(DescribeConversationRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.ChatService.DescribeConversation
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *DescribeConversationRequest) (*DescribeConversationResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*DescribeConversationRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*DescribeConversationRequest) when calling interceptor")
+					}
+					return s.ChatService.DescribeConversation(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*DescribeConversationResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*DescribeConversationResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *DescribeConversationResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *DescribeConversationResponse and nil error while calling DescribeConversation. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *chatServiceServer) serveDescribeConversationProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "DescribeConversation")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := io.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(DescribeConversationRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.ChatService.DescribeConversation
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *DescribeConversationRequest) (*DescribeConversationResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*DescribeConversationRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*DescribeConversationRequest) when calling interceptor")
+					}
+					return s.ChatService.DescribeConversation(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*DescribeConversationResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*DescribeConversationResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *DescribeConversationResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *DescribeConversationResponse and nil error while calling DescribeConversation. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *chatServiceServer) serveDeleteConversation(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveDeleteConversationJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveDeleteConversationProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *chatServiceServer) serveDeleteConversationJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "DeleteConversation")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(DeleteConversationRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.ChatService.DeleteConversation
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *DeleteConversationRequest) (*DeleteConversationResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*DeleteConversationRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*DeleteConversationRequest) when calling interceptor")
+					}
+					return s.ChatService.DeleteConversation(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*DeleteConversationResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*DeleteConversationResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *DeleteConversationResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *DeleteConversationResponse and nil error while calling DeleteConversation. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *chatServiceServer) serveDeleteConversationProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "DeleteConversation")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := io.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(DeleteConversationRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.ChatService.DeleteConversation
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *DeleteConversationRequest) (*DeleteConversationResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*DeleteConversationRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*DeleteConversationRequest) when calling interceptor")
+					}
+					return s.ChatService.DeleteConversation(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*DeleteConversationResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*DeleteConversationResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *DeleteConversationResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *DeleteConversationResponse and nil error while calling DeleteConversation. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *chatServiceServer) serveUpdateConversationTitle(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveUpdateConversationTitleJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveUpdateConversationTitleProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *chatServiceServer) serveUpdateConversationTitleJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "UpdateConversationTitle")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(UpdateConversationTitleRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.ChatService.UpdateConversationTitle
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *UpdateConversationTitleRequest) (*UpdateConversationTitleResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*UpdateConversationTitleRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*UpdateConversationTitleRequest) when calling interceptor")
+					}
+					return s.ChatService.UpdateConversationTitle(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*UpdateConversationTitleResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*UpdateConversationTitleResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *UpdateConversationTitleResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *UpdateConversationTitleResponse and nil error while calling UpdateConversationTitle. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *chatServiceServer) serveUpdateConversationTitleProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "UpdateConversationTitle")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := io.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(UpdateConversationTitleRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.ChatService.UpdateConversationTitle
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *UpdateConversationTitleRequest) (*UpdateConversationTitleResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*UpdateConversationTitleRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*UpdateConversationTitleRequest) when calling interceptor")
+					}
+					return s.ChatService.UpdateConversationTitle(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*UpdateConversationTitleResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*UpdateConversationTitleResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *UpdateConversationTitleResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *UpdateConversationTitleResponse and nil error while calling UpdateConversationTitle. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *chatServiceServer) serveArchiveConversation(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveArchiveConversationJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveArchiveConversationProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *chatServiceServer) serveArchiveConversationJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "ArchiveConversation")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(ArchiveConversationRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.ChatService.ArchiveConversation
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *ArchiveConversationRequest) (*ArchiveConversationResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ArchiveConversationRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ArchiveConversationRequest) when calling interceptor")
+					}
+					return s.ChatService.ArchiveConversation(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ArchiveConversationResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ArchiveConversationResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *ArchiveConversationResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *ArchiveConversationResponse and nil error while calling ArchiveConversation. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *chatServiceServer) serveArchiveConversationProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "ArchiveConversation")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := io.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(ArchiveConversationRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.ChatService.ArchiveConversation
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *ArchiveConversationRequest) (*ArchiveConversationResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ArchiveConversationRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ArchiveConversationRequest) when calling interceptor")
+					}
+					return s.ChatService.ArchiveConversation(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ArchiveConversationResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ArchiveConversationResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *ArchiveConversationResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *ArchiveConversationResponse and nil error while calling ArchiveConversation. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *chatServiceServer) serveUnarchiveConversation(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveUnarchiveConversationJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveUnarchiveConversationProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *chatServiceServer) serveUnarchiveConversationJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "UnarchiveConversation")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(UnarchiveConversationRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.ChatService.UnarchiveConversation
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *UnarchiveConversationRequest) (*UnarchiveConversationResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*UnarchiveConversationRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*UnarchiveConversationRequest) when calling interceptor")
+					}
+					return s.ChatService.UnarchiveConversation(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*UnarchiveConversationResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*UnarchiveConversationResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *UnarchiveConversationResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *UnarchiveConversationResponse and nil error while calling UnarchiveConversation. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *chatServiceServer) serveUnarchiveConversationProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "UnarchiveConversation")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := io.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(UnarchiveConversationRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.ChatService.UnarchiveConversation
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *UnarchiveConversationRequest) (*UnarchiveConversationResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*UnarchiveConversationRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*UnarchiveConversationRequest) when calling interceptor")
+					}
+					return s.ChatService.UnarchiveConversation(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*UnarchiveConversationResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*UnarchiveConversationResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *UnarchiveConversationResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *UnarchiveConversationResponse and nil error while calling UnarchiveConversation. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *chatServiceServer) serveRegenerateReply(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveRegenerateReplyJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveRegenerateReplyProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *chatServiceServer) serveRegenerateReplyJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "RegenerateReply")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(RegenerateReplyRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.ChatService.RegenerateReply
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *RegenerateReplyRequest) (*RegenerateReplyResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*RegenerateReplyRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*RegenerateReplyRequest) when calling interceptor")
+					}
+					return s.ChatService.RegenerateReply(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*RegenerateReplyResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*RegenerateReplyResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *RegenerateReplyResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *RegenerateReplyResponse and nil error while calling RegenerateReply. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *chatServiceServer) serveRegenerateReplyProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "RegenerateReply")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := io.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(RegenerateReplyRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.ChatService.RegenerateReply
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *RegenerateReplyRequest) (*RegenerateReplyResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*RegenerateReplyRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*RegenerateReplyRequest) when calling interceptor")
+					}
+					return s.ChatService.RegenerateReply(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*RegenerateReplyResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*RegenerateReplyResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *RegenerateReplyResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *RegenerateReplyResponse and nil error while calling RegenerateReply. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *chatServiceServer) serveEditMessage(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveEditMessageJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveEditMessageProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *chatServiceServer) serveEditMessageJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "EditMessage")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(EditMessageRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.ChatService.EditMessage
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *EditMessageRequest) (*EditMessageResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*EditMessageRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*EditMessageRequest) when calling interceptor")
+					}
+					return s.ChatService.EditMessage(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*EditMessageResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*EditMessageResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *EditMessageResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *EditMessageResponse and nil error while calling EditMessage. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *chatServiceServer) serveEditMessageProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "EditMessage")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := io.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(EditMessageRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.ChatService.EditMessage
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *EditMessageRequest) (*EditMessageResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*EditMessageRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*EditMessageRequest) when calling interceptor")
+					}
+					return s.ChatService.EditMessage(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*EditMessageResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*EditMessageResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *EditMessageResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *EditMessageResponse and nil error while calling EditMessage. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *chatServiceServer) serveForkConversation(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveForkConversationJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveForkConversationProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *chatServiceServer) serveForkConversationJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "ForkConversation")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(ForkConversationRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.ChatService.ForkConversation
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *ForkConversationRequest) (*ForkConversationResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ForkConversationRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ForkConversationRequest) when calling interceptor")
+					}
+					return s.ChatService.ForkConversation(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ForkConversationResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ForkConversationResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *ForkConversationResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *ForkConversationResponse and nil error while calling ForkConversation. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *chatServiceServer) serveForkConversationProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "ForkConversation")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := io.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(ForkConversationRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.ChatService.ForkConversation
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *ForkConversationRequest) (*ForkConversationResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ForkConversationRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ForkConversationRequest) when calling interceptor")
+					}
+					return s.ChatService.ForkConversation(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ForkConversationResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ForkConversationResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *ForkConversationResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *ForkConversationResponse and nil error while calling ForkConversation. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *chatServiceServer) serveUpdateConversationSettings(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveUpdateConversationSettingsJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveUpdateConversationSettingsProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *chatServiceServer) serveUpdateConversationSettingsJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "UpdateConversationSettings")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(UpdateConversationSettingsRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.ChatService.UpdateConversationSettings
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *UpdateConversationSettingsRequest) (*UpdateConversationSettingsResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*UpdateConversationSettingsRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*UpdateConversationSettingsRequest) when calling interceptor")
+					}
+					return s.ChatService.UpdateConversationSettings(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*UpdateConversationSettingsResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*UpdateConversationSettingsResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *UpdateConversationSettingsResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *UpdateConversationSettingsResponse and nil error while calling UpdateConversationSettings. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *chatServiceServer) serveUpdateConversationSettingsProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "UpdateConversationSettings")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := io.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(UpdateConversationSettingsRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.ChatService.UpdateConversationSettings
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *UpdateConversationSettingsRequest) (*UpdateConversationSettingsResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*UpdateConversationSettingsRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*UpdateConversationSettingsRequest) when calling interceptor")
+					}
+					return s.ChatService.UpdateConversationSettings(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*UpdateConversationSettingsResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*UpdateConversationSettingsResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *UpdateConversationSettingsResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *UpdateConversationSettingsResponse and nil error while calling UpdateConversationSettings. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *chatServiceServer) serveApproveToolCall(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveApproveToolCallJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveApproveToolCallProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *chatServiceServer) serveApproveToolCallJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "ApproveToolCall")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(ApproveToolCallRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.ChatService.ApproveToolCall
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *ApproveToolCallRequest) (*ApproveToolCallResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ApproveToolCallRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ApproveToolCallRequest) when calling interceptor")
+					}
+					return s.ChatService.ApproveToolCall(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ApproveToolCallResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ApproveToolCallResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *ApproveToolCallResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *ApproveToolCallResponse and nil error while calling ApproveToolCall. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *chatServiceServer) serveApproveToolCallProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "ApproveToolCall")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := io.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(ApproveToolCallRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.ChatService.ApproveToolCall
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *ApproveToolCallRequest) (*ApproveToolCallResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ApproveToolCallRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ApproveToolCallRequest) when calling interceptor")
+					}
+					return s.ChatService.ApproveToolCall(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ApproveToolCallResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ApproveToolCallResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *ApproveToolCallResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *ApproveToolCallResponse and nil error while calling ApproveToolCall. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *chatServiceServer) serveRejectToolCall(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveRejectToolCallJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveRejectToolCallProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *chatServiceServer) serveRejectToolCallJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "RejectToolCall")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(RejectToolCallRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.ChatService.RejectToolCall
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *RejectToolCallRequest) (*RejectToolCallResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*RejectToolCallRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*RejectToolCallRequest) when calling interceptor")
+					}
+					return s.ChatService.RejectToolCall(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*RejectToolCallResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*RejectToolCallResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *RejectToolCallResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *RejectToolCallResponse and nil error while calling RejectToolCall. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *chatServiceServer) serveRejectToolCallProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "RejectToolCall")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := io.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(RejectToolCallRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.ChatService.RejectToolCall
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *RejectToolCallRequest) (*RejectToolCallResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*RejectToolCallRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*RejectToolCallRequest) when calling interceptor")
+					}
+					return s.ChatService.RejectToolCall(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*RejectToolCallResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*RejectToolCallResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *RejectToolCallResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *RejectToolCallResponse and nil error while calling RejectToolCall. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *chatServiceServer) serveGetUsageReport(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveGetUsageReportJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveGetUsageReportProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *chatServiceServer) serveGetUsageReportJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "GetUsageReport")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(GetUsageReportRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.ChatService.GetUsageReport
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *GetUsageReportRequest) (*GetUsageReportResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*GetUsageReportRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*GetUsageReportRequest) when calling interceptor")
+					}
+					return s.ChatService.GetUsageReport(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*GetUsageReportResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*GetUsageReportResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *GetUsageReportResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *GetUsageReportResponse and nil error while calling GetUsageReport. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *chatServiceServer) serveGetUsageReportProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "GetUsageReport")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := io.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(GetUsageReportRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.ChatService.GetUsageReport
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *GetUsageReportRequest) (*GetUsageReportResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*GetUsageReportRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*GetUsageReportRequest) when calling interceptor")
+					}
+					return s.ChatService.GetUsageReport(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*GetUsageReportResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*GetUsageReportResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *GetUsageReportResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *GetUsageReportResponse and nil error while calling GetUsageReport. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *chatServiceServer) ServiceDescriptor() ([]byte, int) {
+	return twirpFileDescriptor0, 0
+}
+
+func (s *chatServiceServer) ProtocGenTwirpVersion() string {
+	return "v8.1.3"
+}
+
+// PathPrefix returns the base service path, in the form: "/<prefix>/<package>.<Service>/"
+// that is everything in a Twirp route except for the <Method>. This can be used for routing,
+// for example to identify the requests that are targeted to this service in a mux.
+func (s *chatServiceServer) PathPrefix() string {
+	return baseServicePath(s.pathPrefix, "acai.chat", "ChatService")
+}
+
+// ========================
+// PersonaService Interface
+// ========================
+
+type PersonaService interface {
+	// Create a persona, its name must be unique
+	CreatePersona(context.Context, *CreatePersonaRequest) (*CreatePersonaResponse, error)
+
+	// List all personas by name
+	ListPersonas(context.Context, *ListPersonasRequest) (*ListPersonasResponse, error)
+
+	// Describe a persona by its ID
+	DescribePersona(context.Context, *DescribePersonaRequest) (*DescribePersonaResponse, error)
+
+	// Replace a persona, conversations using it follow the changes from their next reply on
+	UpdatePersona(context.Context, *UpdatePersonaRequest) (*UpdatePersonaResponse, error)
+
+	// Delete a persona by its ID, conversations using it fall back to the default assistant
+	DeletePersona(context.Context, *DeletePersonaRequest) (*DeletePersonaResponse, error)
+}
+
+// ==============================
+// PersonaService Protobuf Client
+// ==============================
+
+type personaServiceProtobufClient struct {
+	client      HTTPClient
+	urls        [5]string
+	interceptor twirp.Interceptor
+	opts        twirp.ClientOptions
+}
+
+// NewPersonaServiceProtobufClient creates a Protobuf client that implements the PersonaService interface.
+// It communicates using Protobuf and can be configured with a custom HTTPClient.
+func NewPersonaServiceProtobufClient(baseURL string, client HTTPClient, opts ...twirp.ClientOption) PersonaService {
+	if c, ok := client.(*http.Client); ok {
+		client = withoutRedirects(c)
+	}
+
+	clientOpts := twirp.ClientOptions{}
+	for _, o := range opts {
+		o(&clientOpts)
+	}
+
+	// Using ReadOpt allows backwards and forwards compatibility with new options in the future
+	literalURLs := false
+	_ = clientOpts.ReadOpt("literalURLs", &literalURLs)
+	var pathPrefix string
+	if ok := clientOpts.ReadOpt("pathPrefix", &pathPrefix); !ok {
+		pathPrefix = "/twirp" // default prefix
+	}
+
+	// Build method URLs: <baseURL>[<prefix>]/<package>.<Service>/<Method>
+	serviceURL := sanitizeBaseURL(baseURL)
+	serviceURL += baseServicePath(pathPrefix, "acai.chat", "PersonaService")
+	urls := [5]string{
+		serviceURL + "CreatePersona",
+		serviceURL + "ListPersonas",
+		serviceURL + "DescribePersona",
+		serviceURL + "UpdatePersona",
+		serviceURL + "DeletePersona",
+	}
+
+	return &personaServiceProtobufClient{
+		client:      client,
+		urls:        urls,
+		interceptor: twirp.ChainInterceptors(clientOpts.Interceptors...),
+		opts:        clientOpts,
+	}
+}
+
+func (c *personaServiceProtobufClient) CreatePersona(ctx context.Context, in *CreatePersonaRequest) (*CreatePersonaResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "acai.chat")
+	ctx = ctxsetters.WithServiceName(ctx, "PersonaService")
+	ctx = ctxsetters.WithMethodName(ctx, "CreatePersona")
+	caller := c.callCreatePersona
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *CreatePersonaRequest) (*CreatePersonaResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*CreatePersonaRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*CreatePersonaRequest) when calling interceptor")
+					}
+					return c.callCreatePersona(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*CreatePersonaResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*CreatePersonaResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *personaServiceProtobufClient) callCreatePersona(ctx context.Context, in *CreatePersonaRequest) (*CreatePersonaResponse, error) {
+	out := new(CreatePersonaResponse)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[0], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *personaServiceProtobufClient) ListPersonas(ctx context.Context, in *ListPersonasRequest) (*ListPersonasResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "acai.chat")
+	ctx = ctxsetters.WithServiceName(ctx, "PersonaService")
+	ctx = ctxsetters.WithMethodName(ctx, "ListPersonas")
+	caller := c.callListPersonas
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *ListPersonasRequest) (*ListPersonasResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ListPersonasRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ListPersonasRequest) when calling interceptor")
+					}
+					return c.callListPersonas(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ListPersonasResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ListPersonasResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *personaServiceProtobufClient) callListPersonas(ctx context.Context, in *ListPersonasRequest) (*ListPersonasResponse, error) {
+	out := new(ListPersonasResponse)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[1], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *personaServiceProtobufClient) DescribePersona(ctx context.Context, in *DescribePersonaRequest) (*DescribePersonaResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "acai.chat")
+	ctx = ctxsetters.WithServiceName(ctx, "PersonaService")
+	ctx = ctxsetters.WithMethodName(ctx, "DescribePersona")
+	caller := c.callDescribePersona
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *DescribePersonaRequest) (*DescribePersonaResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*DescribePersonaRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*DescribePersonaRequest) when calling interceptor")
+					}
+					return c.callDescribePersona(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*DescribePersonaResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*DescribePersonaResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *personaServiceProtobufClient) callDescribePersona(ctx context.Context, in *DescribePersonaRequest) (*DescribePersonaResponse, error) {
+	out := new(DescribePersonaResponse)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[2], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *personaServiceProtobufClient) UpdatePersona(ctx context.Context, in *UpdatePersonaRequest) (*UpdatePersonaResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "acai.chat")
+	ctx = ctxsetters.WithServiceName(ctx, "PersonaService")
+	ctx = ctxsetters.WithMethodName(ctx, "UpdatePersona")
+	caller := c.callUpdatePersona
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *UpdatePersonaRequest) (*UpdatePersonaResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*UpdatePersonaRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*UpdatePersonaRequest) when calling interceptor")
+					}
+					return c.callUpdatePersona(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*UpdatePersonaResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*UpdatePersonaResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *personaServiceProtobufClient) callUpdatePersona(ctx context.Context, in *UpdatePersonaRequest) (*UpdatePersonaResponse, error) {
+	out := new(UpdatePersonaResponse)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[3], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *personaServiceProtobufClient) DeletePersona(ctx context.Context, in *DeletePersonaRequest) (*DeletePersonaResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "acai.chat")
+	ctx = ctxsetters.WithServiceName(ctx, "PersonaService")
+	ctx = ctxsetters.WithMethodName(ctx, "DeletePersona")
+	caller := c.callDeletePersona
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *DeletePersonaRequest) (*DeletePersonaResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*DeletePersonaRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*DeletePersonaRequest) when calling interceptor")
+					}
+					return c.callDeletePersona(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*DeletePersonaResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*DeletePersonaResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *personaServiceProtobufClient) callDeletePersona(ctx context.Context, in *DeletePersonaRequest) (*DeletePersonaResponse, error) {
+	out := new(DeletePersonaResponse)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[4], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+// ==========================
+// PersonaService JSON Client
+// ==========================
+
+type personaServiceJSONClient struct {
+	client      HTTPClient
+	urls        [5]string
+	interceptor twirp.Interceptor
+	opts        twirp.ClientOptions
+}
+
+// NewPersonaServiceJSONClient creates a JSON client that implements the PersonaService interface.
+// It communicates using JSON and can be configured with a custom HTTPClient.
+func NewPersonaServiceJSONClient(baseURL string, client HTTPClient, opts ...twirp.ClientOption) PersonaService {
+	if c, ok := client.(*http.Client); ok {
+		client = withoutRedirects(c)
+	}
+
+	clientOpts := twirp.ClientOptions{}
+	for _, o := range opts {
+		o(&clientOpts)
+	}
+
+	// Using ReadOpt allows backwards and forwards compatibility with new options in the future
+	literalURLs := false
+	_ = clientOpts.ReadOpt("literalURLs", &literalURLs)
+	var pathPrefix string
+	if ok := clientOpts.ReadOpt("pathPrefix", &pathPrefix); !ok {
+		pathPrefix = "/twirp" // default prefix
+	}
+
+	// Build method URLs: <baseURL>[<prefix>]/<package>.<Service>/<Method>
+	serviceURL := sanitizeBaseURL(baseURL)
+	serviceURL += baseServicePath(pathPrefix, "acai.chat", "PersonaService")
+	urls := [5]string{
+		serviceURL + "CreatePersona",
+		serviceURL + "ListPersonas",
+		serviceURL + "DescribePersona",
+		serviceURL + "UpdatePersona",
+		serviceURL + "DeletePersona",
+	}
+
+	return &personaServiceJSONClient{
+		client:      client,
+		urls:        urls,
+		interceptor: twirp.ChainInterceptors(clientOpts.Interceptors...),
+		opts:        clientOpts,
+	}
+}
+
+func (c *personaServiceJSONClient) CreatePersona(ctx context.Context, in *CreatePersonaRequest) (*CreatePersonaResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "acai.chat")
+	ctx = ctxsetters.WithServiceName(ctx, "PersonaService")
+	ctx = ctxsetters.WithMethodName(ctx, "CreatePersona")
+	caller := c.callCreatePersona
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *CreatePersonaRequest) (*CreatePersonaResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*CreatePersonaRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*CreatePersonaRequest) when calling interceptor")
+					}
+					return c.callCreatePersona(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*CreatePersonaResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*CreatePersonaResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *personaServiceJSONClient) callCreatePersona(ctx context.Context, in *CreatePersonaRequest) (*CreatePersonaResponse, error) {
+	out := new(CreatePersonaResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[0], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *personaServiceJSONClient) ListPersonas(ctx context.Context, in *ListPersonasRequest) (*ListPersonasResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "acai.chat")
+	ctx = ctxsetters.WithServiceName(ctx, "PersonaService")
+	ctx = ctxsetters.WithMethodName(ctx, "ListPersonas")
+	caller := c.callListPersonas
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *ListPersonasRequest) (*ListPersonasResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ListPersonasRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ListPersonasRequest) when calling interceptor")
+					}
+					return c.callListPersonas(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ListPersonasResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ListPersonasResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *personaServiceJSONClient) callListPersonas(ctx context.Context, in *ListPersonasRequest) (*ListPersonasResponse, error) {
+	out := new(ListPersonasResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[1], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *personaServiceJSONClient) DescribePersona(ctx context.Context, in *DescribePersonaRequest) (*DescribePersonaResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "acai.chat")
+	ctx = ctxsetters.WithServiceName(ctx, "PersonaService")
+	ctx = ctxsetters.WithMethodName(ctx, "DescribePersona")
+	caller := c.callDescribePersona
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *DescribePersonaRequest) (*DescribePersonaResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*DescribePersonaRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*DescribePersonaRequest) when calling interceptor")
+					}
+					return c.callDescribePersona(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*DescribePersonaResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*DescribePersonaResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *personaServiceJSONClient) callDescribePersona(ctx context.Context, in *DescribePersonaRequest) (*DescribePersonaResponse, error) {
+	out := new(DescribePersonaResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[2], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *personaServiceJSONClient) UpdatePersona(ctx context.Context, in *UpdatePersonaRequest) (*UpdatePersonaResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "acai.chat")
+	ctx = ctxsetters.WithServiceName(ctx, "PersonaService")
+	ctx = ctxsetters.WithMethodName(ctx, "UpdatePersona")
+	caller := c.callUpdatePersona
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *UpdatePersonaRequest) (*UpdatePersonaResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*UpdatePersonaRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*UpdatePersonaRequest) when calling interceptor")
+					}
+					return c.callUpdatePersona(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*UpdatePersonaResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*UpdatePersonaResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *personaServiceJSONClient) callUpdatePersona(ctx context.Context, in *UpdatePersonaRequest) (*UpdatePersonaResponse, error) {
+	out := new(UpdatePersonaResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[3], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *personaServiceJSONClient) DeletePersona(ctx context.Context, in *DeletePersonaRequest) (*DeletePersonaResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "acai.chat")
+	ctx = ctxsetters.WithServiceName(ctx, "PersonaService")
+	ctx = ctxsetters.WithMethodName(ctx, "DeletePersona")
+	caller := c.callDeletePersona
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *DeletePersonaRequest) (*DeletePersonaResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*DeletePersonaRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*DeletePersonaRequest) when calling interceptor")
+					}
+					return c.callDeletePersona(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*DeletePersonaResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*DeletePersonaResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *personaServiceJSONClient) callDeletePersona(ctx context.Context, in *DeletePersonaRequest) (*DeletePersonaResponse, error) {
+	out := new(DeletePersonaResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[4], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+// =============================
+// PersonaService Server Handler
+// =============================
+
+type personaServiceServer struct {
+	PersonaService
+	interceptor      twirp.Interceptor
+	hooks            *twirp.ServerHooks
+	pathPrefix       string // prefix for routing
+	jsonSkipDefaults bool   // do not include unpopulated fields (default values) in the response
+	jsonCamelCase    bool   // JSON fields are serialized as lowerCamelCase rather than keeping the original proto names
+}
+
+// NewPersonaServiceServer builds a TwirpServer that can be used as an http.Handler to handle
+// HTTP requests that are routed to the right method in the provided svc implementation.
+// The opts are twirp.ServerOption modifiers, for example twirp.WithServerHooks(hooks).
+func NewPersonaServiceServer(svc PersonaService, opts ...interface{}) TwirpServer {
+	serverOpts := newServerOpts(opts)
+
+	// Using ReadOpt allows backwards and forwards compatibility with new options in the future
+	jsonSkipDefaults := false
+	_ = serverOpts.ReadOpt("jsonSkipDefaults", &jsonSkipDefaults)
+	jsonCamelCase := false
+	_ = serverOpts.ReadOpt("jsonCamelCase", &jsonCamelCase)
+	var pathPrefix string
+	if ok := serverOpts.ReadOpt("pathPrefix", &pathPrefix); !ok {
+		pathPrefix = "/twirp" // default prefix
+	}
+
+	return &personaServiceServer{
+		PersonaService:   svc,
+		hooks:            serverOpts.Hooks,
+		interceptor:      twirp.ChainInterceptors(serverOpts.Interceptors...),
+		pathPrefix:       pathPrefix,
+		jsonSkipDefaults: jsonSkipDefaults,
+		jsonCamelCase:    jsonCamelCase,
+	}
+}
+
+// writeError writes an HTTP response with a valid Twirp error format, and triggers hooks.
+// If err is not a twirp.Error, it will get wrapped with twirp.InternalErrorWith(err)
+func (s *personaServiceServer) writeError(ctx context.Context, resp http.ResponseWriter, err error) {
+	writeError(ctx, resp, err, s.hooks)
+}
+
+// handleRequestBodyError is used to handle error when the twirp server cannot read request
+func (s *personaServiceServer) handleRequestBodyError(ctx context.Context, resp http.ResponseWriter, msg string, err error) {
+	if context.Canceled == ctx.Err() {
+		s.writeError(ctx, resp, twirp.NewError(twirp.Canceled, "failed to read request: context canceled"))
+		return
+	}
+	if context.DeadlineExceeded == ctx.Err() {
+		s.writeError(ctx, resp, twirp.NewError(twirp.DeadlineExceeded, "failed to read request: deadline exceeded"))
+		return
+	}
+	s.writeError(ctx, resp, twirp.WrapError(malformedRequestError(msg), err))
+}
+
+// PersonaServicePathPrefix is a convenience constant that may identify URL paths.
+// Should be used with caution, it only matches routes generated by Twirp Go clients,
+// with the default "/twirp" prefix and default CamelCase service and method names.
+// More info: https://twitchtv.github.io/twirp/docs/routing.html
+const PersonaServicePathPrefix = "/twirp/acai.chat.PersonaService/"
+
+func (s *personaServiceServer) ServeHTTP(resp http.ResponseWriter, req *http.Request) {
+	ctx := req.Context()
+	ctx = ctxsetters.WithPackageName(ctx, "acai.chat")
+	ctx = ctxsetters.WithServiceName(ctx, "PersonaService")
+	ctx = ctxsetters.WithResponseWriter(ctx, resp)
+
+	var err error
+	ctx, err = callRequestReceived(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	if req.Method != "POST" {
+		msg := fmt.Sprintf("unsupported method %q (only POST is allowed)", req.Method)
+		s.writeError(ctx, resp, badRouteError(msg, req.Method, req.URL.Path))
+		return
+	}
+
+	// Verify path format: [<prefix>]/<package>.<Service>/<Method>
+	prefix, pkgService, method := parseTwirpPath(req.URL.Path)
+	if pkgService != "acai.chat.PersonaService" {
+		msg := fmt.Sprintf("no handler for path %q", req.URL.Path)
+		s.writeError(ctx, resp, badRouteError(msg, req.Method, req.URL.Path))
+		return
+	}
+	if prefix != s.pathPrefix {
+		msg := fmt.Sprintf("invalid path prefix %q, expected %q, on path %q", prefix, s.pathPrefix, req.URL.Path)
+		s.writeError(ctx, resp, badRouteError(msg, req.Method, req.URL.Path))
+		return
+	}
+
+	switch method {
+	case "CreatePersona":
+		s.serveCreatePersona(ctx, resp, req)
+		return
+	case "ListPersonas":
+		s.serveListPersonas(ctx, resp, req)
+		return
+	case "DescribePersona":
+		s.serveDescribePersona(ctx, resp, req)
+		return
+	case "UpdatePersona":
+		s.serveUpdatePersona(ctx, resp, req)
+		return
+	case "DeletePersona":
+		s.serveDeletePersona(ctx, resp, req)
+		return
+	default:
+		msg := fmt.Sprintf("no handler for path %q", req.URL.Path)
+		s.writeError(ctx, resp, badRouteError(msg, req.Method, req.URL.Path))
+		return
+	}
+}
+
+func (s *personaServiceServer) serveCreatePersona(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveCreatePersonaJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveCreatePersonaProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *personaServiceServer) serveCreatePersonaJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "CreatePersona")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(CreatePersonaRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.PersonaService.CreatePersona
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *CreatePersonaRequest) (*CreatePersonaResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*CreatePersonaRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*CreatePersonaRequest) when calling interceptor")
+					}
+					return s.PersonaService.CreatePersona(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*CreatePersonaResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*CreatePersonaResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *CreatePersonaResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *CreatePersonaResponse and nil error while calling CreatePersona. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *personaServiceServer) serveCreatePersonaProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "CreatePersona")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := io.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(CreatePersonaRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.PersonaService.CreatePersona
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *CreatePersonaRequest) (*CreatePersonaResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*CreatePersonaRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*CreatePersonaRequest) when calling interceptor")
+					}
+					return s.PersonaService.CreatePersona(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*CreatePersonaResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*CreatePersonaResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *CreatePersonaResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *CreatePersonaResponse and nil error while calling CreatePersona. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *personaServiceServer) serveListPersonas(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveListPersonasJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveListPersonasProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *personaServiceServer) serveListPersonasJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "ListPersonas")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(ListPersonasRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.PersonaService.ListPersonas
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *ListPersonasRequest) (*ListPersonasResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ListPersonasRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ListPersonasRequest) when calling interceptor")
+					}
+					return s.PersonaService.ListPersonas(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ListPersonasResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ListPersonasResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *ListPersonasResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *ListPersonasResponse and nil error while calling ListPersonas. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *personaServiceServer) serveListPersonasProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "ListPersonas")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := io.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(ListPersonasRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.PersonaService.ListPersonas
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *ListPersonasRequest) (*ListPersonasResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ListPersonasRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ListPersonasRequest) when calling interceptor")
+					}
+					return s.PersonaService.ListPersonas(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ListPersonasResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ListPersonasResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *ListPersonasResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *ListPersonasResponse and nil error while calling ListPersonas. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *personaServiceServer) serveDescribePersona(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveDescribePersonaJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveDescribePersonaProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *personaServiceServer) serveDescribePersonaJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "DescribePersona")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(DescribePersonaRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.PersonaService.DescribePersona
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *DescribePersonaRequest) (*DescribePersonaResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*DescribePersonaRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*DescribePersonaRequest) when calling interceptor")
+					}
+					return s.PersonaService.DescribePersona(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*DescribePersonaResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*DescribePersonaResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *DescribePersonaResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *DescribePersonaResponse and nil error while calling DescribePersona. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *personaServiceServer) serveDescribePersonaProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "DescribePersona")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := io.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(DescribePersonaRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.PersonaService.DescribePersona
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *DescribePersonaRequest) (*DescribePersonaResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*DescribePersonaRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*DescribePersonaRequest) when calling interceptor")
+					}
+					return s.PersonaService.DescribePersona(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*DescribePersonaResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*DescribePersonaResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *DescribePersonaResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *DescribePersonaResponse and nil error while calling DescribePersona. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *personaServiceServer) serveUpdatePersona(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveUpdatePersonaJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveUpdatePersonaProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *personaServiceServer) serveUpdatePersonaJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "UpdatePersona")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(UpdatePersonaRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.PersonaService.UpdatePersona
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *UpdatePersonaRequest) (*UpdatePersonaResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*UpdatePersonaRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*UpdatePersonaRequest) when calling interceptor")
+					}
+					return s.PersonaService.UpdatePersona(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*UpdatePersonaResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*UpdatePersonaResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *UpdatePersonaResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *UpdatePersonaResponse and nil error while calling UpdatePersona. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *personaServiceServer) serveUpdatePersonaProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "UpdatePersona")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := io.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(UpdatePersonaRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.PersonaService.UpdatePersona
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *UpdatePersonaRequest) (*UpdatePersonaResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*UpdatePersonaRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*UpdatePersonaRequest) when calling interceptor")
+					}
+					return s.PersonaService.UpdatePersona(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*UpdatePersonaResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*UpdatePersonaResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *UpdatePersonaResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *UpdatePersonaResponse and nil error while calling UpdatePersona. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *personaServiceServer) serveDeletePersona(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveDeletePersonaJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveDeletePersonaProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *personaServiceServer) serveDeletePersonaJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "DeletePersona")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(DeletePersonaRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.PersonaService.DeletePersona
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *DeletePersonaRequest) (*DeletePersonaResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*DeletePersonaRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*DeletePersonaRequest) when calling interceptor")
+					}
+					return s.PersonaService.DeletePersona(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*DeletePersonaResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*DeletePersonaResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *DeletePersonaResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *DeletePersonaResponse and nil error while calling DeletePersona. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *personaServiceServer) serveDeletePersonaProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "DeletePersona")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := io.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(DeletePersonaRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.PersonaService.DeletePersona
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *DeletePersonaRequest) (*DeletePersonaResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*DeletePersonaRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*DeletePersonaRequest) when calling interceptor")
+					}
+					return s.PersonaService.DeletePersona(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*DeletePersonaResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*DeletePersonaResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *DeletePersonaResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *DeletePersonaResponse and nil error while calling DeletePersona. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *personaServiceServer) ServiceDescriptor() ([]byte, int) {
+	return twirpFileDescriptor0, 1
+}
+
+func (s *personaServiceServer) ProtocGenTwirpVersion() string {
+	return "v8.1.3"
+}
+
+// PathPrefix returns the base service path, in the form: "/<prefix>/<package>.<Service>/"
+// that is everything in a Twirp route except for the <Method>. This can be used for routing,
+// for example to identify the requests that are targeted to this service in a mux.
+func (s *personaServiceServer) PathPrefix() string {
+	return baseServicePath(s.pathPrefix, "acai.chat", "PersonaService")
+}
+
+// =======================
+// TenantService Interface
+// =======================
+
+// Manages the tenants sharing the deployment, only available to admins
+type TenantService interface {
+	// Create a tenant, its ID must be unique
+	CreateTenant(context.Context, *CreateTenantRequest) (*CreateTenantResponse, error)
+
+	// List all tenants by ID
+	ListTenants(context.Context, *ListTenantsRequest) (*ListTenantsResponse, error)
+
+	// Describe a tenant by its ID
+	DescribeTenant(context.Context, *DescribeTenantRequest) (*DescribeTenantResponse, error)
+
+	// Replace the configuration of a tenant, it applies from the next reply on
+	UpdateTenant(context.Context, *UpdateTenantRequest) (*UpdateTenantResponse, error)
+
+	// Delete a tenant by its ID, its conversations are kept but can no longer be replied to
+	DeleteTenant(context.Context, *DeleteTenantRequest) (*DeleteTenantResponse, error)
+}
+
+// =============================
+// TenantService Protobuf Client
+// =============================
+
+type tenantServiceProtobufClient struct {
+	client      HTTPClient
+	urls        [5]string
+	interceptor twirp.Interceptor
+	opts        twirp.ClientOptions
+}
+
+// NewTenantServiceProtobufClient creates a Protobuf client that implements the TenantService interface.
+// It communicates using Protobuf and can be configured with a custom HTTPClient.
+func NewTenantServiceProtobufClient(baseURL string, client HTTPClient, opts ...twirp.ClientOption) TenantService {
+	if c, ok := client.(*http.Client); ok {
+		client = withoutRedirects(c)
+	}
+
+	clientOpts := twirp.ClientOptions{}
+	for _, o := range opts {
+		o(&clientOpts)
+	}
+
+	// Using ReadOpt allows backwards and forwards compatibility with new options in the future
+	literalURLs := false
+	_ = clientOpts.ReadOpt("literalURLs", &literalURLs)
+	var pathPrefix string
+	if ok := clientOpts.ReadOpt("pathPrefix", &pathPrefix); !ok {
+		pathPrefix = "/twirp" // default prefix
+	}
+
+	// Build method URLs: <baseURL>[<prefix>]/<package>.<Service>/<Method>
+	serviceURL := sanitizeBaseURL(baseURL)
+	serviceURL += baseServicePath(pathPrefix, "acai.chat", "TenantService")
+	urls := [5]string{
+		serviceURL + "CreateTenant",
+		serviceURL + "ListTenants",
+		serviceURL + "DescribeTenant",
+		serviceURL + "UpdateTenant",
+		serviceURL + "DeleteTenant",
+	}
+
+	return &tenantServiceProtobufClient{
+		client:      client,
+		urls:        urls,
+		interceptor: twirp.ChainInterceptors(clientOpts.Interceptors...),
+		opts:        clientOpts,
+	}
+}
+
+func (c *tenantServiceProtobufClient) CreateTenant(ctx context.Context, in *CreateTenantRequest) (*CreateTenantResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "acai.chat")
+	ctx = ctxsetters.WithServiceName(ctx, "TenantService")
+	ctx = ctxsetters.WithMethodName(ctx, "CreateTenant")
+	caller := c.callCreateTenant
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *CreateTenantRequest) (*CreateTenantResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*CreateTenantRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*CreateTenantRequest) when calling interceptor")
+					}
+					return c.callCreateTenant(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*CreateTenantResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*CreateTenantResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *tenantServiceProtobufClient) callCreateTenant(ctx context.Context, in *CreateTenantRequest) (*CreateTenantResponse, error) {
+	out := new(CreateTenantResponse)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[0], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *tenantServiceProtobufClient) ListTenants(ctx context.Context, in *ListTenantsRequest) (*ListTenantsResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "acai.chat")
+	ctx = ctxsetters.WithServiceName(ctx, "TenantService")
+	ctx = ctxsetters.WithMethodName(ctx, "ListTenants")
+	caller := c.callListTenants
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *ListTenantsRequest) (*ListTenantsResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ListTenantsRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ListTenantsRequest) when calling interceptor")
+					}
+					return c.callListTenants(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ListTenantsResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ListTenantsResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *tenantServiceProtobufClient) callListTenants(ctx context.Context, in *ListTenantsRequest) (*ListTenantsResponse, error) {
+	out := new(ListTenantsResponse)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[1], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *tenantServiceProtobufClient) DescribeTenant(ctx context.Context, in *DescribeTenantRequest) (*DescribeTenantResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "acai.chat")
+	ctx = ctxsetters.WithServiceName(ctx, "TenantService")
+	ctx = ctxsetters.WithMethodName(ctx, "DescribeTenant")
+	caller := c.callDescribeTenant
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *DescribeTenantRequest) (*DescribeTenantResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*DescribeTenantRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*DescribeTenantRequest) when calling interceptor")
+					}
+					return c.callDescribeTenant(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*DescribeTenantResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*DescribeTenantResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *tenantServiceProtobufClient) callDescribeTenant(ctx context.Context, in *DescribeTenantRequest) (*DescribeTenantResponse, error) {
+	out := new(DescribeTenantResponse)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[2], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *tenantServiceProtobufClient) UpdateTenant(ctx context.Context, in *UpdateTenantRequest) (*UpdateTenantResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "acai.chat")
+	ctx = ctxsetters.WithServiceName(ctx, "TenantService")
+	ctx = ctxsetters.WithMethodName(ctx, "UpdateTenant")
+	caller := c.callUpdateTenant
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *UpdateTenantRequest) (*UpdateTenantResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*UpdateTenantRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*UpdateTenantRequest) when calling interceptor")
+					}
+					return c.callUpdateTenant(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*UpdateTenantResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*UpdateTenantResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *tenantServiceProtobufClient) callUpdateTenant(ctx context.Context, in *UpdateTenantRequest) (*UpdateTenantResponse, error) {
+	out := new(UpdateTenantResponse)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[3], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *tenantServiceProtobufClient) DeleteTenant(ctx context.Context, in *DeleteTenantRequest) (*DeleteTenantResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "acai.chat")
+	ctx = ctxsetters.WithServiceName(ctx, "TenantService")
+	ctx = ctxsetters.WithMethodName(ctx, "DeleteTenant")
+	caller := c.callDeleteTenant
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *DeleteTenantRequest) (*DeleteTenantResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*DeleteTenantRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*DeleteTenantRequest) when calling interceptor")
+					}
+					return c.callDeleteTenant(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*DeleteTenantResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*DeleteTenantResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *tenantServiceProtobufClient) callDeleteTenant(ctx context.Context, in *DeleteTenantRequest) (*DeleteTenantResponse, error) {
+	out := new(DeleteTenantResponse)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[4], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+// =========================
+// TenantService JSON Client
+// =========================
+
+type tenantServiceJSONClient struct {
+	client      HTTPClient
+	urls        [5]string
+	interceptor twirp.Interceptor
+	opts        twirp.ClientOptions
+}
+
+// NewTenantServiceJSONClient creates a JSON client that implements the TenantService interface.
+// It communicates using JSON and can be configured with a custom HTTPClient.
+func NewTenantServiceJSONClient(baseURL string, client HTTPClient, opts ...twirp.ClientOption) TenantService {
+	if c, ok := client.(*http.Client); ok {
+		client = withoutRedirects(c)
+	}
+
+	clientOpts := twirp.ClientOptions{}
+	for _, o := range opts {
+		o(&clientOpts)
+	}
+
+	// Using ReadOpt allows backwards and forwards compatibility with new options in the future
+	literalURLs := false
+	_ = clientOpts.ReadOpt("literalURLs", &literalURLs)
+	var pathPrefix string
+	if ok := clientOpts.ReadOpt("pathPrefix", &pathPrefix); !ok {
+		pathPrefix = "/twirp" // default prefix
+	}
+
+	// Build method URLs: <baseURL>[<prefix>]/<package>.<Service>/<Method>
+	serviceURL := sanitizeBaseURL(baseURL)
+	serviceURL += baseServicePath(pathPrefix, "acai.chat", "TenantService")
+	urls := [5]string{
+		serviceURL + "CreateTenant",
+		serviceURL + "ListTenants",
+		serviceURL + "DescribeTenant",
+		serviceURL + "UpdateTenant",
+		serviceURL + "DeleteTenant",
+	}
+
+	return &tenantServiceJSONClient{
+		client:      client,
+		urls:        urls,
+		interceptor: twirp.ChainInterceptors(clientOpts.Interceptors...),
+		opts:        clientOpts,
+	}
+}
+
+func (c *tenantServiceJSONClient) CreateTenant(ctx context.Context, in *CreateTenantRequest) (*CreateTenantResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "acai.chat")
+	ctx = ctxsetters.WithServiceName(ctx, "TenantService")
+	ctx = ctxsetters.WithMethodName(ctx, "CreateTenant")
+	caller := c.callCreateTenant
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *CreateTenantRequest) (*CreateTenantResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*CreateTenantRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*CreateTenantRequest) when calling interceptor")
+					}
+					return c.callCreateTenant(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*CreateTenantResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*CreateTenantResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *tenantServiceJSONClient) callCreateTenant(ctx context.Context, in *CreateTenantRequest) (*CreateTenantResponse, error) {
+	out := new(CreateTenantResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[0], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *tenantServiceJSONClient) ListTenants(ctx context.Context, in *ListTenantsRequest) (*ListTenantsResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "acai.chat")
+	ctx = ctxsetters.WithServiceName(ctx, "TenantService")
+	ctx = ctxsetters.WithMethodName(ctx, "ListTenants")
+	caller := c.callListTenants
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *ListTenantsRequest) (*ListTenantsResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ListTenantsRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ListTenantsRequest) when calling interceptor")
+					}
+					return c.callListTenants(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ListTenantsResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ListTenantsResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *tenantServiceJSONClient) callListTenants(ctx context.Context, in *ListTenantsRequest) (*ListTenantsResponse, error) {
+	out := new(ListTenantsResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[1], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *tenantServiceJSONClient) DescribeTenant(ctx context.Context, in *DescribeTenantRequest) (*DescribeTenantResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "acai.chat")
+	ctx = ctxsetters.WithServiceName(ctx, "TenantService")
+	ctx = ctxsetters.WithMethodName(ctx, "DescribeTenant")
+	caller := c.callDescribeTenant
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *DescribeTenantRequest) (*DescribeTenantResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*DescribeTenantRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*DescribeTenantRequest) when calling interceptor")
+					}
+					return c.callDescribeTenant(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*DescribeTenantResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*DescribeTenantResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *tenantServiceJSONClient) callDescribeTenant(ctx context.Context, in *DescribeTenantRequest) (*DescribeTenantResponse, error) {
+	out := new(DescribeTenantResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[2], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *tenantServiceJSONClient) UpdateTenant(ctx context.Context, in *UpdateTenantRequest) (*UpdateTenantResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "acai.chat")
+	ctx = ctxsetters.WithServiceName(ctx, "TenantService")
+	ctx = ctxsetters.WithMethodName(ctx, "UpdateTenant")
+	caller := c.callUpdateTenant
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *UpdateTenantRequest) (*UpdateTenantResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*UpdateTenantRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*UpdateTenantRequest) when calling interceptor")
+					}
+					return c.callUpdateTenant(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*UpdateTenantResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*UpdateTenantResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *tenantServiceJSONClient) callUpdateTenant(ctx context.Context, in *UpdateTenantRequest) (*UpdateTenantResponse, error) {
+	out := new(UpdateTenantResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[3], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *tenantServiceJSONClient) DeleteTenant(ctx context.Context, in *DeleteTenantRequest) (*DeleteTenantResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "acai.chat")
+	ctx = ctxsetters.WithServiceName(ctx, "TenantService")
+	ctx = ctxsetters.WithMethodName(ctx, "DeleteTenant")
+	caller := c.callDeleteTenant
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *DeleteTenantRequest) (*DeleteTenantResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*DeleteTenantRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*DeleteTenantRequest) when calling interceptor")
+					}
+					return c.callDeleteTenant(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*DeleteTenantResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*DeleteTenantResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *tenantServiceJSONClient) callDeleteTenant(ctx context.Context, in *DeleteTenantRequest) (*DeleteTenantResponse, error) {
+	out := new(DeleteTenantResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[4], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+// ============================
+// TenantService Server Handler
+// ============================
+
+type tenantServiceServer struct {
+	TenantService
+	interceptor      twirp.Interceptor
+	hooks            *twirp.ServerHooks
+	pathPrefix       string // prefix for routing
+	jsonSkipDefaults bool   // do not include unpopulated fields (default values) in the response
+	jsonCamelCase    bool   // JSON fields are serialized as lowerCamelCase rather than keeping the original proto names
+}
+
+// NewTenantServiceServer builds a TwirpServer that can be used as an http.Handler to handle
+// HTTP requests that are routed to the right method in the provided svc implementation.
+// The opts are twirp.ServerOption modifiers, for example twirp.WithServerHooks(hooks).
+func NewTenantServiceServer(svc TenantService, opts ...interface{}) TwirpServer {
+	serverOpts := newServerOpts(opts)
+
+	// Using ReadOpt allows backwards and forwards compatibility with new options in the future
+	jsonSkipDefaults := false
+	_ = serverOpts.ReadOpt("jsonSkipDefaults", &jsonSkipDefaults)
+	jsonCamelCase := false
+	_ = serverOpts.ReadOpt("jsonCamelCase", &jsonCamelCase)
+	var pathPrefix string
+	if ok := serverOpts.ReadOpt("pathPrefix", &pathPrefix); !ok {
+		pathPrefix = "/twirp" // default prefix
+	}
+
+	return &tenantServiceServer{
+		TenantService:    svc,
+		hooks:            serverOpts.Hooks,
+		interceptor:      twirp.ChainInterceptors(serverOpts.Interceptors...),
+		pathPrefix:       pathPrefix,
+		jsonSkipDefaults: jsonSkipDefaults,
+		jsonCamelCase:    jsonCamelCase,
+	}
+}
+
+// writeError writes an HTTP response with a valid Twirp error format, and triggers hooks.
+// If err is not a twirp.Error, it will get wrapped with twirp.InternalErrorWith(err)
+func (s *tenantServiceServer) writeError(ctx context.Context, resp http.ResponseWriter, err error) {
+	writeError(ctx, resp, err, s.hooks)
+}
+
+// handleRequestBodyError is used to handle error when the twirp server cannot read request
+func (s *tenantServiceServer) handleRequestBodyError(ctx context.Context, resp http.ResponseWriter, msg string, err error) {
+	if context.Canceled == ctx.Err() {
+		s.writeError(ctx, resp, twirp.NewError(twirp.Canceled, "failed to read request: context canceled"))
+		return
+	}
+	if context.DeadlineExceeded == ctx.Err() {
+		s.writeError(ctx, resp, twirp.NewError(twirp.DeadlineExceeded, "failed to read request: deadline exceeded"))
+		return
+	}
+	s.writeError(ctx, resp, twirp.WrapError(malformedRequestError(msg), err))
+}
+
+// TenantServicePathPrefix is a convenience constant that may identify URL paths.
+// Should be used with caution, it only matches routes generated by Twirp Go clients,
+// with the default "/twirp" prefix and default CamelCase service and method names.
+// More info: https://twitchtv.github.io/twirp/docs/routing.html
+const TenantServicePathPrefix = "/twirp/acai.chat.TenantService/"
+
+func (s *tenantServiceServer) ServeHTTP(resp http.ResponseWriter, req *http.Request) {
+	ctx := req.Context()
+	ctx = ctxsetters.WithPackageName(ctx, "acai.chat")
+	ctx = ctxsetters.WithServiceName(ctx, "TenantService")
+	ctx = ctxsetters.WithResponseWriter(ctx, resp)
+
+	var err error
+	ctx, err = callRequestReceived(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	if req.Method != "POST" {
+		msg := fmt.Sprintf("unsupported method %q (only POST is allowed)", req.Method)
+		s.writeError(ctx, resp, badRouteError(msg, req.Method, req.URL.Path))
+		return
+	}
+
+	// Verify path format: [<prefix>]/<package>.<Service>/<Method>
+	prefix, pkgService, method := parseTwirpPath(req.URL.Path)
+	if pkgService != "acai.chat.TenantService" {
+		msg := fmt.Sprintf("no handler for path %q", req.URL.Path)
+		s.writeError(ctx, resp, badRouteError(msg, req.Method, req.URL.Path))
+		return
+	}
+	if prefix != s.pathPrefix {
+		msg := fmt.Sprintf("invalid path prefix %q, expected %q, on path %q", prefix, s.pathPrefix, req.URL.Path)
+		s.writeError(ctx, resp, badRouteError(msg, req.Method, req.URL.Path))
+		return
+	}
+
+	switch method {
+	case "CreateTenant":
+		s.serveCreateTenant(ctx, resp, req)
+		return
+	case "ListTenants":
+		s.serveListTenants(ctx, resp, req)
+		return
+	case "DescribeTenant":
+		s.serveDescribeTenant(ctx, resp, req)
+		return
+	case "UpdateTenant":
+		s.serveUpdateTenant(ctx, resp, req)
+		return
+	case "DeleteTenant":
+		s.serveDeleteTenant(ctx, resp, req)
+		return
+	default:
+		msg := fmt.Sprintf("no handler for path %q", req.URL.Path)
+		s.writeError(ctx, resp, badRouteError(msg, req.Method, req.URL.Path))
+		return
+	}
+}
+
+func (s *tenantServiceServer) serveCreateTenant(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveCreateTenantJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveCreateTenantProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *tenantServiceServer) serveCreateTenantJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "CreateTenant")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(CreateTenantRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.TenantService.CreateTenant
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *CreateTenantRequest) (*CreateTenantResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*CreateTenantRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*CreateTenantRequest) when calling interceptor")
+					}
+					return s.TenantService.CreateTenant(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*CreateTenantResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*CreateTenantResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
 
-// writeError writes an HTTP response with a valid Twirp error format, and triggers hooks.
-// If err is not a twirp.Error, it will get wrapped with twirp.InternalErrorWith(err)
-func (s *chatServiceServer) writeError(ctx context.Context, resp http.ResponseWriter, err error) {
-	writeError(ctx, resp, err, s.hooks)
-}
+	// Call service method
+	var respContent *CreateTenantResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
 
-// handleRequestBodyError is used to handle error when the twirp server cannot read request
-func (s *chatServiceServer) handleRequestBodyError(ctx context.Context, resp http.ResponseWriter, msg string, err error) {
-	if context.Canceled == ctx.Err() {
-		s.writeError(ctx, resp, twirp.NewError(twirp.Canceled, "failed to read request: context canceled"))
+	if err != nil {
+		s.writeError(ctx, resp, err)
 		return
 	}
-	if context.DeadlineExceeded == ctx.Err() {
-		s.writeError(ctx, resp, twirp.NewError(twirp.DeadlineExceeded, "failed to read request: deadline exceeded"))
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *CreateTenantResponse and nil error while calling CreateTenant. nil responses are not supported"))
 		return
 	}
-	s.writeError(ctx, resp, twirp.WrapError(malformedRequestError(msg), err))
-}
 
-// ChatServicePathPrefix is a convenience constant that may identify URL paths.
-// Should be used with caution, it only matches routes generated by Twirp Go clients,
-// with the default "/twirp" prefix and default CamelCase service and method names.
-// More info: https://twitchtv.github.io/twirp/docs/routing.html
-const ChatServicePathPrefix = "/twirp/acai.chat.ChatService/"
+	ctx = callResponsePrepared(ctx, s.hooks)
 
-func (s *chatServiceServer) ServeHTTP(resp http.ResponseWriter, req *http.Request) {
-	ctx := req.Context()
-	ctx = ctxsetters.WithPackageName(ctx, "acai.chat")
-	ctx = ctxsetters.WithServiceName(ctx, "ChatService")
-	ctx = ctxsetters.WithResponseWriter(ctx, resp)
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
 
+func (s *tenantServiceServer) serveCreateTenantProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
 	var err error
-	ctx, err = callRequestReceived(ctx, s.hooks)
+	ctx = ctxsetters.WithMethodName(ctx, "CreateTenant")
+	ctx, err = callRequestRouted(ctx, s.hooks)
 	if err != nil {
 		s.writeError(ctx, resp, err)
 		return
 	}
 
-	if req.Method != "POST" {
-		msg := fmt.Sprintf("unsupported method %q (only POST is allowed)", req.Method)
-		s.writeError(ctx, resp, badRouteError(msg, req.Method, req.URL.Path))
+	buf, err := io.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
 		return
 	}
-
-	// Verify path format: [<prefix>]/<package>.<Service>/<Method>
-	prefix, pkgService, method := parseTwirpPath(req.URL.Path)
-	if pkgService != "acai.chat.ChatService" {
-		msg := fmt.Sprintf("no handler for path %q", req.URL.Path)
-		s.writeError(ctx, resp, badRouteError(msg, req.Method, req.URL.Path))
+	reqContent := new(CreateTenantRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
 		return
 	}
-	if prefix != s.pathPrefix {
-		msg := fmt.Sprintf("invalid path prefix %q, expected %q, on path %q", prefix, s.pathPrefix, req.URL.Path)
-		s.writeError(ctx, resp, badRouteError(msg, req.Method, req.URL.Path))
-		return
+
+	handler := s.TenantService.CreateTenant
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *CreateTenantRequest) (*CreateTenantResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*CreateTenantRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*CreateTenantRequest) when calling interceptor")
+					}
+					return s.TenantService.CreateTenant(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*CreateTenantResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*CreateTenantResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
 	}
 
-	switch method {
-	case "StartConversation":
-		s.serveStartConversation(ctx, resp, req)
-		return
-	case "ContinueConversation":
-		s.serveContinueConversation(ctx, resp, req)
-		return
-	case "ListConversations":
-		s.serveListConversations(ctx, resp, req)
+	// Call service method
+	var respContent *CreateTenantResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
 		return
-	case "DescribeConversation":
-		s.serveDescribeConversation(ctx, resp, req)
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *CreateTenantResponse and nil error while calling CreateTenant. nil responses are not supported"))
 		return
-	default:
-		msg := fmt.Sprintf("no handler for path %q", req.URL.Path)
-		s.writeError(ctx, resp, badRouteError(msg, req.Method, req.URL.Path))
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
 		return
 	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
 }
 
-func (s *chatServiceServer) serveStartConversation(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+func (s *tenantServiceServer) serveListTenants(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
 	header := req.Header.Get("Content-Type")
 	i := strings.Index(header, ";")
 	if i == -1 {
@@ -636,9 +6948,9 @@ func (s *chatServiceServer) serveStartConversation(ctx context.Context, resp htt
 	}
 	switch strings.TrimSpace(strings.ToLower(header[:i])) {
 	case "application/json":
-		s.serveStartConversationJSON(ctx, resp, req)
+		s.serveListTenantsJSON(ctx, resp, req)
 	case "application/protobuf":
-		s.serveStartConversationProtobuf(ctx, resp, req)
+		s.serveListTenantsProtobuf(ctx, resp, req)
 	default:
 		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
 		twerr := badRouteError(msg, req.Method, req.URL.Path)
@@ -646,9 +6958,9 @@ func (s *chatServiceServer) serveStartConversation(ctx context.Context, resp htt
 	}
 }
 
-func (s *chatServiceServer) serveStartConversationJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+func (s *tenantServiceServer) serveListTenantsJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
 	var err error
-	ctx = ctxsetters.WithMethodName(ctx, "StartConversation")
+	ctx = ctxsetters.WithMethodName(ctx, "ListTenants")
 	ctx, err = callRequestRouted(ctx, s.hooks)
 	if err != nil {
 		s.writeError(ctx, resp, err)
@@ -661,29 +6973,29 @@ func (s *chatServiceServer) serveStartConversationJSON(ctx context.Context, resp
 		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
 		return
 	}
-	reqContent := new(StartConversationRequest)
+	reqContent := new(ListTenantsRequest)
 	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
 	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
 		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
 		return
 	}
 
-	handler := s.ChatService.StartConversation
+	handler := s.TenantService.ListTenants
 	if s.interceptor != nil {
-		handler = func(ctx context.Context, req *StartConversationRequest) (*StartConversationResponse, error) {
+		handler = func(ctx context.Context, req *ListTenantsRequest) (*ListTenantsResponse, error) {
 			resp, err := s.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*StartConversationRequest)
+					typedReq, ok := req.(*ListTenantsRequest)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*StartConversationRequest) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*ListTenantsRequest) when calling interceptor")
 					}
-					return s.ChatService.StartConversation(ctx, typedReq)
+					return s.TenantService.ListTenants(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
-				typedResp, ok := resp.(*StartConversationResponse)
+				typedResp, ok := resp.(*ListTenantsResponse)
 				if !ok {
-					return nil, twirp.InternalError("failed type assertion resp.(*StartConversationResponse) when calling interceptor")
+					return nil, twirp.InternalError("failed type assertion resp.(*ListTenantsResponse) when calling interceptor")
 				}
 				return typedResp, err
 			}
@@ -692,7 +7004,7 @@ func (s *chatServiceServer) serveStartConversationJSON(ctx context.Context, resp
 	}
 
 	// Call service method
-	var respContent *StartConversationResponse
+	var respContent *ListTenantsResponse
 	func() {
 		defer ensurePanicResponses(ctx, resp, s.hooks)
 		respContent, err = handler(ctx, reqContent)
@@ -703,7 +7015,7 @@ func (s *chatServiceServer) serveStartConversationJSON(ctx context.Context, resp
 		return
 	}
 	if respContent == nil {
-		s.writeError(ctx, resp, twirp.InternalError("received a nil *StartConversationResponse and nil error while calling StartConversation. nil responses are not supported"))
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *ListTenantsResponse and nil error while calling ListTenants. nil responses are not supported"))
 		return
 	}
 
@@ -729,9 +7041,9 @@ func (s *chatServiceServer) serveStartConversationJSON(ctx context.Context, resp
 	callResponseSent(ctx, s.hooks)
 }
 
-func (s *chatServiceServer) serveStartConversationProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+func (s *tenantServiceServer) serveListTenantsProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
 	var err error
-	ctx = ctxsetters.WithMethodName(ctx, "StartConversation")
+	ctx = ctxsetters.WithMethodName(ctx, "ListTenants")
 	ctx, err = callRequestRouted(ctx, s.hooks)
 	if err != nil {
 		s.writeError(ctx, resp, err)
@@ -743,28 +7055,28 @@ func (s *chatServiceServer) serveStartConversationProtobuf(ctx context.Context,
 		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
 		return
 	}
-	reqContent := new(StartConversationRequest)
+	reqContent := new(ListTenantsRequest)
 	if err = proto.Unmarshal(buf, reqContent); err != nil {
 		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
 		return
 	}
 
-	handler := s.ChatService.StartConversation
+	handler := s.TenantService.ListTenants
 	if s.interceptor != nil {
-		handler = func(ctx context.Context, req *StartConversationRequest) (*StartConversationResponse, error) {
+		handler = func(ctx context.Context, req *ListTenantsRequest) (*ListTenantsResponse, error) {
 			resp, err := s.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*StartConversationRequest)
+					typedReq, ok := req.(*ListTenantsRequest)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*StartConversationRequest) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*ListTenantsRequest) when calling interceptor")
 					}
-					return s.ChatService.StartConversation(ctx, typedReq)
+					return s.TenantService.ListTenants(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
-				typedResp, ok := resp.(*StartConversationResponse)
+				typedResp, ok := resp.(*ListTenantsResponse)
 				if !ok {
-					return nil, twirp.InternalError("failed type assertion resp.(*StartConversationResponse) when calling interceptor")
+					return nil, twirp.InternalError("failed type assertion resp.(*ListTenantsResponse) when calling interceptor")
 				}
 				return typedResp, err
 			}
@@ -773,7 +7085,7 @@ func (s *chatServiceServer) serveStartConversationProtobuf(ctx context.Context,
 	}
 
 	// Call service method
-	var respContent *StartConversationResponse
+	var respContent *ListTenantsResponse
 	func() {
 		defer ensurePanicResponses(ctx, resp, s.hooks)
 		respContent, err = handler(ctx, reqContent)
@@ -784,7 +7096,7 @@ func (s *chatServiceServer) serveStartConversationProtobuf(ctx context.Context,
 		return
 	}
 	if respContent == nil {
-		s.writeError(ctx, resp, twirp.InternalError("received a nil *StartConversationResponse and nil error while calling StartConversation. nil responses are not supported"))
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *ListTenantsResponse and nil error while calling ListTenants. nil responses are not supported"))
 		return
 	}
 
@@ -808,7 +7120,7 @@ func (s *chatServiceServer) serveStartConversationProtobuf(ctx context.Context,
 	callResponseSent(ctx, s.hooks)
 }
 
-func (s *chatServiceServer) serveContinueConversation(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+func (s *tenantServiceServer) serveDescribeTenant(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
 	header := req.Header.Get("Content-Type")
 	i := strings.Index(header, ";")
 	if i == -1 {
@@ -816,9 +7128,9 @@ func (s *chatServiceServer) serveContinueConversation(ctx context.Context, resp
 	}
 	switch strings.TrimSpace(strings.ToLower(header[:i])) {
 	case "application/json":
-		s.serveContinueConversationJSON(ctx, resp, req)
+		s.serveDescribeTenantJSON(ctx, resp, req)
 	case "application/protobuf":
-		s.serveContinueConversationProtobuf(ctx, resp, req)
+		s.serveDescribeTenantProtobuf(ctx, resp, req)
 	default:
 		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
 		twerr := badRouteError(msg, req.Method, req.URL.Path)
@@ -826,9 +7138,9 @@ func (s *chatServiceServer) serveContinueConversation(ctx context.Context, resp
 	}
 }
 
-func (s *chatServiceServer) serveContinueConversationJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+func (s *tenantServiceServer) serveDescribeTenantJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
 	var err error
-	ctx = ctxsetters.WithMethodName(ctx, "ContinueConversation")
+	ctx = ctxsetters.WithMethodName(ctx, "DescribeTenant")
 	ctx, err = callRequestRouted(ctx, s.hooks)
 	if err != nil {
 		s.writeError(ctx, resp, err)
@@ -841,29 +7153,29 @@ func (s *chatServiceServer) serveContinueConversationJSON(ctx context.Context, r
 		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
 		return
 	}
-	reqContent := new(ContinueConversationRequest)
+	reqContent := new(DescribeTenantRequest)
 	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
 	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
 		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
 		return
 	}
 
-	handler := s.ChatService.ContinueConversation
+	handler := s.TenantService.DescribeTenant
 	if s.interceptor != nil {
-		handler = func(ctx context.Context, req *ContinueConversationRequest) (*ContinueConversationResponse, error) {
+		handler = func(ctx context.Context, req *DescribeTenantRequest) (*DescribeTenantResponse, error) {
 			resp, err := s.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*ContinueConversationRequest)
+					typedReq, ok := req.(*DescribeTenantRequest)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*ContinueConversationRequest) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*DescribeTenantRequest) when calling interceptor")
 					}
-					return s.ChatService.ContinueConversation(ctx, typedReq)
+					return s.TenantService.DescribeTenant(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
-				typedResp, ok := resp.(*ContinueConversationResponse)
+				typedResp, ok := resp.(*DescribeTenantResponse)
 				if !ok {
-					return nil, twirp.InternalError("failed type assertion resp.(*ContinueConversationResponse) when calling interceptor")
+					return nil, twirp.InternalError("failed type assertion resp.(*DescribeTenantResponse) when calling interceptor")
 				}
 				return typedResp, err
 			}
@@ -872,7 +7184,7 @@ func (s *chatServiceServer) serveContinueConversationJSON(ctx context.Context, r
 	}
 
 	// Call service method
-	var respContent *ContinueConversationResponse
+	var respContent *DescribeTenantResponse
 	func() {
 		defer ensurePanicResponses(ctx, resp, s.hooks)
 		respContent, err = handler(ctx, reqContent)
@@ -883,7 +7195,7 @@ func (s *chatServiceServer) serveContinueConversationJSON(ctx context.Context, r
 		return
 	}
 	if respContent == nil {
-		s.writeError(ctx, resp, twirp.InternalError("received a nil *ContinueConversationResponse and nil error while calling ContinueConversation. nil responses are not supported"))
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *DescribeTenantResponse and nil error while calling DescribeTenant. nil responses are not supported"))
 		return
 	}
 
@@ -909,9 +7221,9 @@ func (s *chatServiceServer) serveContinueConversationJSON(ctx context.Context, r
 	callResponseSent(ctx, s.hooks)
 }
 
-func (s *chatServiceServer) serveContinueConversationProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+func (s *tenantServiceServer) serveDescribeTenantProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
 	var err error
-	ctx = ctxsetters.WithMethodName(ctx, "ContinueConversation")
+	ctx = ctxsetters.WithMethodName(ctx, "DescribeTenant")
 	ctx, err = callRequestRouted(ctx, s.hooks)
 	if err != nil {
 		s.writeError(ctx, resp, err)
@@ -923,28 +7235,28 @@ func (s *chatServiceServer) serveContinueConversationProtobuf(ctx context.Contex
 		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
 		return
 	}
-	reqContent := new(ContinueConversationRequest)
+	reqContent := new(DescribeTenantRequest)
 	if err = proto.Unmarshal(buf, reqContent); err != nil {
 		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
 		return
 	}
 
-	handler := s.ChatService.ContinueConversation
+	handler := s.TenantService.DescribeTenant
 	if s.interceptor != nil {
-		handler = func(ctx context.Context, req *ContinueConversationRequest) (*ContinueConversationResponse, error) {
+		handler = func(ctx context.Context, req *DescribeTenantRequest) (*DescribeTenantResponse, error) {
 			resp, err := s.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*ContinueConversationRequest)
+					typedReq, ok := req.(*DescribeTenantRequest)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*ContinueConversationRequest) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*DescribeTenantRequest) when calling interceptor")
 					}
-					return s.ChatService.ContinueConversation(ctx, typedReq)
+					return s.TenantService.DescribeTenant(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
-				typedResp, ok := resp.(*ContinueConversationResponse)
+				typedResp, ok := resp.(*DescribeTenantResponse)
 				if !ok {
-					return nil, twirp.InternalError("failed type assertion resp.(*ContinueConversationResponse) when calling interceptor")
+					return nil, twirp.InternalError("failed type assertion resp.(*DescribeTenantResponse) when calling interceptor")
 				}
 				return typedResp, err
 			}
@@ -953,7 +7265,7 @@ func (s *chatServiceServer) serveContinueConversationProtobuf(ctx context.Contex
 	}
 
 	// Call service method
-	var respContent *ContinueConversationResponse
+	var respContent *DescribeTenantResponse
 	func() {
 		defer ensurePanicResponses(ctx, resp, s.hooks)
 		respContent, err = handler(ctx, reqContent)
@@ -964,7 +7276,7 @@ func (s *chatServiceServer) serveContinueConversationProtobuf(ctx context.Contex
 		return
 	}
 	if respContent == nil {
-		s.writeError(ctx, resp, twirp.InternalError("received a nil *ContinueConversationResponse and nil error while calling ContinueConversation. nil responses are not supported"))
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *DescribeTenantResponse and nil error while calling DescribeTenant. nil responses are not supported"))
 		return
 	}
 
@@ -988,7 +7300,7 @@ func (s *chatServiceServer) serveContinueConversationProtobuf(ctx context.Contex
 	callResponseSent(ctx, s.hooks)
 }
 
-func (s *chatServiceServer) serveListConversations(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+func (s *tenantServiceServer) serveUpdateTenant(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
 	header := req.Header.Get("Content-Type")
 	i := strings.Index(header, ";")
 	if i == -1 {
@@ -996,9 +7308,9 @@ func (s *chatServiceServer) serveListConversations(ctx context.Context, resp htt
 	}
 	switch strings.TrimSpace(strings.ToLower(header[:i])) {
 	case "application/json":
-		s.serveListConversationsJSON(ctx, resp, req)
+		s.serveUpdateTenantJSON(ctx, resp, req)
 	case "application/protobuf":
-		s.serveListConversationsProtobuf(ctx, resp, req)
+		s.serveUpdateTenantProtobuf(ctx, resp, req)
 	default:
 		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
 		twerr := badRouteError(msg, req.Method, req.URL.Path)
@@ -1006,9 +7318,9 @@ func (s *chatServiceServer) serveListConversations(ctx context.Context, resp htt
 	}
 }
 
-func (s *chatServiceServer) serveListConversationsJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+func (s *tenantServiceServer) serveUpdateTenantJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
 	var err error
-	ctx = ctxsetters.WithMethodName(ctx, "ListConversations")
+	ctx = ctxsetters.WithMethodName(ctx, "UpdateTenant")
 	ctx, err = callRequestRouted(ctx, s.hooks)
 	if err != nil {
 		s.writeError(ctx, resp, err)
@@ -1021,29 +7333,29 @@ func (s *chatServiceServer) serveListConversationsJSON(ctx context.Context, resp
 		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
 		return
 	}
-	reqContent := new(ListConversationsRequest)
+	reqContent := new(UpdateTenantRequest)
 	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
 	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
 		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
 		return
 	}
 
-	handler := s.ChatService.ListConversations
+	handler := s.TenantService.UpdateTenant
 	if s.interceptor != nil {
-		handler = func(ctx context.Context, req *ListConversationsRequest) (*ListConversationsResponse, error) {
+		handler = func(ctx context.Context, req *UpdateTenantRequest) (*UpdateTenantResponse, error) {
 			resp, err := s.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*ListConversationsRequest)
+					typedReq, ok := req.(*UpdateTenantRequest)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*ListConversationsRequest) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*UpdateTenantRequest) when calling interceptor")
 					}
-					return s.ChatService.ListConversations(ctx, typedReq)
+					return s.TenantService.UpdateTenant(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
-				typedResp, ok := resp.(*ListConversationsResponse)
+				typedResp, ok := resp.(*UpdateTenantResponse)
 				if !ok {
-					return nil, twirp.InternalError("failed type assertion resp.(*ListConversationsResponse) when calling interceptor")
+					return nil, twirp.InternalError("failed type assertion resp.(*UpdateTenantResponse) when calling interceptor")
 				}
 				return typedResp, err
 			}
@@ -1052,7 +7364,7 @@ func (s *chatServiceServer) serveListConversationsJSON(ctx context.Context, resp
 	}
 
 	// Call service method
-	var respContent *ListConversationsResponse
+	var respContent *UpdateTenantResponse
 	func() {
 		defer ensurePanicResponses(ctx, resp, s.hooks)
 		respContent, err = handler(ctx, reqContent)
@@ -1063,7 +7375,7 @@ func (s *chatServiceServer) serveListConversationsJSON(ctx context.Context, resp
 		return
 	}
 	if respContent == nil {
-		s.writeError(ctx, resp, twirp.InternalError("received a nil *ListConversationsResponse and nil error while calling ListConversations. nil responses are not supported"))
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *UpdateTenantResponse and nil error while calling UpdateTenant. nil responses are not supported"))
 		return
 	}
 
@@ -1089,9 +7401,9 @@ func (s *chatServiceServer) serveListConversationsJSON(ctx context.Context, resp
 	callResponseSent(ctx, s.hooks)
 }
 
-func (s *chatServiceServer) serveListConversationsProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+func (s *tenantServiceServer) serveUpdateTenantProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
 	var err error
-	ctx = ctxsetters.WithMethodName(ctx, "ListConversations")
+	ctx = ctxsetters.WithMethodName(ctx, "UpdateTenant")
 	ctx, err = callRequestRouted(ctx, s.hooks)
 	if err != nil {
 		s.writeError(ctx, resp, err)
@@ -1103,28 +7415,28 @@ func (s *chatServiceServer) serveListConversationsProtobuf(ctx context.Context,
 		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
 		return
 	}
-	reqContent := new(ListConversationsRequest)
+	reqContent := new(UpdateTenantRequest)
 	if err = proto.Unmarshal(buf, reqContent); err != nil {
 		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
 		return
 	}
 
-	handler := s.ChatService.ListConversations
+	handler := s.TenantService.UpdateTenant
 	if s.interceptor != nil {
-		handler = func(ctx context.Context, req *ListConversationsRequest) (*ListConversationsResponse, error) {
+		handler = func(ctx context.Context, req *UpdateTenantRequest) (*UpdateTenantResponse, error) {
 			resp, err := s.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*ListConversationsRequest)
+					typedReq, ok := req.(*UpdateTenantRequest)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*ListConversationsRequest) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*UpdateTenantRequest) when calling interceptor")
 					}
-					return s.ChatService.ListConversations(ctx, typedReq)
+					return s.TenantService.UpdateTenant(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
-				typedResp, ok := resp.(*ListConversationsResponse)
+				typedResp, ok := resp.(*UpdateTenantResponse)
 				if !ok {
-					return nil, twirp.InternalError("failed type assertion resp.(*ListConversationsResponse) when calling interceptor")
+					return nil, twirp.InternalError("failed type assertion resp.(*UpdateTenantResponse) when calling interceptor")
 				}
 				return typedResp, err
 			}
@@ -1133,7 +7445,7 @@ func (s *chatServiceServer) serveListConversationsProtobuf(ctx context.Context,
 	}
 
 	// Call service method
-	var respContent *ListConversationsResponse
+	var respContent *UpdateTenantResponse
 	func() {
 		defer ensurePanicResponses(ctx, resp, s.hooks)
 		respContent, err = handler(ctx, reqContent)
@@ -1144,7 +7456,7 @@ func (s *chatServiceServer) serveListConversationsProtobuf(ctx context.Context,
 		return
 	}
 	if respContent == nil {
-		s.writeError(ctx, resp, twirp.InternalError("received a nil *ListConversationsResponse and nil error while calling ListConversations. nil responses are not supported"))
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *UpdateTenantResponse and nil error while calling UpdateTenant. nil responses are not supported"))
 		return
 	}
 
@@ -1168,7 +7480,7 @@ func (s *chatServiceServer) serveListConversationsProtobuf(ctx context.Context,
 	callResponseSent(ctx, s.hooks)
 }
 
-func (s *chatServiceServer) serveDescribeConversation(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+func (s *tenantServiceServer) serveDeleteTenant(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
 	header := req.Header.Get("Content-Type")
 	i := strings.Index(header, ";")
 	if i == -1 {
@@ -1176,9 +7488,9 @@ func (s *chatServiceServer) serveDescribeConversation(ctx context.Context, resp
 	}
 	switch strings.TrimSpace(strings.ToLower(header[:i])) {
 	case "application/json":
-		s.serveDescribeConversationJSON(ctx, resp, req)
+		s.serveDeleteTenantJSON(ctx, resp, req)
 	case "application/protobuf":
-		s.serveDescribeConversationProtobuf(ctx, resp, req)
+		s.serveDeleteTenantProtobuf(ctx, resp, req)
 	default:
 		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
 		twerr := badRouteError(msg, req.Method, req.URL.Path)
@@ -1186,9 +7498,9 @@ func (s *chatServiceServer) serveDescribeConversation(ctx context.Context, resp
 	}
 }
 
-func (s *chatServiceServer) serveDescribeConversationJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+func (s *tenantServiceServer) serveDeleteTenantJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
 	var err error
-	ctx = ctxsetters.WithMethodName(ctx, "DescribeConversation")
+	ctx = ctxsetters.WithMethodName(ctx, "DeleteTenant")
 	ctx, err = callRequestRouted(ctx, s.hooks)
 	if err != nil {
 		s.writeError(ctx, resp, err)
@@ -1201,29 +7513,29 @@ func (s *chatServiceServer) serveDescribeConversationJSON(ctx context.Context, r
 		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
 		return
 	}
-	reqContent := new(DescribeConversationRequest)
+	reqContent := new(DeleteTenantRequest)
 	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
 	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
 		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
 		return
 	}
 
-	handler := s.ChatService.DescribeConversation
+	handler := s.TenantService.DeleteTenant
 	if s.interceptor != nil {
-		handler = func(ctx context.Context, req *DescribeConversationRequest) (*DescribeConversationResponse, error) {
+		handler = func(ctx context.Context, req *DeleteTenantRequest) (*DeleteTenantResponse, error) {
 			resp, err := s.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*DescribeConversationRequest)
+					typedReq, ok := req.(*DeleteTenantRequest)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*DescribeConversationRequest) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*DeleteTenantRequest) when calling interceptor")
 					}
-					return s.ChatService.DescribeConversation(ctx, typedReq)
+					return s.TenantService.DeleteTenant(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
-				typedResp, ok := resp.(*DescribeConversationResponse)
+				typedResp, ok := resp.(*DeleteTenantResponse)
 				if !ok {
-					return nil, twirp.InternalError("failed type assertion resp.(*DescribeConversationResponse) when calling interceptor")
+					return nil, twirp.InternalError("failed type assertion resp.(*DeleteTenantResponse) when calling interceptor")
 				}
 				return typedResp, err
 			}
@@ -1232,7 +7544,7 @@ func (s *chatServiceServer) serveDescribeConversationJSON(ctx context.Context, r
 	}
 
 	// Call service method
-	var respContent *DescribeConversationResponse
+	var respContent *DeleteTenantResponse
 	func() {
 		defer ensurePanicResponses(ctx, resp, s.hooks)
 		respContent, err = handler(ctx, reqContent)
@@ -1243,7 +7555,7 @@ func (s *chatServiceServer) serveDescribeConversationJSON(ctx context.Context, r
 		return
 	}
 	if respContent == nil {
-		s.writeError(ctx, resp, twirp.InternalError("received a nil *DescribeConversationResponse and nil error while calling DescribeConversation. nil responses are not supported"))
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *DeleteTenantResponse and nil error while calling DeleteTenant. nil responses are not supported"))
 		return
 	}
 
@@ -1269,9 +7581,9 @@ func (s *chatServiceServer) serveDescribeConversationJSON(ctx context.Context, r
 	callResponseSent(ctx, s.hooks)
 }
 
-func (s *chatServiceServer) serveDescribeConversationProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+func (s *tenantServiceServer) serveDeleteTenantProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
 	var err error
-	ctx = ctxsetters.WithMethodName(ctx, "DescribeConversation")
+	ctx = ctxsetters.WithMethodName(ctx, "DeleteTenant")
 	ctx, err = callRequestRouted(ctx, s.hooks)
 	if err != nil {
 		s.writeError(ctx, resp, err)
@@ -1283,28 +7595,28 @@ func (s *chatServiceServer) serveDescribeConversationProtobuf(ctx context.Contex
 		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
 		return
 	}
-	reqContent := new(DescribeConversationRequest)
+	reqContent := new(DeleteTenantRequest)
 	if err = proto.Unmarshal(buf, reqContent); err != nil {
 		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
 		return
 	}
 
-	handler := s.ChatService.DescribeConversation
+	handler := s.TenantService.DeleteTenant
 	if s.interceptor != nil {
-		handler = func(ctx context.Context, req *DescribeConversationRequest) (*DescribeConversationResponse, error) {
+		handler = func(ctx context.Context, req *DeleteTenantRequest) (*DeleteTenantResponse, error) {
 			resp, err := s.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*DescribeConversationRequest)
+					typedReq, ok := req.(*DeleteTenantRequest)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*DescribeConversationRequest) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*DeleteTenantRequest) when calling interceptor")
 					}
-					return s.ChatService.DescribeConversation(ctx, typedReq)
+					return s.TenantService.DeleteTenant(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
-				typedResp, ok := resp.(*DescribeConversationResponse)
+				typedResp, ok := resp.(*DeleteTenantResponse)
 				if !ok {
-					return nil, twirp.InternalError("failed type assertion resp.(*DescribeConversationResponse) when calling interceptor")
+					return nil, twirp.InternalError("failed type assertion resp.(*DeleteTenantResponse) when calling interceptor")
 				}
 				return typedResp, err
 			}
@@ -1313,7 +7625,7 @@ func (s *chatServiceServer) serveDescribeConversationProtobuf(ctx context.Contex
 	}
 
 	// Call service method
-	var respContent *DescribeConversationResponse
+	var respContent *DeleteTenantResponse
 	func() {
 		defer ensurePanicResponses(ctx, resp, s.hooks)
 		respContent, err = handler(ctx, reqContent)
@@ -1324,7 +7636,7 @@ func (s *chatServiceServer) serveDescribeConversationProtobuf(ctx context.Contex
 		return
 	}
 	if respContent == nil {
-		s.writeError(ctx, resp, twirp.InternalError("received a nil *DescribeConversationResponse and nil error while calling DescribeConversation. nil responses are not supported"))
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *DeleteTenantResponse and nil error while calling DeleteTenant. nil responses are not supported"))
 		return
 	}
 
@@ -1348,19 +7660,19 @@ func (s *chatServiceServer) serveDescribeConversationProtobuf(ctx context.Contex
 	callResponseSent(ctx, s.hooks)
 }
 
-func (s *chatServiceServer) ServiceDescriptor() ([]byte, int) {
-	return twirpFileDescriptor0, 0
+func (s *tenantServiceServer) ServiceDescriptor() ([]byte, int) {
+	return twirpFileDescriptor0, 2
 }
 
-func (s *chatServiceServer) ProtocGenTwirpVersion() string {
+func (s *tenantServiceServer) ProtocGenTwirpVersion() string {
 	return "v8.1.3"
 }
 
 // PathPrefix returns the base service path, in the form: "/<prefix>/<package>.<Service>/"
 // that is everything in a Twirp route except for the <Method>. This can be used for routing,
 // for example to identify the requests that are targeted to this service in a mux.
-func (s *chatServiceServer) PathPrefix() string {
-	return baseServicePath(s.pathPrefix, "acai.chat", "ChatService")
+func (s *tenantServiceServer) PathPrefix() string {
+	return baseServicePath(s.pathPrefix, "acai.chat", "TenantService")
 }
 
 // =====
@@ -1929,39 +8241,162 @@ func callClientError(ctx context.Context, h *twirp.ClientHooks, err twirp.Error)
 }
 
 var twirpFileDescriptor0 = []byte{
-	// 529 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x94, 0x51, 0x6b, 0xd3, 0x50,
-	0x14, 0xc7, 0x4d, 0xda, 0xd9, 0xe5, 0x64, 0xad, 0xdd, 0xa5, 0x60, 0x96, 0x15, 0x56, 0xa2, 0xb8,
-	0x3e, 0x48, 0x2a, 0x75, 0x0f, 0xc2, 0xf0, 0x61, 0x56, 0x85, 0xa1, 0x56, 0x48, 0x3a, 0x84, 0x09,
-	0xd3, 0x34, 0xbb, 0x66, 0x17, 0xd2, 0xdc, 0x98, 0x7b, 0x3b, 0xf0, 0xd3, 0xec, 0x83, 0xfa, 0x22,
-	0x4d, 0x6e, 0xe3, 0xbd, 0x34, 0xa9, 0x8a, 0x8f, 0xe7, 0xe4, 0x7f, 0xcf, 0xf9, 0xfd, 0xcf, 0x39,
-	0x04, 0x3a, 0x59, 0x1a, 0x8e, 0xc2, 0x9b, 0x80, 0xbb, 0x69, 0x46, 0x39, 0x45, 0x46, 0x10, 0x06,
-	0xc4, 0x5d, 0x25, 0xec, 0xa3, 0x88, 0xd2, 0x28, 0xc6, 0xa3, 0xfc, 0xc3, 0x7c, 0xf9, 0x6d, 0xc4,
-	0xc9, 0x02, 0x33, 0x1e, 0x2c, 0xd2, 0x42, 0xeb, 0xfc, 0xd4, 0x61, 0x6f, 0x42, 0x93, 0x5b, 0x9c,
-	0xb1, 0x80, 0x13, 0x9a, 0xa0, 0x0e, 0xe8, 0xe4, 0xda, 0xd2, 0x06, 0xda, 0xd0, 0xf0, 0x74, 0x72,
-	0x8d, 0x7a, 0xb0, 0xc3, 0x09, 0x8f, 0xb1, 0xa5, 0xe7, 0xa9, 0x22, 0x40, 0x2f, 0xc0, 0x28, 0x2b,
-	0x59, 0x8d, 0x81, 0x36, 0x34, 0xc7, 0xb6, 0x5b, 0xf4, 0x72, 0xd7, 0xbd, 0xdc, 0xd9, 0x5a, 0xe1,
-	0xfd, 0x16, 0xa3, 0x53, 0xd8, 0x5d, 0x60, 0xc6, 0x82, 0x08, 0x33, 0xab, 0x39, 0x68, 0x0c, 0xcd,
-	0xf1, 0x91, 0x5b, 0xf2, 0xba, 0x32, 0x8a, 0xfb, 0xa1, 0xd0, 0x79, 0xe5, 0x03, 0xfb, 0x4e, 0x83,
-	0x96, 0xc8, 0x6e, 0x80, 0x3e, 0x83, 0x66, 0x46, 0x05, 0x67, 0x67, 0xdc, 0xaf, 0x2b, 0xea, 0xd1,
-	0x18, 0x7b, 0xb9, 0x12, 0x59, 0xd0, 0x0a, 0x69, 0xc2, 0x71, 0xc2, 0x73, 0x0b, 0x86, 0xb7, 0x0e,
-	0x55, 0x7b, 0xcd, 0x7f, 0xb0, 0xe7, 0x3c, 0x85, 0xe6, 0xaa, 0x03, 0x32, 0xa1, 0x75, 0x31, 0x7d,
-	0x37, 0xfd, 0xf8, 0x69, 0xda, 0xbd, 0x87, 0x76, 0xa1, 0x79, 0xe1, 0xbf, 0xf1, 0xba, 0x1a, 0x6a,
-	0x83, 0x71, 0xe6, 0xfb, 0xe7, 0xfe, 0xec, 0x6c, 0x3a, 0xeb, 0xea, 0xce, 0x09, 0x58, 0x3e, 0x0f,
-	0x32, 0x2e, 0x13, 0x7a, 0xf8, 0xfb, 0x12, 0x33, 0xbe, 0xa2, 0x13, 0xbe, 0x85, 0xc9, 0x75, 0xe8,
-	0xa4, 0x70, 0x50, 0xf1, 0x8a, 0xa5, 0x34, 0x61, 0x18, 0x1d, 0xc3, 0x83, 0x50, 0xca, 0x7f, 0x29,
-	0x67, 0xd4, 0x91, 0xd3, 0xe7, 0x75, 0x8b, 0xed, 0xc1, 0x4e, 0x86, 0xd3, 0xf8, 0x87, 0x98, 0x48,
-	0x11, 0x38, 0x5f, 0xe1, 0x70, 0x42, 0x13, 0x4e, 0x92, 0x25, 0xae, 0x42, 0xfd, 0xeb, 0x9e, 0x92,
-	0x27, 0x5d, 0xf5, 0x74, 0x02, 0xfd, 0xea, 0x0e, 0xc2, 0x56, 0xc9, 0xa5, 0xc9, 0x5c, 0x36, 0x58,
-	0xef, 0x09, 0x53, 0x06, 0xc1, 0x04, 0x94, 0x73, 0x09, 0x07, 0x15, 0xdf, 0x44, 0xb9, 0x97, 0xd0,
-	0x96, 0xd1, 0x98, 0xa5, 0xe5, 0xa7, 0xf8, 0xb0, 0xe6, 0x6a, 0x3c, 0x55, 0xed, 0xbc, 0x85, 0xc3,
-	0xd7, 0x98, 0x85, 0x19, 0x99, 0xff, 0xd7, 0x3c, 0x9c, 0xcf, 0xd0, 0xaf, 0xae, 0x23, 0x30, 0x4f,
-	0x61, 0x4f, 0x7e, 0x91, 0x57, 0xd9, 0x42, 0xa9, 0x88, 0xc7, 0x77, 0x0d, 0x30, 0x27, 0x37, 0x01,
-	0xf7, 0x71, 0x76, 0x4b, 0x42, 0x8c, 0xae, 0x60, 0x7f, 0xe3, 0x6c, 0xd0, 0x23, 0xa9, 0x56, 0xdd,
-	0x29, 0xda, 0x8f, 0xb7, 0x8b, 0x04, 0x6c, 0x04, 0xbd, 0xaa, 0x15, 0xa2, 0x27, 0x2a, 0x6e, 0xdd,
-	0x15, 0xd9, 0xc7, 0x7f, 0xd4, 0x89, 0x46, 0x57, 0xb0, 0xbf, 0xb1, 0x59, 0xc5, 0x48, 0xdd, 0x4d,
-	0x28, 0x46, 0xea, 0x8f, 0x23, 0x82, 0x5e, 0xd5, 0x56, 0x14, 0x23, 0x5b, 0xd6, 0xaf, 0x18, 0xd9,
-	0xb6, 0xde, 0x57, 0xed, 0x4b, 0x93, 0x24, 0x1c, 0x67, 0x49, 0x10, 0x8f, 0xd2, 0xf9, 0xfc, 0x7e,
-	0xfe, 0x6b, 0x79, 0xfe, 0x2b, 0x00, 0x00, 0xff, 0xff, 0x59, 0x83, 0x17, 0xf4, 0xd0, 0x05, 0x00,
-	0x00,
+	// 2502 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x59, 0x5f, 0x6f, 0xdb, 0xd6,
+	0x15, 0x0f, 0x45, 0xc9, 0x92, 0x8e, 0x64, 0x59, 0xb9, 0x96, 0x6d, 0x9a, 0x71, 0x62, 0x99, 0xf9,
+	0xbf, 0xb4, 0x4e, 0xe7, 0xa5, 0xd8, 0x8a, 0xae, 0x68, 0x65, 0x4b, 0xc9, 0xbc, 0xba, 0xb6, 0x47,
+	0xcb, 0xed, 0xd0, 0x0d, 0x21, 0x68, 0xf1, 0x46, 0x61, 0x23, 0x91, 0x1a, 0x49, 0x39, 0x71, 0x1e,
+	0xb7, 0x97, 0x65, 0x1f, 0x61, 0x5f, 0xa0, 0x2f, 0xc3, 0x80, 0x3d, 0x6d, 0x03, 0xf6, 0x3e, 0xa0,
+	0xc3, 0x86, 0x7d, 0x8f, 0x7d, 0x89, 0xe1, 0xfe, 0x21, 0x45, 0x4a, 0x24, 0x65, 0xc5, 0xee, 0x43,
+	0xde, 0xc4, 0x73, 0x7f, 0xe7, 0xdc, 0xf3, 0xf7, 0xde, 0x73, 0x8f, 0xa0, 0xe2, 0x0c, 0x3a, 0x0f,
+	0x3b, 0xcf, 0x75, 0x6f, 0x73, 0xe0, 0xd8, 0x9e, 0x8d, 0x8a, 0x7a, 0x47, 0x37, 0x37, 0x09, 0x41,
+	0xbe, 0xd1, 0xb5, 0xed, 0x6e, 0x0f, 0x3f, 0xa4, 0x0b, 0x27, 0xc3, 0x67, 0x0f, 0x8d, 0xa1, 0xa3,
+	0x7b, 0xa6, 0x6d, 0x31, 0xa8, 0xbc, 0x3e, 0xbe, 0xee, 0x99, 0x7d, 0xec, 0x7a, 0x7a, 0x7f, 0xc0,
+	0x00, 0xca, 0x9f, 0x4b, 0x50, 0xde, 0xb1, 0xad, 0x53, 0xec, 0xb8, 0x94, 0x0f, 0x55, 0x20, 0x63,
+	0x1a, 0x92, 0x50, 0x17, 0xee, 0x15, 0xd5, 0x8c, 0x69, 0xa0, 0x1a, 0xe4, 0x3c, 0xd3, 0xeb, 0x61,
+	0x29, 0x43, 0x49, 0xec, 0x03, 0xfd, 0x04, 0x8a, 0x81, 0x24, 0x49, 0xac, 0x0b, 0xf7, 0x4a, 0x5b,
+	0xf2, 0x26, 0xdb, 0x6b, 0xd3, 0xdf, 0x6b, 0xb3, 0xed, 0x23, 0xd4, 0x11, 0x18, 0x7d, 0x0c, 0x85,
+	0x3e, 0x76, 0x5d, 0xbd, 0x8b, 0x5d, 0x29, 0x5b, 0x17, 0xef, 0x95, 0xb6, 0xd6, 0x37, 0x03, 0x7b,
+	0x36, 0xc3, 0xaa, 0x6c, 0x7e, 0xc1, 0x70, 0x6a, 0xc0, 0x80, 0x64, 0x28, 0xe8, 0x4e, 0xe7, 0xb9,
+	0x79, 0x8a, 0x0d, 0x29, 0x57, 0x17, 0xee, 0x15, 0xd4, 0xe0, 0x1b, 0x5d, 0x83, 0xe2, 0x40, 0x77,
+	0xb0, 0xe5, 0x69, 0xa6, 0x21, 0xcd, 0x51, 0x65, 0x0b, 0x8c, 0xb0, 0x6b, 0xa0, 0x1f, 0xc2, 0xd2,
+	0x33, 0xdb, 0x79, 0xa1, 0x0d, 0x6c, 0xd3, 0xf2, 0x34, 0x2e, 0x8f, 0x00, 0xf3, 0x14, 0x88, 0xc8,
+	0xe2, 0x21, 0x59, 0xe3, 0x7b, 0xee, 0x1a, 0x44, 0x51, 0x17, 0x7b, 0x9e, 0x69, 0x75, 0x5d, 0xa9,
+	0x50, 0x17, 0x52, 0x14, 0x3d, 0xe2, 0x30, 0x35, 0x60, 0x40, 0xd7, 0x01, 0x06, 0xd8, 0x71, 0x6d,
+	0x4b, 0x27, 0x9b, 0x14, 0xe9, 0x26, 0x45, 0x4e, 0xd9, 0x35, 0xd0, 0x1d, 0xc8, 0x0d, 0xc9, 0x36,
+	0x12, 0x50, 0xc1, 0xd5, 0x90, 0xe0, 0x63, 0x6a, 0x32, 0x5b, 0x46, 0xab, 0x50, 0xb0, 0x5f, 0x5a,
+	0xd8, 0x21, 0x42, 0x4a, 0x54, 0x48, 0x9e, 0x7e, 0xef, 0x52, 0x73, 0x3d, 0x6c, 0xe9, 0xcc, 0xdc,
+	0x32, 0x33, 0x97, 0x11, 0x76, 0x0d, 0x74, 0x13, 0xe6, 0x69, 0x9c, 0xb4, 0x01, 0xb6, 0x0c, 0xd3,
+	0xea, 0x4a, 0xf3, 0xd4, 0x59, 0x65, 0x4a, 0x3c, 0x64, 0x34, 0xf4, 0x00, 0xae, 0xea, 0x2f, 0x75,
+	0x93, 0x28, 0xac, 0xe9, 0x83, 0x81, 0x63, 0x9f, 0xea, 0x3d, 0xa9, 0x42, 0x81, 0x55, 0x7f, 0xa1,
+	0xc1, 0xe9, 0xf2, 0x1f, 0x33, 0x50, 0x68, 0xdb, 0x76, 0x6f, 0x47, 0xef, 0xf5, 0x26, 0x72, 0x04,
+	0x41, 0xd6, 0xd2, 0xfb, 0x7e, 0x8a, 0xd0, 0xdf, 0x68, 0x0d, 0x8a, 0xba, 0xd3, 0x1d, 0xf6, 0xb1,
+	0xe5, 0xb9, 0x34, 0x43, 0x8a, 0xea, 0x88, 0x80, 0x96, 0x61, 0xce, 0xc1, 0xee, 0xb0, 0xe7, 0x49,
+	0x59, 0xba, 0xc4, 0xbf, 0x48, 0xb6, 0x61, 0xc7, 0xb1, 0x1d, 0x1a, 0xdd, 0xa2, 0xca, 0x3e, 0xd0,
+	0x87, 0x50, 0xf0, 0xf3, 0x9a, 0x46, 0xb6, 0xb4, 0xb5, 0x3a, 0x91, 0x6c, 0x4d, 0x0e, 0x50, 0x03,
+	0x28, 0xfa, 0x29, 0x14, 0x02, 0xbb, 0x48, 0x9c, 0x2b, 0x5b, 0xf5, 0xa4, 0x54, 0xf3, 0xed, 0x54,
+	0x03, 0x0e, 0x74, 0x1f, 0xaa, 0x0e, 0xfe, 0x06, 0x77, 0xc8, 0xba, 0xe6, 0x60, 0xdd, 0xb5, 0x2d,
+	0x9a, 0x07, 0x45, 0x75, 0x21, 0xa0, 0xab, 0x94, 0x2c, 0x1b, 0x50, 0xe1, 0x79, 0xf3, 0x25, 0x76,
+	0x5c, 0xb2, 0xb5, 0x04, 0xf9, 0x8e, 0x6d, 0x79, 0xd8, 0xf2, 0xb8, 0x9b, 0xfc, 0xcf, 0x68, 0xe5,
+	0x64, 0x66, 0xa8, 0x1c, 0xf9, 0xbb, 0x0c, 0xe4, 0xf9, 0x36, 0x13, 0x11, 0xf8, 0x00, 0xb2, 0x8e,
+	0xcd, 0x8b, 0xb4, 0xb2, 0xb5, 0x96, 0x64, 0xa6, 0x6a, 0xf7, 0xb0, 0x4a, 0x91, 0x61, 0x0d, 0xc5,
+	0x14, 0x0d, 0xb3, 0xb3, 0xd4, 0xf6, 0x27, 0x50, 0xf4, 0x6c, 0xbb, 0xa7, 0x75, 0xf4, 0x5e, 0x8f,
+	0x46, 0xb0, 0x94, 0xec, 0x71, 0x3f, 0x99, 0xd4, 0x82, 0xc7, 0x7f, 0xa1, 0x6d, 0x28, 0x9c, 0x32,
+	0xff, 0xb9, 0xd2, 0x1c, 0x3d, 0x1a, 0xee, 0x4c, 0x39, 0x1a, 0xb8, 0xbb, 0xd5, 0x80, 0x6f, 0x54,
+	0x59, 0xf9, 0xd4, 0xca, 0x52, 0xf6, 0x20, 0x4b, 0x9c, 0x81, 0x4a, 0x90, 0x3f, 0xde, 0xff, 0x7c,
+	0xff, 0xe0, 0xab, 0xfd, 0xea, 0x15, 0x54, 0x80, 0xec, 0xf1, 0x51, 0x4b, 0xad, 0x0a, 0x68, 0x1e,
+	0x8a, 0x8d, 0xa3, 0xa3, 0xdd, 0xa3, 0x76, 0x63, 0xbf, 0x5d, 0xcd, 0x90, 0xcf, 0xf6, 0xc1, 0xc1,
+	0x9e, 0xb6, 0xd3, 0xd8, 0xdb, 0xab, 0x8a, 0x68, 0x01, 0x4a, 0xf4, 0x53, 0x6d, 0x1d, 0x1d, 0xef,
+	0xb5, 0xab, 0x59, 0xc5, 0x84, 0x82, 0x9f, 0x41, 0x68, 0x15, 0x96, 0x1a, 0x87, 0x87, 0xea, 0xc1,
+	0x97, 0x8d, 0x3d, 0x6d, 0xff, 0xa0, 0xad, 0xa9, 0xad, 0x5f, 0x1c, 0xef, 0xaa, 0xad, 0x66, 0xf5,
+	0x0a, 0xaa, 0x41, 0x35, 0x58, 0x3a, 0x6c, 0xed, 0x37, 0x77, 0xf7, 0x9f, 0x54, 0x05, 0xb4, 0x04,
+	0x57, 0x03, 0x2a, 0xfb, 0xd1, 0x6a, 0x56, 0x33, 0x11, 0xb2, 0xda, 0xfa, 0x79, 0x6b, 0xa7, 0xdd,
+	0x6a, 0x56, 0x45, 0xe5, 0x77, 0x02, 0xe4, 0xa8, 0x25, 0xa4, 0x56, 0xfa, 0xb6, 0x81, 0x7b, 0x3c,
+	0x0d, 0xd8, 0x07, 0x29, 0xfd, 0x81, 0x63, 0xf7, 0x07, 0x9e, 0xe6, 0xd9, 0x2f, 0xb0, 0xe5, 0xd2,
+	0x94, 0x10, 0xd5, 0x32, 0x23, 0xb6, 0x29, 0x8d, 0x94, 0x7e, 0xc7, 0xee, 0x0f, 0x7a, 0x98, 0x26,
+	0x37, 0x07, 0x8a, 0x14, 0x58, 0x1d, 0x2d, 0x70, 0x30, 0x82, 0x6c, 0xc7, 0x76, 0x59, 0xa5, 0x0a,
+	0x2a, 0xfd, 0xad, 0x7c, 0x2b, 0x40, 0x2d, 0xee, 0x08, 0x24, 0xdb, 0xbb, 0x67, 0xae, 0x87, 0xfb,
+	0x1a, 0xdb, 0x90, 0x2b, 0x57, 0x66, 0xc4, 0x43, 0x4a, 0x1b, 0x69, 0x9e, 0x09, 0x6b, 0x7e, 0x1b,
+	0x4a, 0x1e, 0xee, 0x0f, 0xb0, 0xa3, 0x7b, 0x43, 0x07, 0x53, 0x75, 0x84, 0x9f, 0x5d, 0x51, 0xc3,
+	0xc4, 0xdf, 0x0b, 0x02, 0x39, 0x5a, 0xfb, 0xfa, 0x2b, 0x5f, 0x69, 0xa2, 0x54, 0x4e, 0x2d, 0xf6,
+	0xf5, 0x57, 0x4c, 0xdb, 0xed, 0x0a, 0x94, 0xb5, 0x10, 0x87, 0xf2, 0x77, 0x01, 0xa4, 0x23, 0x4f,
+	0x77, 0xbc, 0xb0, 0xba, 0x2a, 0xfe, 0xcd, 0x10, 0xbb, 0x1e, 0x29, 0x02, 0x7e, 0x17, 0xf8, 0x65,
+	0xca, 0x3f, 0x23, 0xa7, 0x7f, 0xe6, 0x62, 0xa7, 0xbf, 0x38, 0x7e, 0xfa, 0xdf, 0x85, 0x05, 0xd3,
+	0xc0, 0xfd, 0x81, 0xed, 0x61, 0xab, 0x73, 0xa6, 0xbd, 0xc0, 0x67, 0xfc, 0x14, 0xac, 0x84, 0xc8,
+	0x9f, 0xe3, 0x33, 0xe5, 0x2f, 0x19, 0x58, 0x8d, 0xd1, 0xdd, 0x1d, 0xd8, 0x96, 0x8b, 0x89, 0x98,
+	0x4e, 0x88, 0xae, 0x05, 0x07, 0x42, 0x25, 0x4c, 0xde, 0x4d, 0xba, 0xc2, 0x6b, 0x90, 0x73, 0xf0,
+	0xa0, 0x77, 0xc6, 0xf5, 0x63, 0x1f, 0xd4, 0xbb, 0xa3, 0xdb, 0x91, 0xa9, 0x55, 0xec, 0x07, 0x97,
+	0xe2, 0xc4, 0xc5, 0x92, 0x3b, 0xef, 0xc5, 0x32, 0x17, 0x7f, 0xb1, 0xa0, 0x7d, 0x40, 0x5c, 0x96,
+	0x16, 0x9c, 0x1d, 0xae, 0x94, 0xaf, 0x8b, 0xe7, 0x3a, 0x3c, 0xaa, 0x9c, 0xd7, 0x27, 0xb8, 0xca,
+	0x1b, 0x01, 0xae, 0xed, 0xd8, 0x96, 0x67, 0x5a, 0x43, 0x1c, 0x17, 0xf2, 0x73, 0x7b, 0x2d, 0x94,
+	0x1b, 0x99, 0x68, 0x6e, 0xc4, 0xc4, 0x4f, 0x8c, 0x8d, 0xdf, 0x7f, 0x05, 0x58, 0x8b, 0xd7, 0x85,
+	0x87, 0x30, 0x88, 0x81, 0x90, 0x1c, 0x83, 0xcc, 0x78, 0x0c, 0x62, 0xdd, 0x2b, 0xce, 0xe4, 0xde,
+	0xec, 0x5b, 0xbb, 0xf7, 0x4f, 0x22, 0x48, 0x7b, 0xa6, 0x1b, 0xc9, 0x48, 0xd7, 0xf7, 0xed, 0x7d,
+	0xa8, 0x9a, 0x56, 0xa7, 0x37, 0x34, 0xb0, 0x16, 0xb4, 0x69, 0x02, 0x55, 0x6c, 0x81, 0xd3, 0x1b,
+	0x91, 0x6e, 0xad, 0x8b, 0x35, 0xd7, 0x7c, 0xcd, 0xfc, 0x9b, 0x23, 0xdd, 0x5a, 0x17, 0x1f, 0x99,
+	0xaf, 0x31, 0xad, 0x1f, 0xb2, 0x48, 0x6b, 0x3c, 0xa8, 0x1f, 0xbd, 0x8b, 0x69, 0x8d, 0xa3, 0x4f,
+	0x61, 0xbe, 0xe3, 0x60, 0xdd, 0xc3, 0x86, 0xa6, 0x3f, 0xf3, 0xb0, 0x73, 0x8e, 0x4b, 0xaa, 0xcc,
+	0x19, 0x1a, 0x04, 0x8f, 0x1a, 0x50, 0xf1, 0x05, 0x9c, 0xe0, 0x67, 0xb6, 0x83, 0xa5, 0xdc, 0x54,
+	0x09, 0xfe, 0x96, 0xdb, 0x94, 0x81, 0xe8, 0x30, 0x1c, 0x18, 0x21, 0x1d, 0xe6, 0xa6, 0xeb, 0xc0,
+	0x19, 0x02, 0x1d, 0x7c, 0x01, 0x5c, 0x87, 0xfc, 0x74, 0x1d, 0x38, 0x07, 0xd7, 0x61, 0x03, 0xca,
+	0xbc, 0x18, 0x1d, 0xfc, 0xcc, 0x7c, 0xc5, 0xbb, 0x93, 0x12, 0xab, 0x45, 0x4a, 0x52, 0x7e, 0x2b,
+	0xc0, 0x6a, 0x4c, 0xb8, 0x78, 0xfa, 0x7d, 0x02, 0xf3, 0xe1, 0xa4, 0x77, 0x25, 0x81, 0xe6, 0xc5,
+	0x4a, 0x42, 0x5e, 0xa8, 0x51, 0x34, 0xba, 0x03, 0x0b, 0x16, 0x7e, 0xe5, 0x69, 0xa1, 0x58, 0xb1,
+	0x64, 0x9d, 0x27, 0xe4, 0x43, 0x3f, 0x5e, 0xca, 0x63, 0xb8, 0xd6, 0xc4, 0x6e, 0xc7, 0x31, 0x4f,
+	0x2e, 0x54, 0x91, 0xca, 0x1f, 0x04, 0x58, 0x8b, 0x17, 0xc4, 0xed, 0xf9, 0x18, 0xca, 0x61, 0x16,
+	0x2a, 0x26, 0xc5, 0x9c, 0x08, 0x18, 0xbd, 0x0f, 0x39, 0xf2, 0x0a, 0x20, 0xc7, 0x7d, 0xaa, 0x13,
+	0x18, 0x4a, 0x69, 0xc2, 0x6a, 0x13, 0xf7, 0xb0, 0x77, 0x31, 0x93, 0xd6, 0x40, 0x8e, 0x93, 0xc2,
+	0xec, 0x51, 0x34, 0xb8, 0x71, 0x4c, 0x23, 0x1e, 0x5e, 0x6d, 0x93, 0xf0, 0xce, 0x7c, 0x9a, 0xc5,
+	0xde, 0x01, 0xca, 0x53, 0x58, 0x4f, 0xdc, 0xe0, 0x12, 0x7c, 0xaa, 0xb4, 0x40, 0xe6, 0x15, 0x7f,
+	0x21, 0x2f, 0x7d, 0x0d, 0xd7, 0x62, 0xc5, 0x5c, 0x86, 0x8a, 0x4f, 0x60, 0xed, 0xd8, 0xd2, 0x2f,
+	0x41, 0xc9, 0x5f, 0xc3, 0xf5, 0x04, 0x41, 0x97, 0xa1, 0x66, 0x03, 0x96, 0x55, 0xdc, 0xc5, 0x16,
+	0x76, 0x74, 0x0f, 0xab, 0xe4, 0x9a, 0x98, 0x59, 0xc1, 0x7f, 0x09, 0xb0, 0x32, 0x21, 0xe3, 0x9d,
+	0xbd, 0x88, 0x4e, 0x01, 0xb5, 0x0c, 0xd3, 0x7f, 0xaf, 0xcf, 0x5c, 0x0f, 0x53, 0x4c, 0x0b, 0x5d,
+	0xfe, 0x62, 0xe4, 0xf2, 0x57, 0xfe, 0x29, 0xc0, 0x62, 0x64, 0xe3, 0x77, 0xd6, 0x83, 0x3a, 0xac,
+	0x3c, 0xb6, 0x9d, 0x17, 0x17, 0x6a, 0x92, 0xd2, 0xed, 0x53, 0xbe, 0x02, 0x69, 0x72, 0x8b, 0xcb,
+	0x28, 0x87, 0x37, 0x02, 0x6c, 0x4c, 0x9e, 0x5c, 0x41, 0x2b, 0x3e, 0xab, 0x19, 0x17, 0xe9, 0xf6,
+	0x15, 0x1d, 0x94, 0x34, 0x55, 0x2e, 0xc3, 0xdc, 0x0e, 0x2c, 0xb3, 0x34, 0xc0, 0x41, 0x3c, 0x67,
+	0x35, 0xb1, 0x0e, 0xe5, 0x20, 0x6b, 0x46, 0xb1, 0x02, 0xff, 0xf1, 0xcd, 0xcf, 0x87, 0x89, 0x5d,
+	0xde, 0xd9, 0xec, 0x7e, 0x0d, 0x4b, 0x2a, 0x1d, 0xd3, 0x7c, 0x7f, 0x1e, 0x63, 0x53, 0x2c, 0x3a,
+	0x18, 0x12, 0xfd, 0x29, 0x16, 0xf9, 0x52, 0xbe, 0x13, 0x60, 0x79, 0x7c, 0xf3, 0x77, 0xd6, 0x91,
+	0x6f, 0x04, 0x58, 0x7a, 0x82, 0x3d, 0x36, 0x3d, 0xc1, 0x03, 0xdb, 0xf1, 0x7c, 0x4f, 0x7e, 0x04,
+	0xe0, 0x7a, 0xba, 0xe3, 0x69, 0x64, 0x02, 0x24, 0x09, 0x53, 0xdb, 0xd7, 0x22, 0x45, 0x93, 0x6f,
+	0x32, 0xd1, 0xc3, 0x96, 0xc1, 0x18, 0xa7, 0x0f, 0xc1, 0xf2, 0xd8, 0x32, 0xc8, 0x97, 0xf2, 0x0f,
+	0x01, 0x96, 0xc7, 0x75, 0xe1, 0x8e, 0xfd, 0x88, 0x4c, 0xc0, 0x5e, 0xfa, 0x2d, 0xec, 0xed, 0x90,
+	0xa1, 0xf1, 0x0c, 0x9b, 0xaa, 0xfd, 0x52, 0xa5, 0x2c, 0x64, 0x66, 0xe4, 0xd9, 0x9e, 0xde, 0x93,
+	0x32, 0x49, 0x33, 0x23, 0xba, 0x2c, 0x7f, 0x0a, 0xa2, 0x6a, 0xbf, 0x44, 0x55, 0x10, 0x0d, 0xdd,
+	0x0f, 0x20, 0xf9, 0x39, 0x1a, 0x3a, 0x65, 0xd2, 0x87, 0x4e, 0x7f, 0x13, 0x20, 0x7f, 0xc8, 0xc6,
+	0x00, 0xe7, 0x9a, 0xa1, 0x4e, 0x0c, 0x53, 0xc4, 0xb4, 0x61, 0x4a, 0x36, 0x3c, 0x4c, 0x21, 0xfd,
+	0x9e, 0x6d, 0xf7, 0x5c, 0x29, 0x57, 0x17, 0x09, 0x95, 0x7e, 0x44, 0x47, 0x7b, 0x73, 0x33, 0x8c,
+	0xf6, 0x94, 0x26, 0xd4, 0x76, 0xe8, 0x03, 0x88, 0xeb, 0xef, 0xe7, 0xc0, 0x7b, 0x90, 0xe7, 0x83,
+	0x0d, 0x9e, 0x00, 0x28, 0x64, 0xbc, 0x8f, 0xf5, 0x21, 0x4a, 0x0b, 0x96, 0xc6, 0xa4, 0xf0, 0xe8,
+	0xcd, 0x26, 0x66, 0x09, 0x16, 0xc9, 0xa3, 0x86, 0xd3, 0xfd, 0xe3, 0x5e, 0x79, 0x0c, 0xb5, 0x28,
+	0x99, 0x0b, 0xdf, 0x84, 0x02, 0xe7, 0xf4, 0xd3, 0x23, 0x4e, 0x7a, 0x80, 0x51, 0x7e, 0x0c, 0xcb,
+	0xfe, 0x33, 0x63, 0xcc, 0xda, 0xe8, 0x60, 0x47, 0x18, 0x1b, 0xec, 0x28, 0x4f, 0x60, 0x65, 0x82,
+	0xf1, 0xad, 0x0c, 0x6c, 0x42, 0x8d, 0x5d, 0x29, 0x17, 0xf5, 0xf6, 0x98, 0x94, 0xb7, 0x52, 0xe6,
+	0x43, 0xa8, 0xb1, 0x37, 0xca, 0x6c, 0xce, 0x58, 0x81, 0xa5, 0x31, 0x36, 0xfe, 0xaa, 0xf9, 0x9f,
+	0x00, 0x73, 0x6d, 0xfa, 0x4f, 0xc5, 0xb9, 0x8a, 0x20, 0xc8, 0x64, 0x31, 0x9c, 0xc9, 0xf1, 0x59,
+	0x3f, 0x51, 0x30, 0xb9, 0x98, 0x82, 0xd9, 0x82, 0xfc, 0xc9, 0xd0, 0xe8, 0x62, 0xcf, 0xe5, 0x25,
+	0x20, 0x85, 0xac, 0x67, 0x8a, 0x6d, 0xb3, 0x75, 0xd5, 0x07, 0x46, 0x0b, 0x27, 0x3f, 0x4b, 0xe1,
+	0xfc, 0x5b, 0x80, 0xf9, 0x88, 0x50, 0xf4, 0x01, 0xc0, 0xd0, 0xc5, 0x8e, 0x66, 0xe8, 0x26, 0xbf,
+	0x07, 0x4a, 0x5b, 0x57, 0x43, 0x2a, 0x30, 0x9c, 0x5a, 0x24, 0xa0, 0x26, 0xc1, 0xa0, 0x47, 0x50,
+	0xa6, 0x1c, 0x7d, 0xdb, 0xf2, 0x9e, 0xf7, 0xce, 0xa4, 0x4c, 0x12, 0x4f, 0x89, 0xc0, 0xbe, 0x60,
+	0x28, 0x74, 0x17, 0x72, 0x6c, 0x0b, 0x31, 0x09, 0xce, 0xd6, 0xd1, 0x03, 0xc8, 0xfb, 0x92, 0xb3,
+	0x49, 0x50, 0x1f, 0xa1, 0x3c, 0x82, 0x39, 0x46, 0x22, 0xb7, 0x1f, 0x1f, 0xc2, 0x0a, 0x74, 0x72,
+	0xcc, 0xbf, 0x82, 0x79, 0x71, 0x26, 0x34, 0x2f, 0xfe, 0x0c, 0x16, 0x59, 0xe1, 0x33, 0x57, 0x8c,
+	0x06, 0x46, 0x73, 0xec, 0x3f, 0xab, 0x18, 0x37, 0x70, 0x24, 0x07, 0x28, 0x0d, 0xff, 0x00, 0xf2,
+	0x25, 0xf0, 0x5c, 0x9e, 0x41, 0x44, 0x0d, 0x10, 0x39, 0x1f, 0x18, 0x35, 0x38, 0x35, 0xb6, 0x61,
+	0x31, 0x42, 0xe5, 0x72, 0x1f, 0x40, 0x9e, 0xb1, 0xf9, 0x67, 0x46, 0x8c, 0x60, 0x1f, 0xa1, 0x3c,
+	0x82, 0x25, 0xbf, 0xf0, 0xa3, 0x06, 0x46, 0xfe, 0xa5, 0x13, 0xa2, 0xff, 0xd2, 0x29, 0x3b, 0xb0,
+	0x3c, 0xce, 0x35, 0xbb, 0x51, 0x9f, 0xc1, 0x22, 0x2b, 0xf2, 0x8b, 0x78, 0x36, 0x2a, 0x61, 0x76,
+	0x25, 0xb6, 0x60, 0x91, 0xd5, 0xfa, 0x0c, 0xd6, 0x2f, 0x43, 0x2d, 0xca, 0xc3, 0xb6, 0xdd, 0xfa,
+	0x4f, 0x09, 0x4a, 0x3b, 0xcf, 0x75, 0xef, 0x08, 0x3b, 0xa7, 0x66, 0x07, 0xa3, 0xa7, 0x70, 0x75,
+	0x62, 0x06, 0x8e, 0x6e, 0x86, 0x74, 0x49, 0x9a, 0xee, 0xcb, 0xb7, 0xd2, 0x41, 0xdc, 0xcc, 0x2e,
+	0xd4, 0xe2, 0x66, 0xb4, 0x68, 0xec, 0xbf, 0xa7, 0xa4, 0x81, 0xb2, 0x7c, 0x77, 0x2a, 0x8e, 0x6f,
+	0xf4, 0x14, 0xae, 0x4e, 0x8c, 0xe2, 0x22, 0x86, 0x24, 0xcd, 0x55, 0xe5, 0x5b, 0xe9, 0xa0, 0x91,
+	0x21, 0x71, 0xd3, 0xb1, 0x88, 0x21, 0x29, 0x73, 0x38, 0xf9, 0xee, 0x54, 0x1c, 0xdf, 0x48, 0x07,
+	0x34, 0x39, 0xb4, 0x42, 0xb7, 0x22, 0xec, 0x09, 0x93, 0x31, 0xf9, 0xf6, 0x14, 0x14, 0xdf, 0x62,
+	0x00, 0x2b, 0x09, 0x83, 0x29, 0x74, 0x3f, 0xdc, 0x5d, 0xa5, 0x4e, 0xc7, 0xe4, 0x1f, 0x9c, 0x07,
+	0xca, 0x77, 0x34, 0x60, 0x31, 0x66, 0xc6, 0x84, 0xc2, 0xfa, 0x26, 0x8f, 0xb2, 0xe4, 0x3b, 0xd3,
+	0x60, 0x7c, 0x97, 0x6f, 0x60, 0x29, 0x76, 0x48, 0x84, 0xc2, 0xce, 0x4f, 0x9b, 0x47, 0xc9, 0xf7,
+	0xa6, 0x03, 0xf9, 0x5e, 0xbf, 0x84, 0x85, 0xb1, 0x71, 0x0f, 0xda, 0x08, 0x31, 0xc7, 0x8f, 0x93,
+	0x64, 0x25, 0x0d, 0xc2, 0x25, 0xef, 0x41, 0x29, 0x34, 0x02, 0x41, 0xd7, 0x43, 0x2c, 0x93, 0x33,
+	0x19, 0xf9, 0x46, 0xd2, 0x32, 0x97, 0xf6, 0x2b, 0xa8, 0x8e, 0x0f, 0x09, 0x50, 0x58, 0x8b, 0x84,
+	0x21, 0x85, 0x7c, 0x33, 0x15, 0xc3, 0x85, 0x9f, 0x81, 0x9c, 0xfc, 0x38, 0x47, 0xef, 0xa5, 0x26,
+	0xc8, 0xd8, 0x38, 0x41, 0x7e, 0xff, 0x9c, 0xe8, 0x91, 0xff, 0xc7, 0x9e, 0xd3, 0x11, 0xff, 0xc7,
+	0x3f, 0xe8, 0x65, 0x25, 0x0d, 0xc2, 0x25, 0x1f, 0x43, 0x25, 0xfa, 0xbc, 0x44, 0xf5, 0x48, 0xd4,
+	0x62, 0x9e, 0xbd, 0xf2, 0x46, 0x0a, 0x62, 0x24, 0x36, 0xfa, 0x56, 0x8a, 0x88, 0x8d, 0x7d, 0x03,
+	0xca, 0x1b, 0x29, 0x08, 0x7e, 0xa0, 0xff, 0x55, 0x84, 0x0a, 0xef, 0x01, 0xfd, 0x33, 0x5d, 0x85,
+	0xf9, 0xc8, 0x3b, 0x00, 0x45, 0xc6, 0x2d, 0x31, 0xef, 0x0c, 0xb9, 0x9e, 0x0c, 0xe0, 0xda, 0x1f,
+	0x40, 0x39, 0xdc, 0xfd, 0xa3, 0x1b, 0x63, 0x87, 0xe6, 0xd8, 0x6b, 0x41, 0x5e, 0x4f, 0x5c, 0x1f,
+	0xc5, 0x6f, 0xac, 0x9b, 0x8f, 0xc4, 0x2f, 0xfe, 0x89, 0x20, 0x2b, 0x69, 0x10, 0x2e, 0x59, 0x85,
+	0xf9, 0x48, 0x63, 0x1e, 0x31, 0x3f, 0xae, 0xf1, 0x97, 0xeb, 0xc9, 0x80, 0x91, 0xcc, 0x48, 0xbb,
+	0x1d, 0x91, 0x19, 0xd7, 0xbf, 0xcb, 0xf5, 0x64, 0x00, 0x8f, 0xdc, 0xb7, 0xa2, 0xdf, 0xbb, 0xfa,
+	0x81, 0x3b, 0x80, 0x72, 0xb8, 0x0b, 0x8b, 0x38, 0x39, 0xa6, 0xc1, 0x93, 0xd7, 0x13, 0xd7, 0x47,
+	0x47, 0x49, 0xa8, 0xfb, 0x8a, 0x1c, 0x25, 0x93, 0xbd, 0x9a, 0x7c, 0x23, 0x69, 0x79, 0x94, 0xc1,
+	0xd1, 0x8e, 0x0a, 0xd5, 0x63, 0xc2, 0x11, 0x55, 0x71, 0x23, 0x05, 0x31, 0x4a, 0xad, 0x70, 0x87,
+	0x14, 0xb1, 0x3a, 0xa6, 0xf9, 0x92, 0xd7, 0x13, 0xd7, 0x47, 0x02, 0xc3, 0xbd, 0x4f, 0x44, 0x60,
+	0x4c, 0x23, 0x25, 0xaf, 0x27, 0xae, 0x33, 0x81, 0xdb, 0xf3, 0x5f, 0x97, 0x4c, 0xcb, 0xc3, 0x8e,
+	0xa5, 0xf7, 0x1e, 0x0e, 0x4e, 0x4e, 0xe6, 0xe8, 0x9b, 0xe4, 0x47, 0xff, 0x1f, 0x00, 0x63, 0x5a,
+	0x1f, 0xf1, 0x3a, 0x28, 0x00, 0x00,
 }
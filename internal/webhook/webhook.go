@@ -0,0 +1,150 @@
+// Package webhook delivers events to the HTTP endpoints subscribed to them.
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// SignatureHeader carries the hex encoded HMAC-SHA256 of the request body keyed with the shared secret, prefixed with
+// "sha256=", so subscribers can tell events apart from forgeries.
+const SignatureHeader = "X-Webhook-Signature"
+
+const (
+	defaultAttempts = 4
+	defaultBackoff  = time.Second
+	defaultTimeout  = 10 * time.Second
+)
+
+// Event is posted to subscribers as JSON. Deliveries are retried, subscribers should ignore events whose ID they saw.
+type Event struct {
+	ID        string    `json:"id"`
+	Type      string    `json:"type"`
+	CreatedAt time.Time `json:"created_at"`
+	Data      any       `json:"data"`
+}
+
+// Sender posts events to every subscribed URL in the background, retrying failed deliveries with exponential backoff.
+type Sender struct {
+	urls     []string
+	secret   []byte
+	client   *http.Client
+	attempts int
+	backoff  time.Duration
+	wg       sync.WaitGroup
+}
+
+// New creates a sender posting events to the URLs, signed with the secret when it is not empty.
+func New(urls []string, secret string) *Sender {
+	return &Sender{
+		urls:     urls,
+		secret:   []byte(secret),
+		client:   &http.Client{Timeout: defaultTimeout},
+		attempts: defaultAttempts,
+		backoff:  defaultBackoff,
+	}
+}
+
+// FromEnv creates a sender posting events to the comma separated URLs of WEBHOOK_URLS, signed with WEBHOOK_SECRET. It
+// returns nil when WEBHOOK_URLS is not set.
+func FromEnv() *Sender {
+	var urls []string
+	for _, u := range strings.Split(os.Getenv("WEBHOOK_URLS"), ",") {
+		if u = strings.TrimSpace(u); u != "" {
+			urls = append(urls, u)
+		}
+	}
+
+	if len(urls) == 0 {
+		return nil
+	}
+
+	return New(urls, os.Getenv("WEBHOOK_SECRET"))
+}
+
+// Send delivers an event of the given type to every subscriber, without waiting for the deliveries.
+func (s *Sender) Send(ctx context.Context, eventType string, data any) {
+	e := Event{ID: uuid.New().String(), Type: eventType, CreatedAt: time.Now().UTC(), Data: data}
+
+	body, err := json.Marshal(e)
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to encode webhook event", "type", eventType, "error", err)
+		return
+	}
+
+	ctx = context.WithoutCancel(ctx)
+	for _, url := range s.urls {
+		s.wg.Add(1)
+		go func() {
+			defer s.wg.Done()
+			s.deliver(ctx, url, e, body)
+		}()
+	}
+}
+
+// Wait waits for the deliveries in progress, e.g. before shutting down.
+func (s *Sender) Wait() {
+	s.wg.Wait()
+}
+
+func (s *Sender) deliver(ctx context.Context, url string, e Event, body []byte) {
+	backoff := s.backoff
+	for attempt := 1; ; attempt++ {
+		err := s.post(ctx, url, body)
+		if err == nil {
+			return
+		}
+
+		if attempt == s.attempts {
+			slog.ErrorContext(ctx, "Failed to deliver webhook event", "url", url, "id", e.ID, "type", e.Type, "error", err)
+			return
+		}
+
+		slog.WarnContext(ctx, "Webhook delivery failed, retrying", "url", url, "id", e.ID, "attempt", attempt, "error", err)
+		time.Sleep(backoff)
+		backoff *= 2
+	}
+}
+
+func (s *Sender) post(ctx context.Context, url string, body []byte) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+	if len(s.secret) > 0 {
+		req.Header.Set(SignatureHeader, Sign(s.secret, body))
+	}
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return err
+	}
+	_ = resp.Body.Close()
+
+	if resp.StatusCode/100 != 2 {
+		return fmt.Errorf("subscriber responded with status %d", resp.StatusCode)
+	}
+	return nil
+}
+
+// Sign returns the SignatureHeader of the body.
+func Sign(secret, body []byte) string {
+	mac := hmac.New(sha256.New, secret)
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
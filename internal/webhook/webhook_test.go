@@ -0,0 +1,76 @@
+package webhook
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestSender(t *testing.T) {
+	t.Run("events are signed and retried until delivered", func(t *testing.T) {
+		var calls atomic.Int32
+		received := make(chan Event, 1)
+
+		subscriber := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if calls.Add(1) == 1 {
+				w.WriteHeader(http.StatusServiceUnavailable)
+				return
+			}
+
+			body, _ := io.ReadAll(r.Body)
+			if got, want := r.Header.Get(SignatureHeader), Sign([]byte("secret"), body); got != want {
+				t.Errorf("expected signature %q, got %q", want, got)
+			}
+
+			var e Event
+			if err := json.Unmarshal(body, &e); err != nil {
+				t.Errorf("unexpected error: %v", err)
+			}
+			received <- e
+		}))
+		defer subscriber.Close()
+
+		s := New([]string{subscriber.URL}, "secret")
+		s.backoff = time.Millisecond
+
+		s.Send(context.Background(), "conversation.title_updated", map[string]string{"title": "Weather in Paris"})
+		s.Wait()
+
+		select {
+		case e := <-received:
+			if e.ID == "" || e.Type != "conversation.title_updated" || e.Data.(map[string]any)["title"] != "Weather in Paris" {
+				t.Errorf("unexpected event: %+v", e)
+			}
+		default:
+			t.Fatal("expected the event to be delivered")
+		}
+
+		if n := calls.Load(); n != 2 {
+			t.Errorf("expected a failed delivery and a retry, got %d calls", n)
+		}
+	})
+
+	t.Run("deliveries give up after the last attempt", func(t *testing.T) {
+		var calls atomic.Int32
+		subscriber := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			calls.Add(1)
+			w.WriteHeader(http.StatusInternalServerError)
+		}))
+		defer subscriber.Close()
+
+		s := New([]string{subscriber.URL}, "")
+		s.backoff = time.Millisecond
+
+		s.Send(context.Background(), "conversation.title_updated", nil)
+		s.Wait()
+
+		if n := calls.Load(); n != defaultAttempts {
+			t.Errorf("expected %d attempts, got %d", defaultAttempts, n)
+		}
+	})
+}